@@ -0,0 +1,82 @@
+package structures
+
+import "sync/atomic"
+
+// AtomicEntry is a component of a single linked structure which can be used concurrently.
+//
+// Unlike [Entry], the link at the next entry is read and written atomically, so it can be used to implement lock-free structures.
+// The element of an entry can not be changed after its creation, but it can be cleared,
+// so that an entry which remains linked does not keep its element reachable.
+type AtomicEntry[T any] struct {
+	// contains filtered or unexported fields
+	element atomic.Pointer[T]
+	next    atomic.Pointer[AtomicEntry[T]]
+}
+
+// NewAtomicEntry returns a new [AtomicEntry].
+func NewAtomicEntry[T any](element T, next *AtomicEntry[T]) *AtomicEntry[T] {
+	entry := &AtomicEntry[T]{}
+	entry.element.Store(&element)
+	entry.next.Store(next)
+	return entry
+}
+
+// NewAtomicEntrySlice creates a series of linked entries which containing the elements of e, in the same order.
+// The first and the last entries of the series are returned.
+func NewAtomicEntrySlice[T any](e []T) (*AtomicEntry[T], *AtomicEntry[T]) {
+	if len(e) == 0 {
+		return nil, nil
+	}
+	last := NewAtomicEntry(e[len(e)-1], nil)
+	first := last
+	for i := len(e) - 2; i >= 0; i-- {
+		first = NewAtomicEntry(e[i], first)
+	}
+	return first, last
+}
+
+// Element returns the element of e.
+// It returns the zero value of T if the element has been cleared.
+func (e *AtomicEntry[T]) Element() T {
+	result, _ := e.LoadElement()
+	return result
+}
+
+// LoadElement returns the element of e.
+// The method returns false if the element has been cleared.
+func (e *AtomicEntry[T]) LoadElement() (T, bool) {
+
+	var result T
+
+	element := e.element.Load()
+	if element == nil {
+		return result, false
+	}
+	return *element, true
+}
+
+// ClearElement removes the element from e.
+func (e *AtomicEntry[T]) ClearElement() {
+	e.element.Store(nil)
+}
+
+// Next returns a pointer at the entry next to e.
+func (e *AtomicEntry[T]) Next() *AtomicEntry[T] {
+	return e.next.Load()
+}
+
+// SetNext sets the entry next to e.
+func (e *AtomicEntry[T]) SetNext(next *AtomicEntry[T]) {
+	e.next.Store(next)
+}
+
+// CompareAndSwapNext sets next as the entry next to e if the current one is old.
+// It returns true if the entry has been set.
+func (e *AtomicEntry[T]) CompareAndSwapNext(old *AtomicEntry[T], next *AtomicEntry[T]) bool {
+	return e.next.CompareAndSwap(old, next)
+}
+
+// Hash returns the hash code of e.
+func (e *AtomicEntry[T]) Hash() uint64 {
+	return NewEntrySingle(e.Element(), nil).Hash()
+}
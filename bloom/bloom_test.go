@@ -0,0 +1,58 @@
+package bloom
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestBinary(t *testing.T) {
+
+	var filter *BloomFilter[wrapper.Int] = NewBloomFilter[wrapper.Int](100, 0.01)
+	var counting *CountingBloomFilter[wrapper.Int] = NewCountingBloomFilter[wrapper.Int](100, 0.01)
+	var tests []binaryTest = []binaryTest{
+		{"BloomFilter", filter, NewBloomFilter[wrapper.Int](10, 0.1), &BloomFilter[wrapper.Int]{}},
+		{"CountingBloomFilter", counting, NewCountingBloomFilter[wrapper.Int](10, 0.1), &CountingBloomFilter[wrapper.Int]{}},
+	}
+
+	filter.Add(1, 2, 3)
+	counting.Add(1, 2, 2)
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
@@ -0,0 +1,239 @@
+package bloom
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"reflect"
+	"strconv"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/util"
+)
+
+// BloomFilter provides a generic Bloom filter, which is a probabilistic set that uses a constant amount of memory.
+//
+// Contains never returns false for an element which has been added,
+// but it can return true for an element which has not been added, with a probability called false positive rate.
+// The elements are not stored, so they can not be iterated or removed. Use a [CountingBloomFilter] to remove them.
+//
+// The positions of an element are derived from its Hash method through double hashing.
+type BloomFilter[T util.Hasher] struct {
+	// contains filtered or unexported fields
+	bits []uint64
+	m    int
+	k    int
+}
+
+// NewBloomFilter returns a new empty [BloomFilter] sized to contain n elements with a false positive rate p.
+//
+// It panics if n is not positive or if p is not between 0 and 1, both excluded.
+func NewBloomFilter[T util.Hasher](n int, p float64) *BloomFilter[T] {
+	m, k := parameters(n, p)
+	return &BloomFilter[T]{bits: make([]uint64, (m+63)/64), m: m, k: k}
+}
+
+// Size returns the number of bits of f.
+func (f *BloomFilter[T]) Size() int {
+	return f.m
+}
+
+// HashCount returns the number of positions set by each element.
+func (f *BloomFilter[T]) HashCount() int {
+	return f.k
+}
+
+// IsEmpty returns a bool which indicates if f is empty or not.
+func (f *BloomFilter[T]) IsEmpty() bool {
+	for _, i := range f.bits {
+		if i != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns an estimate of the number of distinct elements added at f, computed from the bits which are set.
+func (f *BloomFilter[T]) Count() int {
+	return estimate(f.m, f.k, f.set())
+}
+
+// FalsePositiveRate returns the probability that Contains returns true for an element which has not been added,
+// computed from the bits which are set.
+func (f *BloomFilter[T]) FalsePositiveRate() float64 {
+	return math.Pow(float64(f.set())/float64(f.m), float64(f.k))
+}
+
+// Add adds the elements e at f.
+func (f *BloomFilter[T]) Add(e ...T) {
+	for _, i := range e {
+		for j := range locations(i.Hash(), f.m, f.k) {
+			f.bits[j/64] |= 1 << (j % 64)
+		}
+	}
+}
+
+// Contains returns false if e has not been added at f.
+// If it returns true, e may have been added.
+func (f *BloomFilter[T]) Contains(e T) bool {
+	for i := range locations(e.Hash(), f.m, f.k) {
+		if f.bits[i/64]&(1<<(i%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clear removes all element from f.
+func (f *BloomFilter[T]) Clear() {
+	clear(f.bits)
+}
+
+// Union returns a new [BloomFilter] which contains the elements of f and of other.
+// The result is the same of a filter where the elements of both filters have been added.
+//
+// It returns an error if f and other have a different size or a different number of hash functions.
+func (f *BloomFilter[T]) Union(other *BloomFilter[T]) (*BloomFilter[T], error) {
+	if err := f.compatible(other); err != nil {
+		return nil, err
+	}
+	result := &BloomFilter[T]{bits: make([]uint64, len(f.bits)), m: f.m, k: f.k}
+	for i := range f.bits {
+		result.bits[i] = f.bits[i] | other.bits[i]
+	}
+	return result, nil
+}
+
+// Intersect returns a new [BloomFilter] which contains the elements added both at f and at other.
+// The false positive rate of the result can be higher than the one of a filter where only those elements have been added.
+//
+// It returns an error if f and other have a different size or a different number of hash functions.
+func (f *BloomFilter[T]) Intersect(other *BloomFilter[T]) (*BloomFilter[T], error) {
+	if err := f.compatible(other); err != nil {
+		return nil, err
+	}
+	result := &BloomFilter[T]{bits: make([]uint64, len(f.bits)), m: f.m, k: f.k}
+	for i := range f.bits {
+		result.bits[i] = f.bits[i] & other.bits[i]
+	}
+	return result, nil
+}
+
+// Equal returns true if st is a [BloomFilter] with the same size, the same number of hash functions and the same bits of f.
+// In any other case, it returns false.
+func (f *BloomFilter[T]) Equal(st any) bool {
+	filter, ok := st.(*BloomFilter[T])
+	if !ok || f == nil || filter == nil || f.compatible(filter) != nil {
+		return false
+	}
+	return reflect.DeepEqual(f.bits, filter.bits)
+}
+
+// Compare returns 0 if f and st have the same size,
+// -1 if f is smaller than st,
+// 1 if f is bigger than st,
+// -2 if st is not a [BloomFilter] or if one between f and st is nil.
+func (f *BloomFilter[T]) Compare(st any) int {
+	filter, ok := st.(*BloomFilter[T])
+	if ok && f != nil && filter != nil {
+		if f.m < filter.m {
+			return -1
+		}
+		if f.m > filter.m {
+			return 1
+		}
+		return 0
+	}
+	return -2
+}
+
+// Hash returns the hash code of f.
+func (f *BloomFilter[T]) Hash() uint64 {
+	h := fnv.New64()
+	for _, i := range f.bits {
+		h.Write([]byte(strconv.FormatUint(i, 16)))
+	}
+	return h.Sum64()
+}
+
+// Copy returns a [BloomFilter] containing the same elements of f.
+func (f *BloomFilter[T]) Copy() *BloomFilter[T] {
+	result := &BloomFilter[T]{bits: make([]uint64, len(f.bits)), m: f.m, k: f.k}
+	copy(result.bits, f.bits)
+	return result
+}
+
+// String returns a rapresentation of f in the form of a string.
+func (f *BloomFilter[T]) String() string {
+	check := reflect.TypeOf(new(T)).String()
+	return fmt.Sprintf("BloomFilter[%v][size: %v, hashes: %v, count: %v]", check[1:], f.m, f.k, f.Count())
+}
+
+// MarshalBinary returns the binary encoding of f, which contains its size, its number of hash functions and its bits.
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder(2 + len(f.bits))
+	words := codec.NewCodec[uint64]()
+	if err := encodeParameters(e, f.m, f.k); err != nil {
+		return nil, err
+	}
+	for _, i := range f.bits {
+		if err := words.Encode(e, i); err != nil {
+			return nil, err
+		}
+	}
+	return e.Bytes(), nil
+}
+
+// UnmarshalBinary replaces f with the filter decoded from the binary encoding data.
+func (f *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	d, n, err := codec.NewDecoder(data)
+	if err != nil {
+		return err
+	}
+	m, k, err := decodeParameters(d, n)
+	if err != nil {
+		return err
+	}
+	if n-2 != (m+63)/64 {
+		return errors.New("Invalid number of bits " + strconv.Itoa(m))
+	}
+	result := &BloomFilter[T]{bits: make([]uint64, n-2), m: m, k: k}
+	words := codec.NewCodec[uint64]()
+	for i := range result.bits {
+		if result.bits[i], err = words.Decode(d); err != nil {
+			return err
+		}
+	}
+	if err := d.Close(); err != nil {
+		return err
+	}
+	*f = *result
+	return nil
+}
+
+// GobEncode returns the binary encoding of f as [BloomFilter.MarshalBinary].
+func (f *BloomFilter[T]) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode decodes data into f as [BloomFilter.UnmarshalBinary].
+func (f *BloomFilter[T]) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+func (f *BloomFilter[T]) set() int {
+	result := 0
+	for _, i := range f.bits {
+		result += bits.OnesCount64(i)
+	}
+	return result
+}
+
+func (f *BloomFilter[T]) compatible(other *BloomFilter[T]) error {
+	if f.m != other.m || f.k != other.k {
+		return errors.New("Cannot combine filters with different sizes or hash functions")
+	}
+	return nil
+}
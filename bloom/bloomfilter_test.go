@@ -0,0 +1,161 @@
+package bloom
+
+import (
+	"testing"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewBloomFilter(t *testing.T) {
+
+	var filter *BloomFilter[wrapper.Int] = NewBloomFilter[wrapper.Int](1000, 0.01)
+
+	if filter.Size() != 9586 || filter.HashCount() != 7 {
+		t.Log("size is", filter.Size(), "hashes are", filter.HashCount())
+		t.Fail()
+	}
+	if !filter.IsEmpty() || filter.Count() != 0 {
+		t.Log("filter is not empty")
+		t.Fail()
+	}
+	if hashes := NewBloomFilter[wrapper.Int](10, 1e-100).HashCount(); hashes != 64 {
+		t.Log("hashes are", hashes)
+		t.Fail()
+	}
+	for _, i := range []func(){
+		func() { NewBloomFilter[wrapper.Int](0, 0.01) },
+		func() { NewBloomFilter[wrapper.Int](10, 1) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Log("NewBloomFilter does not panic")
+					t.Fail()
+				}
+			}()
+			i()
+		}()
+	}
+}
+func TestContainsBloomFilter(t *testing.T) {
+
+	var filter *BloomFilter[wrapper.Int] = NewBloomFilter[wrapper.Int](1000, 0.01)
+
+	for i := wrapper.Int(0); i != 1000; i++ {
+		filter.Add(i)
+	}
+	for i := wrapper.Int(0); i != 1000; i++ {
+		if !filter.Contains(i) {
+			t.Log("element", i, "not found")
+			t.Fail()
+		}
+	}
+	positives := 0
+	for i := wrapper.Int(1000); i != 11000; i++ {
+		if filter.Contains(i) {
+			positives++
+		}
+	}
+	if positives > 200 {
+		t.Log("false positives are", positives)
+		t.Fail()
+	}
+	if count := filter.Count(); count < 950 || count > 1050 {
+		t.Log("count is", count)
+		t.Fail()
+	}
+	if rate := filter.FalsePositiveRate(); rate < 0.005 || rate > 0.02 {
+		t.Log("rate is", rate)
+		t.Fail()
+	}
+	filter.Clear()
+	if !filter.IsEmpty() || filter.Contains(1) {
+		t.Log("filter is not empty")
+		t.Fail()
+	}
+}
+func TestUnionBloomFilter(t *testing.T) {
+
+	var filter *BloomFilter[wrapper.String] = NewBloomFilter[wrapper.String](100, 0.01)
+	var other *BloomFilter[wrapper.String] = NewBloomFilter[wrapper.String](100, 0.01)
+
+	filter.Add("a", "b")
+	other.Add("c")
+	result, err := filter.Union(other)
+	if err != nil || !result.Contains("a") || !result.Contains("c") {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	expected := NewBloomFilter[wrapper.String](100, 0.01)
+	expected.Add("a", "b", "c")
+	if !result.Equal(expected) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if _, err := filter.Union(NewBloomFilter[wrapper.String](1000, 0.01)); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestIntersectBloomFilter(t *testing.T) {
+
+	var filter *BloomFilter[wrapper.String] = NewBloomFilter[wrapper.String](100, 0.01)
+	var other *BloomFilter[wrapper.String] = NewBloomFilter[wrapper.String](100, 0.01)
+
+	filter.Add("a", "b")
+	other.Add("b", "c")
+	result, err := filter.Intersect(other)
+	if err != nil || !result.Contains("b") || result.Contains("a") || result.Contains("c") {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if _, err := filter.Intersect(NewBloomFilter[wrapper.String](100, 0.5)); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestEqualBloomFilter(t *testing.T) {
+
+	var filter *BloomFilter[wrapper.Int] = NewBloomFilter[wrapper.Int](100, 0.01)
+
+	filter.Add(1, 2, 3)
+	if !filter.Equal(filter.Copy()) || filter.Hash() != filter.Copy().Hash() {
+		t.Log("copy is not equal")
+		t.Fail()
+	}
+	other := filter.Copy()
+	other.Add(4)
+	if filter.Equal(other) || filter.Equal(NewCountingBloomFilter[wrapper.Int](100, 0.01)) {
+		t.Log("filters are equal")
+		t.Fail()
+	}
+	if filter.Compare(other) != 0 || filter.Compare(NewBloomFilter[wrapper.Int](1000, 0.01)) != -1 || filter.Compare(NewCountingBloomFilter[wrapper.Int](100, 0.01)) != -2 {
+		t.Log("compare is wrong")
+		t.Fail()
+	}
+	if filter.String() != "BloomFilter[wrapper.Int][size: 959, hashes: 7, count: 3]" {
+		t.Log("string is", filter)
+		t.Fail()
+	}
+}
+func TestBinaryBloomFilter(t *testing.T) {
+
+	var filter *BloomFilter[wrapper.Int] = NewBloomFilter[wrapper.Int](100, 0.01)
+	var result *BloomFilter[wrapper.Int] = NewBloomFilter[wrapper.Int](100, 0.01)
+
+	filter.Add(1, 2, 3)
+	result.Add(1, 2, 3)
+	counting, _ := NewCountingBloomFilter[wrapper.Int](100, 0.01).MarshalBinary()
+	if err := result.UnmarshalBinary(counting); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	e := codec.NewEncoder(3)
+	encodeParameters(e, 64, 1<<40)
+	codec.NewCodec[uint64]().Encode(e, 0)
+	if err := result.UnmarshalBinary(e.Bytes()); err == nil || !result.Equal(filter) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
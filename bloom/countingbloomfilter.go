@@ -0,0 +1,250 @@
+package bloom
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/util"
+)
+
+// CountingBloomFilter provides a generic Bloom filter which stores a counter for each position instead of a bit,
+// so that the elements can also be removed.
+//
+// Like a [BloomFilter], Contains never returns false for an element which has been added and not removed,
+// but it can return true for an element which has not been added.
+// Removing an element which has not been added can cause false negatives.
+//
+// Every counter uses a byte. A counter which reaches 255 is never decremented,
+// since the number of elements which share its position is no longer known.
+type CountingBloomFilter[T util.Hasher] struct {
+	// contains filtered or unexported fields
+	counters []uint8
+	k        int
+}
+
+// NewCountingBloomFilter returns a new empty [CountingBloomFilter] sized to contain n elements with a false positive rate p.
+//
+// It panics if n is not positive or if p is not between 0 and 1, both excluded.
+func NewCountingBloomFilter[T util.Hasher](n int, p float64) *CountingBloomFilter[T] {
+	m, k := parameters(n, p)
+	return &CountingBloomFilter[T]{counters: make([]uint8, m), k: k}
+}
+
+// Size returns the number of counters of f.
+func (f *CountingBloomFilter[T]) Size() int {
+	return len(f.counters)
+}
+
+// HashCount returns the number of positions incremented by each element.
+func (f *CountingBloomFilter[T]) HashCount() int {
+	return f.k
+}
+
+// IsEmpty returns a bool which indicates if f is empty or not.
+func (f *CountingBloomFilter[T]) IsEmpty() bool {
+	return f.set() == 0
+}
+
+// Count returns an estimate of the number of distinct elements contained in f, computed from the counters which are not zero.
+func (f *CountingBloomFilter[T]) Count() int {
+	return estimate(len(f.counters), f.k, f.set())
+}
+
+// FalsePositiveRate returns the probability that Contains returns true for an element which has not been added,
+// computed from the counters which are not zero.
+func (f *CountingBloomFilter[T]) FalsePositiveRate() float64 {
+	return math.Pow(float64(f.set())/float64(len(f.counters)), float64(f.k))
+}
+
+// Add adds the elements e at f.
+func (f *CountingBloomFilter[T]) Add(e ...T) {
+	for _, i := range e {
+		for j := range locations(i.Hash(), len(f.counters), f.k) {
+			if f.counters[j] != math.MaxUint8 {
+				f.counters[j]++
+			}
+		}
+	}
+}
+
+// Contains returns false if e is not contained in f.
+// If it returns true, e may be contained in f.
+func (f *CountingBloomFilter[T]) Contains(e T) bool {
+	for i := range locations(e.Hash(), len(f.counters), f.k) {
+		if f.counters[i] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Remove removes the element e from f.
+// It returns false if e is not contained in f, in which case f is not modified.
+func (f *CountingBloomFilter[T]) Remove(e T) bool {
+	if !f.Contains(e) {
+		return false
+	}
+	for i := range locations(e.Hash(), len(f.counters), f.k) {
+		if f.counters[i] != math.MaxUint8 {
+			f.counters[i]--
+		}
+	}
+	return true
+}
+
+// Clear removes all element from f.
+func (f *CountingBloomFilter[T]) Clear() {
+	clear(f.counters)
+}
+
+// Union returns a new [CountingBloomFilter] which contains the elements of f and of other.
+// Every counter of the result is the sum of the counters of f and of other.
+//
+// It returns an error if f and other have a different size or a different number of hash functions.
+func (f *CountingBloomFilter[T]) Union(other *CountingBloomFilter[T]) (*CountingBloomFilter[T], error) {
+	if err := f.compatible(other); err != nil {
+		return nil, err
+	}
+	result := &CountingBloomFilter[T]{counters: make([]uint8, len(f.counters)), k: f.k}
+	for i := range f.counters {
+		result.counters[i] = uint8(min(int(f.counters[i])+int(other.counters[i]), math.MaxUint8))
+	}
+	return result, nil
+}
+
+// Intersect returns a new [CountingBloomFilter] which contains the elements contained both in f and in other.
+// Every counter of the result is the minimum between the counters of f and of other.
+//
+// It returns an error if f and other have a different size or a different number of hash functions.
+func (f *CountingBloomFilter[T]) Intersect(other *CountingBloomFilter[T]) (*CountingBloomFilter[T], error) {
+	if err := f.compatible(other); err != nil {
+		return nil, err
+	}
+	result := &CountingBloomFilter[T]{counters: make([]uint8, len(f.counters)), k: f.k}
+	for i := range f.counters {
+		result.counters[i] = min(f.counters[i], other.counters[i])
+	}
+	return result, nil
+}
+
+// Equal returns true if st is a [CountingBloomFilter] with the same size, the same number of hash functions and the same counters of f.
+// In any other case, it returns false.
+func (f *CountingBloomFilter[T]) Equal(st any) bool {
+	filter, ok := st.(*CountingBloomFilter[T])
+	if !ok || f == nil || filter == nil || f.compatible(filter) != nil {
+		return false
+	}
+	return reflect.DeepEqual(f.counters, filter.counters)
+}
+
+// Compare returns 0 if f and st have the same size,
+// -1 if f is smaller than st,
+// 1 if f is bigger than st,
+// -2 if st is not a [CountingBloomFilter] or if one between f and st is nil.
+func (f *CountingBloomFilter[T]) Compare(st any) int {
+	filter, ok := st.(*CountingBloomFilter[T])
+	if ok && f != nil && filter != nil {
+		if len(f.counters) < len(filter.counters) {
+			return -1
+		}
+		if len(f.counters) > len(filter.counters) {
+			return 1
+		}
+		return 0
+	}
+	return -2
+}
+
+// Hash returns the hash code of f.
+func (f *CountingBloomFilter[T]) Hash() uint64 {
+	h := fnv.New64()
+	h.Write(f.counters)
+	return h.Sum64()
+}
+
+// Copy returns a [CountingBloomFilter] containing the same elements of f.
+func (f *CountingBloomFilter[T]) Copy() *CountingBloomFilter[T] {
+	result := &CountingBloomFilter[T]{counters: make([]uint8, len(f.counters)), k: f.k}
+	copy(result.counters, f.counters)
+	return result
+}
+
+// String returns a rapresentation of f in the form of a string.
+func (f *CountingBloomFilter[T]) String() string {
+	check := reflect.TypeOf(new(T)).String()
+	return fmt.Sprintf("CountingBloomFilter[%v][size: %v, hashes: %v, count: %v]", check[1:], len(f.counters), f.k, f.Count())
+}
+
+// MarshalBinary returns the binary encoding of f, which contains its size, its number of hash functions and its counters.
+func (f *CountingBloomFilter[T]) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder(2 + len(f.counters))
+	counters := codec.NewCodec[uint8]()
+	if err := encodeParameters(e, len(f.counters), f.k); err != nil {
+		return nil, err
+	}
+	for _, i := range f.counters {
+		if err := counters.Encode(e, i); err != nil {
+			return nil, err
+		}
+	}
+	return e.Bytes(), nil
+}
+
+// UnmarshalBinary replaces f with the filter decoded from the binary encoding data.
+func (f *CountingBloomFilter[T]) UnmarshalBinary(data []byte) error {
+	d, n, err := codec.NewDecoder(data)
+	if err != nil {
+		return err
+	}
+	m, k, err := decodeParameters(d, n)
+	if err != nil {
+		return err
+	}
+	if n-2 != m {
+		return errors.New("Invalid number of counters " + strconv.Itoa(m))
+	}
+	result := &CountingBloomFilter[T]{counters: make([]uint8, m), k: k}
+	counters := codec.NewCodec[uint8]()
+	for i := range result.counters {
+		if result.counters[i], err = counters.Decode(d); err != nil {
+			return err
+		}
+	}
+	if err := d.Close(); err != nil {
+		return err
+	}
+	*f = *result
+	return nil
+}
+
+// GobEncode returns the binary encoding of f as [CountingBloomFilter.MarshalBinary].
+func (f *CountingBloomFilter[T]) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode decodes data into f as [CountingBloomFilter.UnmarshalBinary].
+func (f *CountingBloomFilter[T]) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+func (f *CountingBloomFilter[T]) set() int {
+	result := 0
+	for _, i := range f.counters {
+		if i != 0 {
+			result++
+		}
+	}
+	return result
+}
+
+func (f *CountingBloomFilter[T]) compatible(other *CountingBloomFilter[T]) error {
+	if len(f.counters) != len(other.counters) || f.k != other.k {
+		return errors.New("Cannot combine filters with different sizes or hash functions")
+	}
+	return nil
+}
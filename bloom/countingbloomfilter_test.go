@@ -0,0 +1,165 @@
+package bloom
+
+import (
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewCountingBloomFilter(t *testing.T) {
+
+	var filter *CountingBloomFilter[wrapper.Int] = NewCountingBloomFilter[wrapper.Int](1000, 0.01)
+
+	if filter.Size() != 9586 || filter.HashCount() != 7 {
+		t.Log("size is", filter.Size(), "hashes are", filter.HashCount())
+		t.Fail()
+	}
+	if !filter.IsEmpty() || filter.Count() != 0 {
+		t.Log("filter is not empty")
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("NewCountingBloomFilter does not panic")
+			t.Fail()
+		}
+	}()
+	NewCountingBloomFilter[wrapper.Int](10, 0)
+}
+func TestContainsCountingBloomFilter(t *testing.T) {
+
+	var filter *CountingBloomFilter[wrapper.Int] = NewCountingBloomFilter[wrapper.Int](1000, 0.01)
+
+	for i := wrapper.Int(0); i != 1000; i++ {
+		filter.Add(i)
+	}
+	for i := wrapper.Int(0); i != 1000; i++ {
+		if !filter.Contains(i) {
+			t.Log("element", i, "not found")
+			t.Fail()
+		}
+	}
+	positives := 0
+	for i := wrapper.Int(1000); i != 11000; i++ {
+		if filter.Contains(i) {
+			positives++
+		}
+	}
+	if positives > 200 {
+		t.Log("false positives are", positives)
+		t.Fail()
+	}
+	if count := filter.Count(); count < 950 || count > 1050 {
+		t.Log("count is", count)
+		t.Fail()
+	}
+}
+func TestRemoveCountingBloomFilter(t *testing.T) {
+
+	var filter *CountingBloomFilter[wrapper.String] = NewCountingBloomFilter[wrapper.String](100, 0.01)
+
+	filter.Add("a", "b", "b")
+	if !filter.Remove("b") || !filter.Contains("b") {
+		t.Log("b not found")
+		t.Fail()
+	}
+	if !filter.Remove("b") || filter.Contains("b") {
+		t.Log("b found")
+		t.Fail()
+	}
+	if filter.Remove("c") {
+		t.Log("c removed")
+		t.Fail()
+	}
+	if !filter.Remove("a") || !filter.IsEmpty() {
+		t.Log("filter is not empty")
+		t.Fail()
+	}
+	for i := 0; i != 300; i++ {
+		filter.Add("a")
+	}
+	for i := 0; i != 300; i++ {
+		filter.Remove("a")
+	}
+	if !filter.Contains("a") {
+		t.Log("saturated counters have been decremented")
+		t.Fail()
+	}
+}
+func TestUnionCountingBloomFilter(t *testing.T) {
+
+	var filter *CountingBloomFilter[wrapper.String] = NewCountingBloomFilter[wrapper.String](100, 0.01)
+	var other *CountingBloomFilter[wrapper.String] = NewCountingBloomFilter[wrapper.String](100, 0.01)
+
+	filter.Add("a", "b")
+	other.Add("b", "c")
+	result, err := filter.Union(other)
+	expected := NewCountingBloomFilter[wrapper.String](100, 0.01)
+	expected.Add("a", "b", "b", "c")
+	if err != nil || !result.Equal(expected) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if !result.Remove("b") || !result.Contains("b") {
+		t.Log("b not found")
+		t.Fail()
+	}
+	if _, err := filter.Union(NewCountingBloomFilter[wrapper.String](1000, 0.01)); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestIntersectCountingBloomFilter(t *testing.T) {
+
+	var filter *CountingBloomFilter[wrapper.String] = NewCountingBloomFilter[wrapper.String](100, 0.01)
+	var other *CountingBloomFilter[wrapper.String] = NewCountingBloomFilter[wrapper.String](100, 0.01)
+
+	filter.Add("a", "b")
+	other.Add("b", "c")
+	result, err := filter.Intersect(other)
+	if err != nil || !result.Contains("b") || result.Contains("a") || result.Contains("c") {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if _, err := filter.Intersect(NewCountingBloomFilter[wrapper.String](100, 0.5)); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestEqualCountingBloomFilter(t *testing.T) {
+
+	var filter *CountingBloomFilter[wrapper.Int] = NewCountingBloomFilter[wrapper.Int](100, 0.01)
+
+	filter.Add(1, 2, 3)
+	if !filter.Equal(filter.Copy()) || filter.Hash() != filter.Copy().Hash() {
+		t.Log("copy is not equal")
+		t.Fail()
+	}
+	other := filter.Copy()
+	other.Add(3)
+	if filter.Equal(other) || filter.Equal(NewBloomFilter[wrapper.Int](100, 0.01)) {
+		t.Log("filters are equal")
+		t.Fail()
+	}
+	if filter.Compare(other) != 0 || filter.Compare(NewCountingBloomFilter[wrapper.Int](10, 0.01)) != 1 || filter.Compare(NewBloomFilter[wrapper.Int](100, 0.01)) != -2 {
+		t.Log("compare is wrong")
+		t.Fail()
+	}
+	if filter.String() != "CountingBloomFilter[wrapper.Int][size: 959, hashes: 7, count: 3]" {
+		t.Log("string is", filter)
+		t.Fail()
+	}
+}
+func TestBinaryCountingBloomFilter(t *testing.T) {
+
+	var filter *CountingBloomFilter[wrapper.Int] = NewCountingBloomFilter[wrapper.Int](100, 0.01)
+	var result *CountingBloomFilter[wrapper.Int] = NewCountingBloomFilter[wrapper.Int](100, 0.01)
+
+	filter.Add(1, 2, 2)
+	result.Add(1, 2, 2)
+	bloom, _ := NewBloomFilter[wrapper.Int](100, 0.01).MarshalBinary()
+	if err := result.UnmarshalBinary(bloom); err == nil || !result.Equal(filter) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,99 @@
+// package bloom implements probabilistic structures which test if an element has been added.
+package bloom
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/potex02/structures/internal/codec"
+)
+
+// maxHashCount is the max number of hash functions of a filter.
+// It is reached only with a false positive rate lower than 2^-64.
+const maxHashCount = 64
+
+// parameters returns the number of positions and of hash functions of a filter
+// which contains n elements with a false positive rate p.
+// It panics if n is not positive or if p is not between 0 and 1.
+//
+// The number of hash functions is at most maxHashCount.
+func parameters(n int, p float64) (int, int) {
+	if n <= 0 {
+		panic(fmt.Sprintf("Cannot create a filter for %v elements", n))
+	}
+	if p <= 0 || p >= 1 {
+		panic(fmt.Sprintf("Cannot create a filter with false positive rate %v", p))
+	}
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := math.Round(m / float64(n) * math.Ln2)
+	return int(m), min(max(int(k), 1), maxHashCount)
+}
+
+// locations returns a function that iterates the k positions of a filter of length m associated at hash.
+//
+// The positions are derived from two hashes with double hashing, so the i-th position is h1 + i * h2 modulo m.
+func locations(hash uint64, m int, k int) func(yield func(int) bool) {
+	return func(yield func(int) bool) {
+		h1 := mix(hash)
+		// h2 is odd, so it is never zero.
+		h2 := mix(hash^0x9e3779b97f4a7c15) | 1
+		for i := 0; i != k; i++ {
+			if !yield(int((h1 + uint64(i)*h2) % uint64(m))) {
+				return
+			}
+		}
+	}
+}
+
+// mix scrambles the bits of hash with the finalizer of splitmix64,
+// so that similar hashes, like the ones of consecutive numbers, produce different positions.
+func mix(hash uint64) uint64 {
+	hash ^= hash >> 30
+	hash *= 0xbf58476d1ce4e5b9
+	hash ^= hash >> 27
+	hash *= 0x94d049bb133111eb
+	hash ^= hash >> 31
+	return hash
+}
+
+// estimate returns the approximate number of elements contained in a filter of length m,
+// with k hash functions, where set positions are not empty.
+func estimate(m int, k int, set int) int {
+	if set == m {
+		return math.MaxInt
+	}
+	return int(math.Round(-float64(m) / float64(k) * math.Log(1-float64(set)/float64(m))))
+}
+
+// encodeParameters writes the size and the number of hash functions of a filter.
+func encodeParameters(e *codec.Encoder, m int, k int) error {
+	ints := codec.NewCodec[int]()
+	if err := ints.Encode(e, m); err != nil {
+		return err
+	}
+	return ints.Encode(e, k)
+}
+
+// decodeParameters reads the size and the number of hash functions of a filter encoded in n values.
+// It returns an error if the number of hash functions is greater than the size or than maxHashCount,
+// since every Add and Contains would iterate all of them.
+func decodeParameters(d *codec.Decoder, n int) (int, int, error) {
+	if n < 2 {
+		return 0, 0, errors.New("Invalid binary length " + strconv.Itoa(n))
+	}
+	ints := codec.NewCodec[int]()
+	m, err := ints.Decode(d)
+	if err != nil {
+		return 0, 0, err
+	}
+	k, err := ints.Decode(d)
+	if err != nil {
+		return 0, 0, err
+	}
+	if m <= 0 || k <= 0 || k > m || k > maxHashCount {
+		return 0, 0, errors.New("Invalid filter with size " + strconv.Itoa(m) + " and " + strconv.Itoa(k) + " hash functions")
+	}
+	return m, k, nil
+}
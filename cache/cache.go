@@ -0,0 +1,215 @@
+// package cache implements caches with a fixed capacity, which evict their entries when they are full.
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+)
+
+// Cache provides all methods to use a generic cache, which maps keys of type K to elements of type T.
+// A cache contains all the methods of [structures.Structure], whose elements are the elements of the cache.
+//
+// A cache contains at most Capacity entries.
+// When a new entry is added at a full cache, another entry is evicted following the policy of the cache.
+//
+// The check on the equality of the elements is done with the Equal method if T implements [util.Equaler],
+// otherwise it is done with [reflect.DeepEqual].
+type Cache[K util.Hasher, T any] interface {
+	structures.Structure[T]
+	// Capacity returns the max number of entries of the cache.
+	Capacity() int
+	// ContainsKey returns true if the key is present on the cache.
+	// It does not count as an access to the key.
+	ContainsKey(key K) bool
+	// Keys returns a [list.List] which contains all keys of the cache, in the order of RangeIter.
+	Keys() list.List[K]
+	// Get returns the element associated at the key and records the access to the key.
+	// The method returns false if the key is not found.
+	//
+	// The hits and the misses of Get are counted in the statistics of the cache.
+	Get(key K) (T, bool)
+	// Peek returns the element associated at the key, like Get,
+	// but it does not record the access to the key and it is not counted in the statistics of the cache.
+	// The method returns false if the key is not found.
+	Peek(key K) (T, bool)
+	// Put sets the element e at the key and returns the overwritten value, if present.
+	// If the element is not present, the method returns false.
+	//
+	// If the key is not present and the cache is full, an entry is evicted.
+	Put(key K, e T) (T, bool)
+	// Remove removes the key from the cache and returns the value associated at the key.
+	// It returns false if the key does not exists.
+	//
+	// The removed entry is not counted as evicted.
+	Remove(key K) (T, bool)
+	// OnEvict sets the function executed for every entry evicted by the cache.
+	// A nil fun removes the previous function.
+	OnEvict(fun func(key K, element T))
+	// Stats returns the statistics of the cache.
+	Stats() Stats
+	// ResetStats sets all the statistics of the cache to zero.
+	ResetStats()
+	// RangeIter returns a function that allows to iterate the cache using the range keyword.
+	// The entries are iterated starting from the one which would be evicted last.
+	//
+	//	for i, j := range cache.RangeIter() {
+	//		// Code
+	//	}
+	RangeIter() func(yield func(K, T) bool)
+}
+
+// Stats contains the statistics of a [Cache].
+type Stats struct {
+	// Hits is the number of calls to Get which have found their key.
+	Hits int
+	// Misses is the number of calls to Get which have not found their key.
+	Misses int
+	// Evictions is the number of entries evicted because the cache was full.
+	Evictions int
+	// Expirations is the number of entries removed because their time to live was passed.
+	Expirations int
+}
+
+// HitRate returns the ratio between the hits and the calls to Get.
+// It returns 0 if Get has never been called.
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Clock is a function which returns the current time.
+// [time.Now] is a Clock.
+type Clock func() time.Time
+
+type item[K util.Hasher, T any] struct {
+	key       K
+	element   T
+	entry     *structures.Entry[*item[K, T]]
+	frequency int
+	timeout   *structures.Entry[*item[K, T]]
+	expire    time.Time
+}
+
+func checkCapacity(capacity int) {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("Cannot create a cache with capacity %v", capacity))
+	}
+}
+
+func equalCaches[K util.Hasher, T any](c Cache[K, T], st any) bool {
+	other, ok := st.(Cache[K, T])
+	if !ok || other == nil || c.Len() != other.Len() {
+		return false
+	}
+	for i, j := range c.RangeIter() {
+		e, found := other.Peek(i)
+		if !found || !util.EqualFunction(j)(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func compareCaches[K util.Hasher, T any](c Cache[K, T], st any) int {
+	other, ok := st.(Cache[K, T])
+	if !ok || other == nil {
+		return -2
+	}
+	if c.Len() < other.Len() {
+		return -1
+	}
+	if c.Len() > other.Len() {
+		return 1
+	}
+	return 0
+}
+
+// hashCache returns the hash code of c, which does not depend on the order of its entries.
+func hashCache[K util.Hasher, T any](c Cache[K, T]) uint64 {
+	var result uint64
+	for i, j := range c.RangeIter() {
+		result += table.NewEntry(i, j).Hash()
+	}
+	h := fnv.New64()
+	h.Write([]byte(strconv.FormatUint(result, 16)))
+	return h.Sum64()
+}
+
+func cacheString[K util.Hasher, T any](name string, c Cache[K, T]) string {
+	check := []string{reflect.TypeOf(new(K)).String(), reflect.TypeOf(new(T)).String()}
+	result := fmt.Sprintf("%v[%v, %v][", name, check[0][1:], check[1][1:])
+	first := true
+	for i, j := range c.RangeIter() {
+		if !first {
+			result += ", "
+		}
+		result += fmt.Sprintf("%v: %v", i, j)
+		first = false
+	}
+	result += "]"
+	return result
+}
+
+// marshalCache returns the JSON encoding of c, which is an array of [key, element] pairs in the order of RangeIter.
+func marshalCache[K util.Hasher, T any](c Cache[K, T]) ([]byte, error) {
+	objects := make([][2]any, 0, c.Len())
+	for i, j := range c.RangeIter() {
+		objects = append(objects, [2]any{i, j})
+	}
+	return json.Marshal(objects)
+}
+
+// unmarshalCache decodes the JSON array of [key, element] pairs data.
+func unmarshalCache[K util.Hasher, T any](data []byte) ([]K, []T, error) {
+	var objects [][2]json.RawMessage
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, nil, err
+	}
+	keys := make([]K, len(objects))
+	elements := make([]T, len(objects))
+	for i, j := range objects {
+		if err := json.Unmarshal(j[0], &keys[i]); err != nil {
+			return nil, nil, err
+		}
+		if err := json.Unmarshal(j[1], &elements[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return keys, elements, nil
+}
+
+// marshalBinaryCache returns the binary encoding of c, which contains its entries as key and element pairs in the order of RangeIter.
+func marshalBinaryCache[K util.Hasher, T any](c Cache[K, T]) ([]byte, error) {
+	return codec.MarshalBinaryEntries(c.Len(), c.RangeIter())
+}
+
+// fill clears c and puts the entries decoded from data in c, in reverse order,
+// so that RangeIter iterates them in the order in which they have been encoded.
+//
+// It returns an error if c is the zero value, since its capacity is unknown.
+func fill[K util.Hasher, T any](c Cache[K, T], name string, keys []K, elements []T, err error) error {
+	if err != nil {
+		return err
+	}
+	if c.Capacity() == 0 {
+		return errors.New("Cannot decode a " + name + " without capacity")
+	}
+	c.Clear()
+	for i := len(keys) - 1; i >= 0; i-- {
+		c.Put(keys[i], elements[i])
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestBinary(t *testing.T) {
+
+	var lru *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+	var lfu *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+	var ttl *TTLCache[wrapper.Int, string] = NewTTLCache[wrapper.Int, string](3, time.Minute)
+	var tests []binaryTest = []binaryTest{
+		{"LRUCache", lru, NewLRUCache[wrapper.Int, string](3), NewLRUCache[wrapper.Int, string](3)},
+		{"LFUCache", lfu, NewLFUCache[wrapper.Int, string](3), NewLFUCache[wrapper.Int, string](3)},
+		{"TTLCache", ttl, NewTTLCache[wrapper.Int, string](3, time.Minute), NewTTLCache[wrapper.Int, string](3, time.Minute)},
+	}
+
+	lru.Put(1, "a")
+	lru.Put(2, "b")
+	lfu.Put(1, "a")
+	lfu.Put(2, "b")
+	ttl.Put(1, "a")
+	ttl.Put(2, "b")
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
@@ -0,0 +1,337 @@
+package cache
+
+import (
+	"slices"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = NewLFUCache[wrapper.Int, int](1)
+var _ Cache[wrapper.Int, int] = NewLFUCache[wrapper.Int, int](1)
+
+// LFUCache provides a generic cache which evicts the least frequently used entry.
+// Every call to Get or Put on a key increments its frequency.
+// Between entries with the same frequency, the least recently used one is evicted.
+//
+// The entries are found through a [table.HashTable] and are kept in a [list.LinkedList] for each frequency,
+// whose lists are found through another [table.HashTable], so Get, Put and Peek run in O(1) time.
+//
+// It implements the interface [Cache].
+type LFUCache[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	objects      *table.HashTable[K, *item[K, T]]
+	buckets      *table.HashTable[wrapper.Int, *list.LinkedList[*item[K, T]]]
+	minFrequency int
+	len          int
+	capacity     int
+	evict        func(key K, element T)
+	stats        Stats
+}
+
+// NewLFUCache returns a new empty [LFUCache] which contains at most capacity entries.
+//
+// It panics if capacity is not positive.
+func NewLFUCache[K util.Hasher, T any](capacity int) *LFUCache[K, T] {
+	checkCapacity(capacity)
+	return &LFUCache[K, T]{
+		objects:  table.NewHashTable[K, *item[K, T]](),
+		buckets:  table.NewHashTable[wrapper.Int, *list.LinkedList[*item[K, T]]](),
+		capacity: capacity,
+	}
+}
+
+// Len returns the number of entries of c.
+func (c *LFUCache[K, T]) Len() int {
+	return c.len
+}
+
+// IsEmpty returns a bool which indicates if c is empty or not.
+func (c *LFUCache[K, T]) IsEmpty() bool {
+	return c.len == 0
+}
+
+// Capacity returns the max number of entries of c.
+func (c *LFUCache[K, T]) Capacity() int {
+	return c.capacity
+}
+
+// ContainsKey returns true if the key is present on c.
+// It does not increment the frequency of the key.
+func (c *LFUCache[K, T]) ContainsKey(key K) bool {
+	return c.objects.ContainsKey(key)
+}
+
+// Frequency returns the number of times that the key has been used since it has been added.
+// The method returns false if the key is not found.
+func (c *LFUCache[K, T]) Frequency(key K) (int, bool) {
+	i, ok := c.objects.Get(key)
+	if !ok {
+		return 0, false
+	}
+	return i.frequency, true
+}
+
+// Keys returns a [list.List] which contains all keys of c, from the most frequently used.
+func (c *LFUCache[K, T]) Keys() list.List[K] {
+	keys := list.NewArrayList[K]()
+	for i := range c.RangeIter() {
+		keys.Add(i)
+	}
+	return keys
+}
+
+// ToSlice returns a slice which contains all elements of c, from the most frequently used.
+func (c *LFUCache[K, T]) ToSlice() []T {
+	slice := make([]T, 0, c.len)
+	for _, i := range c.RangeIter() {
+		slice = append(slice, i)
+	}
+	return slice
+}
+
+// Get returns the element associated at the key and increments the frequency of the key.
+// The method returns false if the key is not found.
+func (c *LFUCache[K, T]) Get(key K) (T, bool) {
+
+	var result T
+
+	i, ok := c.objects.Get(key)
+	if !ok {
+		c.stats.Misses++
+		return result, false
+	}
+	c.stats.Hits++
+	c.touch(i)
+	return i.element, true
+}
+
+// Peek returns the element associated at the key, without incrementing the frequency of the key.
+// The method returns false if the key is not found.
+func (c *LFUCache[K, T]) Peek(key K) (T, bool) {
+
+	var result T
+
+	i, ok := c.objects.Get(key)
+	if !ok {
+		return result, false
+	}
+	return i.element, true
+}
+
+// Put sets the element e at the key, increments the frequency of the key and returns the overwritten value, if present.
+// If the element is not present, the method returns false.
+//
+// If the key is not present and c is full, the least frequently used entry is evicted.
+// The new key starts with a frequency of 1.
+func (c *LFUCache[K, T]) Put(key K, e T) (T, bool) {
+
+	var result T
+
+	if i, ok := c.objects.Get(key); ok {
+		result = i.element
+		i.element = e
+		c.touch(i)
+		return result, true
+	}
+	if c.len == c.capacity {
+		bucket, _ := c.buckets.Get(wrapper.Int(c.minFrequency))
+		last := bucket.LastEntry().Element()
+		c.objects.Remove(last.key)
+		c.unlink(last)
+		c.stats.Evictions++
+		if c.evict != nil {
+			c.evict(last.key, last.element)
+		}
+	}
+	i := &item[K, T]{key: key, element: e}
+	c.link(i, 1)
+	c.minFrequency = 1
+	c.objects.Put(key, i)
+	return result, false
+}
+
+// Remove removes the key from c and returns the value associated at the key.
+// It returns false if the key does not exists.
+func (c *LFUCache[K, T]) Remove(key K) (T, bool) {
+
+	var result T
+
+	i, ok := c.objects.Remove(key)
+	if !ok {
+		return result, false
+	}
+	c.unlink(i)
+	if !c.buckets.ContainsKey(wrapper.Int(c.minFrequency)) {
+		c.minFrequency = 0
+		for j := range c.buckets.RangeIter() {
+			if c.minFrequency == 0 || int(j) < c.minFrequency {
+				c.minFrequency = int(j)
+			}
+		}
+	}
+	return i.element, true
+}
+
+// OnEvict sets the function executed for every entry evicted by c.
+// A nil fun removes the previous function.
+func (c *LFUCache[K, T]) OnEvict(fun func(key K, element T)) {
+	c.evict = fun
+}
+
+// Stats returns the statistics of c.
+func (c *LFUCache[K, T]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats sets all the statistics of c to zero.
+func (c *LFUCache[K, T]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// Each executes fun for all entries of c, from the most frequently used.
+func (c *LFUCache[K, T]) Each(fun func(key K, element T)) {
+	for i, j := range c.RangeIter() {
+		fun(i, j)
+	}
+}
+
+// Clear removes all entries from c, without executing the function set by OnEvict.
+// The statistics of c are not modified.
+func (c *LFUCache[K, T]) Clear() {
+	c.objects = table.NewHashTable[K, *item[K, T]]()
+	c.buckets = table.NewHashTable[wrapper.Int, *list.LinkedList[*item[K, T]]]()
+	c.minFrequency = 0
+	c.len = 0
+}
+
+// RangeIter returns a function that allows to iterate a [LFUCache] using the range keyword.
+// The entries are iterated from the most frequently used and,
+// between entries with the same frequency, from the most recently used.
+//
+//	for i, j := range c.RangeIter() {
+//		// Code
+//	}
+//
+// It doesn't allow to modify c during the iteration.
+func (c *LFUCache[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		frequencies := c.buckets.Keys().ToSlice()
+		slices.Sort(frequencies)
+		for _, i := range slices.Backward(frequencies) {
+			bucket, _ := c.buckets.Get(i)
+			for _, j := range bucket.RangeIter() {
+				if !yield(j.key, j.element) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Equal returns true if c and st are both caches and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st, its capacity and the order of its entries.
+func (c *LFUCache[K, T]) Equal(st any) bool {
+	return c != nil && equalCaches[K, T](c, st)
+}
+
+// Compare returns 0 if c and st have the same length,
+// -1 if c is shorten than st,
+// 1 if c is longer than st,
+// -2 if st is not a [Cache] or if one between c and st is nil.
+func (c *LFUCache[K, T]) Compare(st any) int {
+	if c == nil {
+		return -2
+	}
+	return compareCaches[K, T](c, st)
+}
+
+// Hash returns the hash code of c.
+func (c *LFUCache[K, T]) Hash() uint64 {
+	return hashCache[K, T](c)
+}
+
+// String returns a rapresentation of c in the form of a string.
+func (c *LFUCache[K, T]) String() string {
+	return cacheString[K, T]("LFUCache", c)
+}
+
+// MarshalJSON returns the JSON encoding of c, which is an array of [key, element] pairs starting from the most frequently used.
+// The frequencies of the keys are not encoded.
+func (c *LFUCache[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalCache[K, T](c)
+}
+
+// UnmarshalJSON replaces the entries of c with the ones decoded from the JSON array data.
+// All decoded keys have a frequency of 1 and the first pair becomes the most recently used.
+//
+// The method returns an error if c is the zero value, since its capacity is unknown.
+func (c *LFUCache[K, T]) UnmarshalJSON(data []byte) error {
+	keys, elements, err := unmarshalCache[K, T](data)
+	return fill[K, T](c, "LFUCache", keys, elements, err)
+}
+
+// MarshalBinary returns the binary encoding of c, which contains its entries as key and element pairs starting from the most frequently used.
+// The frequencies of the keys are not encoded.
+func (c *LFUCache[K, T]) MarshalBinary() ([]byte, error) {
+	return marshalBinaryCache[K, T](c)
+}
+
+// UnmarshalBinary replaces the entries of c with the ones decoded from the binary encoding data.
+// All decoded keys have a frequency of 1 and the first entry becomes the most recently used.
+//
+// The method returns an error if c is the zero value, since its capacity is unknown.
+func (c *LFUCache[K, T]) UnmarshalBinary(data []byte) error {
+	keys, elements, err := codec.UnmarshalBinaryEntries[K, T](data)
+	return fill[K, T](c, "LFUCache", keys, elements, err)
+}
+
+// GobEncode returns the binary encoding of c as [LFUCache.MarshalBinary].
+func (c *LFUCache[K, T]) GobEncode() ([]byte, error) {
+	return c.MarshalBinary()
+}
+
+// GobDecode decodes data into c as [LFUCache.UnmarshalBinary].
+func (c *LFUCache[K, T]) GobDecode(data []byte) error {
+	return c.UnmarshalBinary(data)
+}
+
+// touch moves the item i in the list of the following frequency.
+func (c *LFUCache[K, T]) touch(i *item[K, T]) {
+	frequency := i.frequency
+	c.unlink(i)
+	if frequency == c.minFrequency {
+		if !c.buckets.ContainsKey(wrapper.Int(frequency)) {
+			c.minFrequency++
+		}
+	}
+	c.link(i, frequency+1)
+}
+
+// link adds the item i at the start of the list of frequency.
+func (c *LFUCache[K, T]) link(i *item[K, T], frequency int) {
+	bucket, ok := c.buckets.Get(wrapper.Int(frequency))
+	if !ok {
+		bucket = list.NewLinkedList[*item[K, T]]()
+		c.buckets.Put(wrapper.Int(frequency), bucket)
+	}
+	i.frequency = frequency
+	i.entry = bucket.AddFirst(i)
+	c.len++
+}
+
+// unlink removes the item i from the list of its frequency, deleting the list if it becomes empty.
+func (c *LFUCache[K, T]) unlink(i *item[K, T]) {
+	bucket, _ := c.buckets.Get(wrapper.Int(i.frequency))
+	bucket.RemoveEntry(i.entry)
+	if bucket.IsEmpty() {
+		c.buckets.Remove(wrapper.Int(i.frequency))
+	}
+	c.len--
+}
@@ -0,0 +1,264 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewLFUCache(t *testing.T) {
+
+	var cache *LFUCache[wrapper.Int, int] = NewLFUCache[wrapper.Int, int](3)
+
+	if cache.Capacity() != 3 || !cache.IsEmpty() || cache.Len() != 0 {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("NewLFUCache does not panic")
+			t.Fail()
+		}
+	}()
+	NewLFUCache[wrapper.Int, int](-1)
+}
+func TestPutLFUCache(t *testing.T) {
+
+	var cache *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+
+	if _, ok := cache.Put(1, "a"); ok {
+		t.Log("1 is present")
+		t.Fail()
+	}
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	if e, ok := cache.Put(1, "d"); !ok || e != "a" {
+		t.Log("overwritten element is", e)
+		t.Fail()
+	}
+	if frequency, ok := cache.Frequency(1); !ok || frequency != 2 {
+		t.Log("frequency is", frequency)
+		t.Fail()
+	}
+	var evicted []wrapper.Int
+	cache.OnEvict(func(key wrapper.Int, element string) {
+		evicted = append(evicted, key)
+	})
+	cache.Put(4, "e")
+	cache.Put(5, "f")
+	if !reflect.DeepEqual(evicted, []wrapper.Int{2, 3}) {
+		t.Log("evicted keys are", evicted)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(cache.ToSlice(), []string{"d", "f", "e"}) || cache.Len() != 3 {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	if stats := cache.Stats(); stats.Evictions != 2 {
+		t.Log("stats are", stats)
+		t.Fail()
+	}
+}
+func TestGetLFUCache(t *testing.T) {
+
+	var cache *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	cache.Get(1)
+	cache.Get(1)
+	if e, ok := cache.Get(3); !ok || e != "c" {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := cache.Peek(2); !ok || e != "b" {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if frequency, _ := cache.Frequency(2); frequency != 1 {
+		t.Log("frequency is", frequency)
+		t.Fail()
+	}
+	if _, ok := cache.Get(4); ok {
+		t.Log("4 found")
+		t.Fail()
+	}
+	cache.Put(4, "d")
+	if cache.ContainsKey(2) || !cache.ContainsKey(3) {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	if !cache.Keys().Equal(list.NewArrayList[wrapper.Int](1, 3, 4)) {
+		t.Log("keys are", cache.Keys())
+		t.Fail()
+	}
+	stats := cache.Stats()
+	if stats.Hits != 3 || stats.Misses != 1 || stats.Evictions != 1 || stats.HitRate() != 0.75 {
+		t.Log("stats are", stats)
+		t.Fail()
+	}
+}
+func TestRemoveLFUCache(t *testing.T) {
+
+	var cache *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+
+	evicted := 0
+	cache.OnEvict(func(key wrapper.Int, element string) {
+		evicted++
+	})
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	cache.Get(2)
+	cache.Get(2)
+	cache.Get(3)
+	if e, ok := cache.Remove(1); !ok || e != "a" {
+		t.Log("removed element is", e)
+		t.Fail()
+	}
+	if _, ok := cache.Remove(1); ok {
+		t.Log("1 removed")
+		t.Fail()
+	}
+	if evicted != 0 {
+		t.Log("evicted entries are", evicted)
+		t.Fail()
+	}
+	cache.Put(4, "d")
+	cache.Get(4)
+	cache.Get(4)
+	cache.Put(5, "e")
+	if cache.ContainsKey(3) || !reflect.DeepEqual(cache.ToSlice(), []string{"d", "b", "e"}) {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	cache.Clear()
+	if !cache.IsEmpty() || evicted != 1 {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	cache.Put(6, "f")
+	if !reflect.DeepEqual(cache.ToSlice(), []string{"f"}) {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+}
+func TestRangeIterLFUCache(t *testing.T) {
+
+	var cache *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	cache.Get(1)
+	var keys []wrapper.Int
+	for i := range cache.RangeIter() {
+		keys = append(keys, i)
+		if i == 3 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(keys, []wrapper.Int{1, 3}) {
+		t.Log("keys are", keys)
+		t.Fail()
+	}
+	var elements []string
+	cache.Each(func(key wrapper.Int, element string) {
+		elements = append(elements, element)
+	})
+	if !reflect.DeepEqual(elements, []string{"a", "c", "b"}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+}
+func TestEqualLFUCache(t *testing.T) {
+
+	var cache *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+	var other *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](5)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	cache.Get(1)
+	other.Put(2, "b")
+	other.Put(1, "a")
+	if !cache.Equal(other) || cache.Hash() != other.Hash() || cache.Compare(other) != 0 {
+		t.Log("caches are not equal")
+		t.Fail()
+	}
+	other.Put(1, "c")
+	if cache.Equal(other) || cache.Equal(nil) {
+		t.Log("caches are equal")
+		t.Fail()
+	}
+	other.Put(3, "d")
+	if cache.Compare(other) != -1 || other.Compare(cache) != 1 {
+		t.Log("compare is wrong")
+		t.Fail()
+	}
+	if cache.String() != "LFUCache[wrapper.Int, string][1: a, 2: b]" {
+		t.Log("string is", cache)
+		t.Fail()
+	}
+}
+func TestJSONLFUCache(t *testing.T) {
+
+	var cache *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+	var result *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	cache.Get(1)
+	data, err := json.Marshal(cache)
+	if err != nil || string(data) != `[[1,"a"],[2,"b"]]` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result.Put(3, "c")
+	if err := json.Unmarshal(data, result); err != nil || !reflect.DeepEqual(result.ToSlice(), []string{"a", "b"}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if frequency, _ := result.Frequency(1); frequency != 1 {
+		t.Log("frequency is", frequency)
+		t.Fail()
+	}
+	var zero LFUCache[wrapper.Int, string]
+	if err := json.Unmarshal(data, &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestBinaryLFUCache(t *testing.T) {
+
+	var cache *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+	var result *LFUCache[wrapper.Int, string] = NewLFUCache[wrapper.Int, string](3)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	cache.Get(1)
+	data, err := cache.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !reflect.DeepEqual(result.Keys().ToSlice(), []wrapper.Int{1, 2}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(cache); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	result = NewLFUCache[wrapper.Int, string](1)
+	if err := gob.NewDecoder(&buffer).Decode(result); err != nil || !reflect.DeepEqual(result.ToSlice(), []string{"a"}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,263 @@
+package cache
+
+import (
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = NewLRUCache[wrapper.Int, int](1)
+var _ Cache[wrapper.Int, int] = NewLRUCache[wrapper.Int, int](1)
+
+// LRUCache provides a generic cache which evicts the least recently used entry.
+// An entry is used when it is read by Get or written by Put.
+//
+// The entries are found through a [table.HashTable] and are kept in order of use in a [list.LinkedList],
+// whose entries are moved and removed directly, so Get, Put, Peek and Remove run in O(1) time.
+//
+// It implements the interface [Cache].
+type LRUCache[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	objects  *table.HashTable[K, *item[K, T]]
+	order    *list.LinkedList[*item[K, T]]
+	capacity int
+	evict    func(key K, element T)
+	stats    Stats
+}
+
+// NewLRUCache returns a new empty [LRUCache] which contains at most capacity entries.
+//
+// It panics if capacity is not positive.
+func NewLRUCache[K util.Hasher, T any](capacity int) *LRUCache[K, T] {
+	checkCapacity(capacity)
+	return &LRUCache[K, T]{objects: table.NewHashTable[K, *item[K, T]](), order: list.NewLinkedList[*item[K, T]](), capacity: capacity}
+}
+
+// Len returns the number of entries of c.
+func (c *LRUCache[K, T]) Len() int {
+	return c.order.Len()
+}
+
+// IsEmpty returns a bool which indicates if c is empty or not.
+func (c *LRUCache[K, T]) IsEmpty() bool {
+	return c.order.IsEmpty()
+}
+
+// Capacity returns the max number of entries of c.
+func (c *LRUCache[K, T]) Capacity() int {
+	return c.capacity
+}
+
+// ContainsKey returns true if the key is present on c.
+// It does not count as an use of the key.
+func (c *LRUCache[K, T]) ContainsKey(key K) bool {
+	return c.objects.ContainsKey(key)
+}
+
+// Keys returns a [list.List] which contains all keys of c, from the most recently used.
+func (c *LRUCache[K, T]) Keys() list.List[K] {
+	keys := list.NewArrayList[K]()
+	for i := range c.RangeIter() {
+		keys.Add(i)
+	}
+	return keys
+}
+
+// ToSlice returns a slice which contains all elements of c, from the most recently used.
+func (c *LRUCache[K, T]) ToSlice() []T {
+	slice := make([]T, 0, c.order.Len())
+	for _, i := range c.RangeIter() {
+		slice = append(slice, i)
+	}
+	return slice
+}
+
+// Get returns the element associated at the key and marks the key as the most recently used.
+// The method returns false if the key is not found.
+func (c *LRUCache[K, T]) Get(key K) (T, bool) {
+
+	var result T
+
+	i, ok := c.objects.Get(key)
+	if !ok {
+		c.stats.Misses++
+		return result, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFirst(i.entry)
+	return i.element, true
+}
+
+// Peek returns the element associated at the key, without marking the key as used.
+// The method returns false if the key is not found.
+func (c *LRUCache[K, T]) Peek(key K) (T, bool) {
+
+	var result T
+
+	i, ok := c.objects.Get(key)
+	if !ok {
+		return result, false
+	}
+	return i.element, true
+}
+
+// Put sets the element e at the key, marks the key as the most recently used and returns the overwritten value, if present.
+// If the element is not present, the method returns false.
+//
+// If the key is not present and c is full, the least recently used entry is evicted.
+func (c *LRUCache[K, T]) Put(key K, e T) (T, bool) {
+
+	var result T
+
+	if i, ok := c.objects.Get(key); ok {
+		result = i.element
+		i.element = e
+		c.order.MoveToFirst(i.entry)
+		return result, true
+	}
+	if c.order.Len() == c.capacity {
+		last := c.order.LastEntry().Element()
+		c.objects.Remove(last.key)
+		c.order.RemoveEntry(last.entry)
+		c.stats.Evictions++
+		if c.evict != nil {
+			c.evict(last.key, last.element)
+		}
+	}
+	i := &item[K, T]{key: key, element: e}
+	i.entry = c.order.AddFirst(i)
+	c.objects.Put(key, i)
+	return result, false
+}
+
+// Remove removes the key from c and returns the value associated at the key.
+// It returns false if the key does not exists.
+func (c *LRUCache[K, T]) Remove(key K) (T, bool) {
+
+	var result T
+
+	i, ok := c.objects.Remove(key)
+	if !ok {
+		return result, false
+	}
+	c.order.RemoveEntry(i.entry)
+	return i.element, true
+}
+
+// OnEvict sets the function executed for every entry evicted by c.
+// A nil fun removes the previous function.
+func (c *LRUCache[K, T]) OnEvict(fun func(key K, element T)) {
+	c.evict = fun
+}
+
+// Stats returns the statistics of c.
+func (c *LRUCache[K, T]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats sets all the statistics of c to zero.
+func (c *LRUCache[K, T]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// Each executes fun for all entries of c, from the most recently used.
+func (c *LRUCache[K, T]) Each(fun func(key K, element T)) {
+	for i, j := range c.RangeIter() {
+		fun(i, j)
+	}
+}
+
+// Clear removes all entries from c, without executing the function set by OnEvict.
+// The statistics of c are not modified.
+func (c *LRUCache[K, T]) Clear() {
+	c.objects = table.NewHashTable[K, *item[K, T]]()
+	c.order = list.NewLinkedList[*item[K, T]]()
+}
+
+// RangeIter returns a function that allows to iterate a [LRUCache] using the range keyword.
+// The entries are iterated from the most recently used.
+//
+//	for i, j := range c.RangeIter() {
+//		// Code
+//	}
+//
+// It doesn't allow to modify c during the iteration.
+func (c *LRUCache[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		for _, i := range c.order.RangeIter() {
+			if !yield(i.key, i.element) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns true if c and st are both caches and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st, its capacity and the order of its entries.
+func (c *LRUCache[K, T]) Equal(st any) bool {
+	return c != nil && equalCaches[K, T](c, st)
+}
+
+// Compare returns 0 if c and st have the same length,
+// -1 if c is shorten than st,
+// 1 if c is longer than st,
+// -2 if st is not a [Cache] or if one between c and st is nil.
+func (c *LRUCache[K, T]) Compare(st any) int {
+	if c == nil {
+		return -2
+	}
+	return compareCaches[K, T](c, st)
+}
+
+// Hash returns the hash code of c.
+func (c *LRUCache[K, T]) Hash() uint64 {
+	return hashCache[K, T](c)
+}
+
+// String returns a rapresentation of c in the form of a string.
+func (c *LRUCache[K, T]) String() string {
+	return cacheString[K, T]("LRUCache", c)
+}
+
+// MarshalJSON returns the JSON encoding of c, which is an array of [key, element] pairs starting from the most recently used.
+func (c *LRUCache[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalCache[K, T](c)
+}
+
+// UnmarshalJSON replaces the entries of c with the ones decoded from the JSON array data.
+// The first pair becomes the most recently used.
+//
+// The method returns an error if c is the zero value, since its capacity is unknown.
+func (c *LRUCache[K, T]) UnmarshalJSON(data []byte) error {
+	keys, elements, err := unmarshalCache[K, T](data)
+	return fill[K, T](c, "LRUCache", keys, elements, err)
+}
+
+// MarshalBinary returns the binary encoding of c, which contains its entries as key and element pairs starting from the most recently used.
+func (c *LRUCache[K, T]) MarshalBinary() ([]byte, error) {
+	return marshalBinaryCache[K, T](c)
+}
+
+// UnmarshalBinary replaces the entries of c with the ones decoded from the binary encoding data.
+// The first entry becomes the most recently used.
+//
+// The method returns an error if c is the zero value, since its capacity is unknown.
+func (c *LRUCache[K, T]) UnmarshalBinary(data []byte) error {
+	keys, elements, err := codec.UnmarshalBinaryEntries[K, T](data)
+	return fill[K, T](c, "LRUCache", keys, elements, err)
+}
+
+// GobEncode returns the binary encoding of c as [LRUCache.MarshalBinary].
+func (c *LRUCache[K, T]) GobEncode() ([]byte, error) {
+	return c.MarshalBinary()
+}
+
+// GobDecode decodes data into c as [LRUCache.UnmarshalBinary].
+func (c *LRUCache[K, T]) GobDecode(data []byte) error {
+	return c.UnmarshalBinary(data)
+}
@@ -0,0 +1,253 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewLRUCache(t *testing.T) {
+
+	var cache *LRUCache[wrapper.Int, int] = NewLRUCache[wrapper.Int, int](3)
+
+	if cache.Capacity() != 3 || !cache.IsEmpty() || cache.Len() != 0 {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("NewLRUCache does not panic")
+			t.Fail()
+		}
+	}()
+	NewLRUCache[wrapper.Int, int](0)
+}
+func TestPutLRUCache(t *testing.T) {
+
+	var cache *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+
+	if _, ok := cache.Put(1, "a"); ok {
+		t.Log("1 is present")
+		t.Fail()
+	}
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	if e, ok := cache.Put(1, "d"); !ok || e != "a" {
+		t.Log("overwritten element is", e)
+		t.Fail()
+	}
+	var evicted []wrapper.Int
+	cache.OnEvict(func(key wrapper.Int, element string) {
+		evicted = append(evicted, key)
+	})
+	cache.Put(4, "e")
+	cache.Put(5, "f")
+	if !reflect.DeepEqual(evicted, []wrapper.Int{2, 3}) {
+		t.Log("evicted keys are", evicted)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(cache.ToSlice(), []string{"f", "e", "d"}) || cache.Len() != 3 {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	if stats := cache.Stats(); stats.Evictions != 2 {
+		t.Log("stats are", stats)
+		t.Fail()
+	}
+}
+func TestGetLRUCache(t *testing.T) {
+
+	var cache *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	if e, ok := cache.Get(1); !ok || e != "a" {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := cache.Peek(2); !ok || e != "b" {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if _, ok := cache.Get(4); ok {
+		t.Log("4 found")
+		t.Fail()
+	}
+	cache.Put(4, "d")
+	if cache.ContainsKey(2) || !cache.ContainsKey(1) {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	if !cache.Keys().Equal(list.NewArrayList[wrapper.Int](4, 1, 3)) {
+		t.Log("keys are", cache.Keys())
+		t.Fail()
+	}
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 1 || stats.HitRate() != 0.5 {
+		t.Log("stats are", stats)
+		t.Fail()
+	}
+	cache.ResetStats()
+	if cache.Stats() != (Stats{}) || cache.Stats().HitRate() != 0 {
+		t.Log("stats are", cache.Stats())
+		t.Fail()
+	}
+}
+func TestRemoveLRUCache(t *testing.T) {
+
+	var cache *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+
+	evicted := 0
+	cache.OnEvict(func(key wrapper.Int, element string) {
+		evicted++
+	})
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	if e, ok := cache.Remove(1); !ok || e != "a" {
+		t.Log("removed element is", e)
+		t.Fail()
+	}
+	if _, ok := cache.Remove(1); ok {
+		t.Log("1 removed")
+		t.Fail()
+	}
+	cache.Put(3, "c")
+	cache.Put(4, "d")
+	if evicted != 0 || cache.Len() != 3 {
+		t.Log("evicted entries are", evicted)
+		t.Fail()
+	}
+	cache.Clear()
+	if !cache.IsEmpty() || evicted != 0 {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	cache.Put(5, "e")
+	if !reflect.DeepEqual(cache.ToSlice(), []string{"e"}) {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+}
+func TestRangeIterLRUCache(t *testing.T) {
+
+	var cache *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	cache.Get(2)
+	var keys []wrapper.Int
+	for i := range cache.RangeIter() {
+		keys = append(keys, i)
+		if i == 3 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(keys, []wrapper.Int{2, 3}) {
+		t.Log("keys are", keys)
+		t.Fail()
+	}
+	var elements []string
+	cache.Each(func(key wrapper.Int, element string) {
+		elements = append(elements, element)
+	})
+	if !reflect.DeepEqual(elements, []string{"b", "c", "a"}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+}
+func TestEqualLRUCache(t *testing.T) {
+
+	var cache *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+	var other *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](5)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	other.Put(2, "b")
+	other.Put(1, "a")
+	if !cache.Equal(other) || cache.Hash() != other.Hash() || cache.Compare(other) != 0 {
+		t.Log("caches are not equal")
+		t.Fail()
+	}
+	lfu := NewLFUCache[wrapper.Int, string](3)
+	lfu.Put(1, "a")
+	lfu.Put(2, "b")
+	if !cache.Equal(lfu) {
+		t.Log("caches are not equal")
+		t.Fail()
+	}
+	other.Put(1, "c")
+	if cache.Equal(other) || cache.Equal(list.NewArrayList[string]("a", "b")) {
+		t.Log("caches are equal")
+		t.Fail()
+	}
+	other.Put(3, "d")
+	if cache.Compare(other) != -1 || other.Compare(cache) != 1 || cache.Compare(list.NewArrayList[string]()) != -2 {
+		t.Log("compare is wrong")
+		t.Fail()
+	}
+	if cache.String() != "LRUCache[wrapper.Int, string][2: b, 1: a]" {
+		t.Log("string is", cache)
+		t.Fail()
+	}
+}
+func TestJSONLRUCache(t *testing.T) {
+
+	var cache *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+	var result *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	data, err := json.Marshal(cache)
+	if err != nil || string(data) != `[[2,"b"],[1,"a"]]` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result.Put(3, "c")
+	if err := json.Unmarshal(data, result); err != nil || !reflect.DeepEqual(result.ToSlice(), []string{"b", "a"}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`[[1]]`), result); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	var zero LRUCache[wrapper.Int, string]
+	if err := json.Unmarshal(data, &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestBinaryLRUCache(t *testing.T) {
+
+	var cache *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+	var result *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	data, err := cache.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !reflect.DeepEqual(result.Keys().ToSlice(), []wrapper.Int{2, 1}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(cache); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	result = NewLRUCache[wrapper.Int, string](1)
+	if err := gob.NewDecoder(&buffer).Decode(result); err != nil || !reflect.DeepEqual(result.ToSlice(), []string{"b"}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
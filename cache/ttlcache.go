@@ -0,0 +1,353 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = NewTTLCache[wrapper.Int, int](1, time.Second)
+var _ Cache[wrapper.Int, int] = NewTTLCache[wrapper.Int, int](1, time.Second)
+
+// TTLCache provides a generic cache whose entries expire after a fixed time to live.
+// The time to live of an entry starts when the entry is written by Put.
+// If c is full, the least recently used entry is evicted, like in a [LRUCache].
+//
+// The expired entries are removed by every method which reads or writes the entries of c,
+// so they are never returned or iterated.
+// A removed expired entry is counted in the Expirations statistic and is passed at the function set by OnEvict.
+//
+// The entries are found through a [table.HashTable] and are kept in two [list.LinkedList],
+// one in order of use and one in order of expiration, so Get, Put, Peek and Remove run in O(1) time.
+//
+// The current time is read from a [Clock], which can be replaced to control the expirations.
+//
+// It implements the interface [Cache].
+type TTLCache[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	objects  *table.HashTable[K, *item[K, T]]
+	order    *list.LinkedList[*item[K, T]]
+	timeouts *list.LinkedList[*item[K, T]]
+	capacity int
+	ttl      time.Duration
+	clock    Clock
+	evict    func(key K, element T)
+	stats    Stats
+}
+
+// NewTTLCache returns a new empty [TTLCache] which contains at most capacity entries,
+// each one expiring after ttl. The current time is read from [time.Now].
+//
+// It panics if capacity or ttl are not positive.
+func NewTTLCache[K util.Hasher, T any](capacity int, ttl time.Duration) *TTLCache[K, T] {
+	return NewTTLCacheWithClock[K, T](capacity, ttl, time.Now)
+}
+
+// NewTTLCacheWithClock returns a new empty [TTLCache] which contains at most capacity entries,
+// each one expiring after ttl. The current time is read from clock.
+//
+// It panics if capacity or ttl are not positive or if clock is nil.
+func NewTTLCacheWithClock[K util.Hasher, T any](capacity int, ttl time.Duration, clock Clock) *TTLCache[K, T] {
+	checkCapacity(capacity)
+	if ttl <= 0 {
+		panic(fmt.Sprintf("Cannot create a cache with time to live %v", ttl))
+	}
+	if clock == nil {
+		panic("Cannot create a cache without clock")
+	}
+	return &TTLCache[K, T]{
+		objects:  table.NewHashTable[K, *item[K, T]](),
+		order:    list.NewLinkedList[*item[K, T]](),
+		timeouts: list.NewLinkedList[*item[K, T]](),
+		capacity: capacity,
+		ttl:      ttl,
+		clock:    clock,
+	}
+}
+
+// Len returns the number of entries of c which are not expired.
+func (c *TTLCache[K, T]) Len() int {
+	c.purge()
+	return c.order.Len()
+}
+
+// IsEmpty returns a bool which indicates if c is empty or not.
+func (c *TTLCache[K, T]) IsEmpty() bool {
+	return c.Len() == 0
+}
+
+// Capacity returns the max number of entries of c.
+func (c *TTLCache[K, T]) Capacity() int {
+	return c.capacity
+}
+
+// TTL returns the time to live of the entries of c.
+func (c *TTLCache[K, T]) TTL() time.Duration {
+	return c.ttl
+}
+
+// ContainsKey returns true if the key is present on c and is not expired.
+// It does not count as an use of the key.
+func (c *TTLCache[K, T]) ContainsKey(key K) bool {
+	c.purge()
+	return c.objects.ContainsKey(key)
+}
+
+// Keys returns a [list.List] which contains all keys of c, from the most recently used.
+func (c *TTLCache[K, T]) Keys() list.List[K] {
+	keys := list.NewArrayList[K]()
+	for i := range c.RangeIter() {
+		keys.Add(i)
+	}
+	return keys
+}
+
+// ToSlice returns a slice which contains all elements of c, from the most recently used.
+func (c *TTLCache[K, T]) ToSlice() []T {
+	slice := make([]T, 0, c.order.Len())
+	for _, i := range c.RangeIter() {
+		slice = append(slice, i)
+	}
+	return slice
+}
+
+// Get returns the element associated at the key and marks the key as the most recently used.
+// The method returns false if the key is not found or is expired.
+//
+// Get does not change the expiration of the key.
+func (c *TTLCache[K, T]) Get(key K) (T, bool) {
+
+	var result T
+
+	c.purge()
+	i, ok := c.objects.Get(key)
+	if !ok {
+		c.stats.Misses++
+		return result, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFirst(i.entry)
+	return i.element, true
+}
+
+// Peek returns the element associated at the key, without marking the key as used.
+// The method returns false if the key is not found or is expired.
+func (c *TTLCache[K, T]) Peek(key K) (T, bool) {
+
+	var result T
+
+	c.purge()
+	i, ok := c.objects.Get(key)
+	if !ok {
+		return result, false
+	}
+	return i.element, true
+}
+
+// Expiration returns the time at which the key expires.
+// The method returns false if the key is not found or is expired.
+func (c *TTLCache[K, T]) Expiration(key K) (time.Time, bool) {
+	c.purge()
+	i, ok := c.objects.Get(key)
+	if !ok {
+		return time.Time{}, false
+	}
+	return i.expire, true
+}
+
+// Put sets the element e at the key, marks the key as the most recently used and returns the overwritten value, if present.
+// If the element is not present or is expired, the method returns false.
+//
+// The time to live of the key starts again from the current time.
+// If the key is not present and c is full, the least recently used entry is evicted.
+func (c *TTLCache[K, T]) Put(key K, e T) (T, bool) {
+
+	var result T
+
+	c.purge()
+	expire := c.clock().Add(c.ttl)
+	if i, ok := c.objects.Get(key); ok {
+		result = i.element
+		i.element = e
+		i.expire = expire
+		c.order.MoveToFirst(i.entry)
+		c.timeouts.MoveToFirst(i.timeout)
+		return result, true
+	}
+	if c.order.Len() == c.capacity {
+		last := c.order.LastEntry().Element()
+		c.unlink(last)
+		c.stats.Evictions++
+		if c.evict != nil {
+			c.evict(last.key, last.element)
+		}
+	}
+	i := &item[K, T]{key: key, element: e, expire: expire}
+	i.entry = c.order.AddFirst(i)
+	i.timeout = c.timeouts.AddFirst(i)
+	c.objects.Put(key, i)
+	return result, false
+}
+
+// Remove removes the key from c and returns the value associated at the key.
+// It returns false if the key does not exists or is expired.
+func (c *TTLCache[K, T]) Remove(key K) (T, bool) {
+
+	var result T
+
+	c.purge()
+	i, ok := c.objects.Get(key)
+	if !ok {
+		return result, false
+	}
+	c.unlink(i)
+	return i.element, true
+}
+
+// OnEvict sets the function executed for every entry evicted or expired by c.
+// A nil fun removes the previous function.
+func (c *TTLCache[K, T]) OnEvict(fun func(key K, element T)) {
+	c.evict = fun
+}
+
+// Stats returns the statistics of c.
+func (c *TTLCache[K, T]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats sets all the statistics of c to zero.
+func (c *TTLCache[K, T]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// Each executes fun for all entries of c, from the most recently used.
+func (c *TTLCache[K, T]) Each(fun func(key K, element T)) {
+	for i, j := range c.RangeIter() {
+		fun(i, j)
+	}
+}
+
+// Clear removes all entries from c, without executing the function set by OnEvict.
+// The statistics of c are not modified.
+func (c *TTLCache[K, T]) Clear() {
+	c.objects = table.NewHashTable[K, *item[K, T]]()
+	c.order = list.NewLinkedList[*item[K, T]]()
+	c.timeouts = list.NewLinkedList[*item[K, T]]()
+}
+
+// RangeIter returns a function that allows to iterate a [TTLCache] using the range keyword.
+// The entries are iterated from the most recently used and the expired entries are removed before the iteration.
+//
+//	for i, j := range c.RangeIter() {
+//		// Code
+//	}
+//
+// It doesn't allow to modify c during the iteration.
+func (c *TTLCache[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		c.purge()
+		for _, i := range c.order.RangeIter() {
+			if !yield(i.key, i.element) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns true if c and st are both caches and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st, its capacity, the order of its entries and their expirations.
+func (c *TTLCache[K, T]) Equal(st any) bool {
+	return c != nil && equalCaches[K, T](c, st)
+}
+
+// Compare returns 0 if c and st have the same length,
+// -1 if c is shorten than st,
+// 1 if c is longer than st,
+// -2 if st is not a [Cache] or if one between c and st is nil.
+func (c *TTLCache[K, T]) Compare(st any) int {
+	if c == nil {
+		return -2
+	}
+	return compareCaches[K, T](c, st)
+}
+
+// Hash returns the hash code of c.
+func (c *TTLCache[K, T]) Hash() uint64 {
+	return hashCache[K, T](c)
+}
+
+// String returns a rapresentation of c in the form of a string.
+func (c *TTLCache[K, T]) String() string {
+	return cacheString[K, T]("TTLCache", c)
+}
+
+// MarshalJSON returns the JSON encoding of c, which is an array of [key, element] pairs starting from the most recently used.
+// The expirations of the keys are not encoded.
+func (c *TTLCache[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalCache[K, T](c)
+}
+
+// UnmarshalJSON replaces the entries of c with the ones decoded from the JSON array data.
+// The first pair becomes the most recently used and the time to live of all decoded keys starts from the current time.
+//
+// The method returns an error if c is the zero value, since its capacity is unknown.
+func (c *TTLCache[K, T]) UnmarshalJSON(data []byte) error {
+	keys, elements, err := unmarshalCache[K, T](data)
+	return fill[K, T](c, "TTLCache", keys, elements, err)
+}
+
+// MarshalBinary returns the binary encoding of c, which contains its entries as key and element pairs starting from the most recently used.
+// The expirations of the keys are not encoded.
+func (c *TTLCache[K, T]) MarshalBinary() ([]byte, error) {
+	return marshalBinaryCache[K, T](c)
+}
+
+// UnmarshalBinary replaces the entries of c with the ones decoded from the binary encoding data.
+// The first entry becomes the most recently used and the time to live of all decoded keys starts from the current time.
+//
+// The method returns an error if c is the zero value, since its capacity is unknown.
+func (c *TTLCache[K, T]) UnmarshalBinary(data []byte) error {
+	keys, elements, err := codec.UnmarshalBinaryEntries[K, T](data)
+	return fill[K, T](c, "TTLCache", keys, elements, err)
+}
+
+// GobEncode returns the binary encoding of c as [TTLCache.MarshalBinary].
+func (c *TTLCache[K, T]) GobEncode() ([]byte, error) {
+	return c.MarshalBinary()
+}
+
+// GobDecode decodes data into c as [TTLCache.UnmarshalBinary].
+func (c *TTLCache[K, T]) GobDecode(data []byte) error {
+	return c.UnmarshalBinary(data)
+}
+
+// purge removes the expired entries, starting from the one which has been written first.
+func (c *TTLCache[K, T]) purge() {
+	if c.clock == nil {
+		return
+	}
+	now := c.clock()
+	for entry := c.timeouts.LastEntry(); entry != nil && !now.Before(entry.Element().expire); entry = c.timeouts.LastEntry() {
+		last := entry.Element()
+		c.unlink(last)
+		c.stats.Expirations++
+		if c.evict != nil {
+			c.evict(last.key, last.element)
+		}
+	}
+}
+
+// unlink removes the item i from c.
+func (c *TTLCache[K, T]) unlink(i *item[K, T]) {
+	c.objects.Remove(i.key)
+	c.order.RemoveEntry(i.entry)
+	c.timeouts.RemoveEntry(i.timeout)
+}
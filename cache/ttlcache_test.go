@@ -0,0 +1,276 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+func TestNewTTLCache(t *testing.T) {
+
+	var cache *TTLCache[wrapper.Int, int] = NewTTLCache[wrapper.Int, int](3, time.Minute)
+
+	if cache.Capacity() != 3 || cache.TTL() != time.Minute || !cache.IsEmpty() || cache.Len() != 0 {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	cache.Put(1, 1)
+	if expiration, ok := cache.Expiration(1); !ok || expiration.Before(time.Now()) {
+		t.Log("expiration is", expiration)
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("NewTTLCache does not panic")
+			t.Fail()
+		}
+	}()
+	NewTTLCache[wrapper.Int, int](3, 0)
+}
+func TestPutTTLCache(t *testing.T) {
+
+	var clock *fakeClock = &fakeClock{now: time.Unix(0, 0)}
+	var cache *TTLCache[wrapper.Int, string] = NewTTLCacheWithClock[wrapper.Int, string](3, time.Minute, clock.Now)
+
+	if _, ok := cache.Put(1, "a"); ok {
+		t.Log("1 is present")
+		t.Fail()
+	}
+	clock.Advance(10 * time.Second)
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	if e, ok := cache.Put(1, "d"); !ok || e != "a" {
+		t.Log("overwritten element is", e)
+		t.Fail()
+	}
+	if expiration, _ := cache.Expiration(1); !expiration.Equal(time.Unix(70, 0)) {
+		t.Log("expiration is", expiration)
+		t.Fail()
+	}
+	var evicted []wrapper.Int
+	cache.OnEvict(func(key wrapper.Int, element string) {
+		evicted = append(evicted, key)
+	})
+	cache.Put(4, "e")
+	if !reflect.DeepEqual(evicted, []wrapper.Int{2}) || !reflect.DeepEqual(cache.ToSlice(), []string{"e", "d", "c"}) {
+		t.Log("evicted keys are", evicted, "cache is", cache)
+		t.Fail()
+	}
+	clock.Advance(time.Minute)
+	cache.Put(5, "f")
+	if !reflect.DeepEqual(evicted, []wrapper.Int{2, 3, 1, 4}) || !reflect.DeepEqual(cache.ToSlice(), []string{"f"}) {
+		t.Log("evicted keys are", evicted, "cache is", cache)
+		t.Fail()
+	}
+	if stats := cache.Stats(); stats.Evictions != 1 || stats.Expirations != 3 {
+		t.Log("stats are", stats)
+		t.Fail()
+	}
+}
+func TestGetTTLCache(t *testing.T) {
+
+	var clock *fakeClock = &fakeClock{now: time.Unix(0, 0)}
+	var cache *TTLCache[wrapper.Int, string] = NewTTLCacheWithClock[wrapper.Int, string](3, time.Minute, clock.Now)
+
+	cache.Put(1, "a")
+	clock.Advance(30 * time.Second)
+	cache.Put(2, "b")
+	if e, ok := cache.Get(1); !ok || e != "a" {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := cache.Peek(2); !ok || e != "b" {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if !cache.Keys().Equal(list.NewArrayList[wrapper.Int](1, 2)) {
+		t.Log("keys are", cache.Keys())
+		t.Fail()
+	}
+	clock.Advance(30 * time.Second)
+	if _, ok := cache.Get(1); ok || cache.ContainsKey(1) || !cache.ContainsKey(2) || cache.Len() != 1 {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	if _, ok := cache.Peek(1); ok {
+		t.Log("1 found")
+		t.Fail()
+	}
+	clock.Advance(30 * time.Second)
+	if !cache.IsEmpty() {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Expirations != 2 || stats.HitRate() != 0.5 {
+		t.Log("stats are", stats)
+		t.Fail()
+	}
+	cache.ResetStats()
+	if cache.Stats() != (Stats{}) {
+		t.Log("stats are", cache.Stats())
+		t.Fail()
+	}
+}
+func TestRemoveTTLCache(t *testing.T) {
+
+	var clock *fakeClock = &fakeClock{now: time.Unix(0, 0)}
+	var cache *TTLCache[wrapper.Int, string] = NewTTLCacheWithClock[wrapper.Int, string](3, time.Minute, clock.Now)
+
+	evicted := 0
+	cache.OnEvict(func(key wrapper.Int, element string) {
+		evicted++
+	})
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	if e, ok := cache.Remove(1); !ok || e != "a" {
+		t.Log("removed element is", e)
+		t.Fail()
+	}
+	if _, ok := cache.Remove(1); ok {
+		t.Log("1 removed")
+		t.Fail()
+	}
+	clock.Advance(time.Minute)
+	if _, ok := cache.Remove(2); ok || evicted != 1 {
+		t.Log("2 removed")
+		t.Fail()
+	}
+	cache.Put(3, "c")
+	cache.Clear()
+	if !cache.IsEmpty() || evicted != 1 {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+	cache.Put(4, "d")
+	if !reflect.DeepEqual(cache.ToSlice(), []string{"d"}) {
+		t.Log("cache is", cache)
+		t.Fail()
+	}
+}
+func TestRangeIterTTLCache(t *testing.T) {
+
+	var clock *fakeClock = &fakeClock{now: time.Unix(0, 0)}
+	var cache *TTLCache[wrapper.Int, string] = NewTTLCacheWithClock[wrapper.Int, string](3, time.Minute, clock.Now)
+
+	cache.Put(1, "a")
+	clock.Advance(30 * time.Second)
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	cache.Get(1)
+	var keys []wrapper.Int
+	for i := range cache.RangeIter() {
+		keys = append(keys, i)
+		if i == 3 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(keys, []wrapper.Int{1, 3}) {
+		t.Log("keys are", keys)
+		t.Fail()
+	}
+	clock.Advance(30 * time.Second)
+	var elements []string
+	cache.Each(func(key wrapper.Int, element string) {
+		elements = append(elements, element)
+	})
+	if !reflect.DeepEqual(elements, []string{"c", "b"}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+}
+func TestEqualTTLCache(t *testing.T) {
+
+	var clock *fakeClock = &fakeClock{now: time.Unix(0, 0)}
+	var cache *TTLCache[wrapper.Int, string] = NewTTLCacheWithClock[wrapper.Int, string](3, time.Minute, clock.Now)
+	var other *LRUCache[wrapper.Int, string] = NewLRUCache[wrapper.Int, string](3)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	other.Put(1, "a")
+	other.Put(2, "b")
+	if !cache.Equal(other) || cache.Hash() != other.Hash() || cache.Compare(other) != 0 {
+		t.Log("caches are not equal")
+		t.Fail()
+	}
+	if cache.String() != "TTLCache[wrapper.Int, string][2: b, 1: a]" {
+		t.Log("string is", cache)
+		t.Fail()
+	}
+	clock.Advance(time.Minute)
+	if cache.Equal(other) || cache.Compare(other) != -1 {
+		t.Log("caches are equal")
+		t.Fail()
+	}
+}
+func TestJSONTTLCache(t *testing.T) {
+
+	var clock *fakeClock = &fakeClock{now: time.Unix(0, 0)}
+	var cache *TTLCache[wrapper.Int, string] = NewTTLCacheWithClock[wrapper.Int, string](3, time.Minute, clock.Now)
+	var result *TTLCache[wrapper.Int, string] = NewTTLCacheWithClock[wrapper.Int, string](3, time.Minute, clock.Now)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	data, err := json.Marshal(cache)
+	if err != nil || string(data) != `[[2,"b"],[1,"a"]]` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	clock.Advance(30 * time.Second)
+	if err := json.Unmarshal(data, result); err != nil || !reflect.DeepEqual(result.ToSlice(), []string{"b", "a"}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if expiration, _ := result.Expiration(1); !expiration.Equal(time.Unix(90, 0)) {
+		t.Log("expiration is", expiration)
+		t.Fail()
+	}
+	var zero TTLCache[wrapper.Int, string]
+	if err := json.Unmarshal(data, &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestBinaryTTLCache(t *testing.T) {
+
+	var cache *TTLCache[wrapper.Int, string] = NewTTLCache[wrapper.Int, string](3, time.Minute)
+	var result *TTLCache[wrapper.Int, string] = NewTTLCache[wrapper.Int, string](3, time.Minute)
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	data, err := cache.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !reflect.DeepEqual(result.Keys().ToSlice(), []wrapper.Int{2, 1}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(cache); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	result = NewTTLCache[wrapper.Int, string](1, time.Minute)
+	if err := gob.NewDecoder(&buffer).Decode(result); err != nil || !reflect.DeepEqual(result.ToSlice(), []string{"b"}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,59 @@
+package structures
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// Collector accumulates a sequence of elements of type T into a result of type R.
+//
+// The collectors of the library are returned by the To functions of each package, like list.ToArrayList or set.ToHashSet,
+// and they are used by the CollectTo functions of the streams, which do not need the reflection based constructor.
+type Collector[T any, R any] struct {
+	// contains filtered or unexported fields
+	collect func(seq iter.Seq[T]) R
+}
+
+// NewCollector returns a new [Collector].
+//
+// supplier creates the initial accumulation value, accumulator adds an element to it and returns the updated value
+// and finisher converts the final accumulation value into the result.
+func NewCollector[T any, A any, R any](supplier func() A, accumulator func(result A, element T) A, finisher func(result A) R) Collector[T, R] {
+	return Collector[T, R]{
+		collect: func(seq iter.Seq[T]) R {
+			result := supplier()
+			for i := range seq {
+				result = accumulator(result, i)
+			}
+			return finisher(result)
+		},
+	}
+}
+
+// Collect accumulates the elements of seq and returns the result.
+func (c Collector[T, R]) Collect(seq iter.Seq[T]) R {
+	return c.collect(seq)
+}
+
+// Joining returns a [Collector] which concatenates the string representations of the elements, separated by separator.
+func Joining[T any](separator string) Collector[T, string] {
+	return NewCollector(func() []string {
+		return []string{}
+	}, func(result []string, element T) []string {
+		return append(result, fmt.Sprint(element))
+	}, func(result []string) string {
+		return strings.Join(result, separator)
+	})
+}
+
+// Counting returns a [Collector] which counts the elements.
+func Counting[T any]() Collector[T, int] {
+	return NewCollector(func() int {
+		return 0
+	}, func(result int, _ T) int {
+		return result + 1
+	}, func(result int) int {
+		return result
+	})
+}
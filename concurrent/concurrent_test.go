@@ -0,0 +1,61 @@
+package concurrent
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/queue"
+	"github.com/potex02/structures/set"
+	"github.com/potex02/structures/stack"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestBinary(t *testing.T) {
+
+	var tests []binaryTest = []binaryTest{
+		{"SyncList", NewSyncList[float64](list.NewArrayList(1.5, -2, 3.25)), NewSyncList[float64](list.NewLinkedList[float64](4)), NewSyncList[float64](list.NewArrayList[float64]())},
+		{"SyncQueue", NewSyncQueue[bool](queue.NewQueue(true, false, true)), NewSyncQueue[bool](queue.NewQueue(false)), NewSyncQueue[bool](queue.NewQueue[bool]())},
+		{"SyncSet", NewSyncSet[wrapper.Complex128](set.NewHashSet[wrapper.Complex128](1+2i, -3i)), NewSyncSet[wrapper.Complex128](set.NewHashSet[wrapper.Complex128](4)), NewSyncSet[wrapper.Complex128](set.NewHashSet[wrapper.Complex128]())},
+		{"SyncStack", NewSyncStack[uint8](stack.NewStack[uint8](1, 2, 255)), NewSyncStack[uint8](stack.NewStack[uint8](4)), NewSyncStack[uint8](stack.NewStack[uint8]())},
+		{"SyncTable", NewSyncTable[wrapper.String, int](table.NewTreeTableFromSlice([]wrapper.String{"a", "b"}, []int{1, -2})), NewSyncTable[wrapper.String, int](table.NewHashTable[wrapper.String, int]()), NewSyncTable[wrapper.String, int](table.NewTreeTable[wrapper.String, int]())},
+	}
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
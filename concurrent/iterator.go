@@ -0,0 +1,198 @@
+package concurrent
+
+import (
+	"slices"
+
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/set"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ list.Iterator[int] = NewSyncListIterator[int](NewSyncList[int](list.NewArrayList[int]()))
+var _ set.Iterator[wrapper.Int] = NewSyncSetIterator[wrapper.Int](NewSyncSet[wrapper.Int](set.NewHashSet[wrapper.Int]()))
+var _ table.Iterator[wrapper.Int, int] = NewSyncTableIterator[wrapper.Int, int](NewSyncTable[wrapper.Int, int](table.NewHashTable[wrapper.Int, int]()))
+
+// SyncListIterator is an iterator of a [SyncList].
+//
+// The iterator works on a snapshot of the list, taken when it is created.
+// The elements removed through the iterator are removed from the snapshot too,
+// so the index of the iterator is the index of its element in the list.
+type SyncListIterator[T any] struct {
+	// contains filtered or unexported fields
+	list    *SyncList[T]
+	objects []T
+	index   int
+}
+
+// NewSyncListIterator returns a new [SyncListIterator] associated at the list parameter.
+func NewSyncListIterator[T any](list *SyncList[T]) list.Iterator[T] {
+	return &SyncListIterator[T]{list: list, objects: list.ToSlice(), index: 0}
+}
+
+// NewSyncListReverseIterator returns a new reverse [SyncListIterator] associated at the list parameter.
+func NewSyncListReverseIterator[T any](list *SyncList[T]) list.Iterator[T] {
+	objects := list.ToSlice()
+	return &SyncListIterator[T]{list: list, objects: objects, index: len(objects) - 1}
+}
+
+// Elements returns the element of i.
+func (i *SyncListIterator[T]) Element() T {
+	if i.End() {
+
+		var result T
+
+		return result
+	}
+	return i.objects[i.index]
+}
+
+// Index returns the index of the element of i.
+func (i *SyncListIterator[T]) Index() int {
+	return i.index
+}
+
+// Remove removes the element from the list and returns the iterator of the next element.
+// If the list has been modified by another goroutine and the element is no longer at the index of i,
+// the list is not modified.
+//
+// As for [list.ArrayListIterator], after the removal of an element during a reverse iteration,
+// Prev returns the iterator of the element before the removed one.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+func (i *SyncListIterator[T]) Remove() list.Iterator[T] {
+	if !i.End() {
+		i.list.remove(i.index, i.objects[i.index])
+		i.objects = slices.Delete(i.objects, i.index, i.index+1)
+		i.index--
+	}
+	return i.Next()
+}
+
+// Prev returns the iterator of the previous element.
+func (i *SyncListIterator[T]) Prev() list.Iterator[T] {
+	i.index--
+	return i
+}
+
+// Next returns the iterator of the next element.
+func (i *SyncListIterator[T]) Next() list.Iterator[T] {
+	i.index++
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *SyncListIterator[T]) End() bool {
+	return i.index < 0 || i.index >= len(i.objects)
+}
+
+// SyncSetIterator is an iterator of a [SyncSet].
+//
+// The iterator works on a snapshot of the set, taken when it is created.
+type SyncSetIterator[T any] struct {
+	// contains filtered or unexported fields
+	set     *SyncSet[T]
+	objects []T
+	index   int
+}
+
+// NewSyncSetIterator returns a new [SyncSetIterator] associated at the set parameter.
+func NewSyncSetIterator[T any](set *SyncSet[T]) set.Iterator[T] {
+	return &SyncSetIterator[T]{set: set, objects: set.ToSlice(), index: 0}
+}
+
+// Elements returns the element of i.
+func (i *SyncSetIterator[T]) Element() T {
+	if i.End() {
+
+		var result T
+
+		return result
+	}
+	return i.objects[i.index]
+}
+
+// Remove removes the element from the set and returns the iterator of the next element.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+func (i *SyncSetIterator[T]) Remove() set.Iterator[T] {
+	if !i.End() {
+		i.set.Remove(i.objects[i.index])
+	}
+	return i.Next()
+}
+
+// Next returns the iterator of the next element.
+func (i *SyncSetIterator[T]) Next() set.Iterator[T] {
+	i.index++
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *SyncSetIterator[T]) End() bool {
+	return i.index >= len(i.objects)
+}
+
+// SyncTableIterator is an iterator of a [SyncTable].
+//
+// The iterator works on a snapshot of the table, taken when it is created.
+type SyncTableIterator[K any, T any] struct {
+	// contains filtered or unexported fields
+	table   *SyncTable[K, T]
+	objects []*table.Entry[K, T]
+	index   int
+}
+
+// NewSyncTableIterator returns a new [SyncTableIterator] associated at the table parameter.
+func NewSyncTableIterator[K any, T any](table *SyncTable[K, T]) table.Iterator[K, T] {
+	return &SyncTableIterator[K, T]{table: table, objects: table.entries(), index: 0}
+}
+
+// Key returns the key of the element of i.
+func (i *SyncTableIterator[K, T]) Key() K {
+	if i.End() {
+
+		var result K
+
+		return result
+	}
+	return i.objects[i.index].Key()
+}
+
+// Elements returns the element of i.
+func (i *SyncTableIterator[K, T]) Element() T {
+	if i.End() {
+
+		var result T
+
+		return result
+	}
+	return i.objects[i.index].Element()
+}
+
+// Remove removes the key of the element from the table and returns the iterator of the next element.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+func (i *SyncTableIterator[K, T]) Remove() table.Iterator[K, T] {
+	if !i.End() {
+		i.table.Remove(i.objects[i.index].Key())
+	}
+	return i.Next()
+}
+
+// Next returns the iterator of the next element.
+func (i *SyncTableIterator[K, T]) Next() table.Iterator[K, T] {
+	i.index++
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *SyncTableIterator[K, T]) End() bool {
+	return i.index >= len(i.objects)
+}
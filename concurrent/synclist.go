@@ -0,0 +1,386 @@
+// Package concurrent implements wrappers which make the structures of the library safe for concurrent use.
+package concurrent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util"
+)
+
+var _ list.List[int] = NewSyncList[int](list.NewArrayList[int]())
+
+// SyncList is a [list.List] safe for concurrent use, which guards another list with a [sync.RWMutex].
+//
+// Iter, IterReverse, RangeIter, RangeIterReverse, Each and Stream work on a snapshot of the list taken when they are called,
+// so they are not affected by the modifications done by other goroutines and they can call the methods of the list.
+type SyncList[T any] struct {
+	// contains filtered or unexported fields
+	objects list.List[T]
+	lock    sync.RWMutex
+}
+
+// NewSyncList returns a new [SyncList] which guards l.
+//
+// l must not be used directly after this call.
+func NewSyncList[T any](l list.List[T]) *SyncList[T] {
+	return &SyncList[T]{objects: l}
+}
+
+// Len returns the length of l.
+func (l *SyncList[T]) Len() int {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.Len()
+}
+
+// IsEmpty returns a bool which indicate if l is empty or not.
+func (l *SyncList[T]) IsEmpty() bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.IsEmpty()
+}
+
+// Contains returns if e is present in l.
+func (l *SyncList[T]) Contains(e T) bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.Contains(e)
+}
+
+// IndexOf returns the first position of e in l.
+// If e is not present, the result is -1.
+func (l *SyncList[T]) IndexOf(e T) int {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.IndexOf(e)
+}
+
+// LastIndexOf returns the last position of e in l.
+// If e is not present, the result is -1.
+func (l *SyncList[T]) LastIndexOf(e T) int {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.LastIndexOf(e)
+}
+
+// ToSlice returns a slice which contains all elements of l.
+func (l *SyncList[T]) ToSlice() []T {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.ToSlice()
+}
+
+// Get returns the elements at the specifies index.
+// It returns an error if the the index is out of bounds.
+func (l *SyncList[T]) Get(index int) (T, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.Get(index)
+}
+
+// GetDefault returns the elements at the specifies index.
+// It returns the T zero value if the the index is out of bounds.
+func (l *SyncList[T]) GetDefault(index int) T {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.GetDefault(index)
+}
+
+// GetDefaultValue returns the elements at the specifies index.
+// It returns value if the the index is out of bounds.
+func (l *SyncList[T]) GetDefaultValue(index int, value T) T {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.GetDefaultValue(index, value)
+}
+
+// Set sets the value of element at the specified index and returns the overwritten value.
+// It returns an error if the the index is out of bounds.
+func (l *SyncList[T]) Set(index int, e T) (T, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.objects.Set(index, e)
+}
+
+// Add adds the elements e at the end of l.
+func (l *SyncList[T]) Add(e ...T) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.objects.Add(e...)
+}
+
+// AddAtIndex adds the elements e at the specified index.
+// It returns an error if the the index is out of bounds.
+func (l *SyncList[T]) AddAtIndex(index int, e ...T) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.objects.AddAtIndex(index, e...)
+}
+
+// AddSlice adds the elements of e at the end of l.
+func (l *SyncList[T]) AddSlice(e []T) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.objects.AddSlice(e)
+}
+
+// AddSliceAtIndex adds the elements of e at the specified index.
+// It returns an error if the the index is out of bounds.
+func (l *SyncList[T]) AddSliceAtIndex(index int, e []T) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.objects.AddSliceAtIndex(index, e)
+}
+
+// AddIfAbsent adds e at the end of l if it is not present and returns true.
+// If e is already present, it returns false.
+func (l *SyncList[T]) AddIfAbsent(e T) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.objects.Contains(e) {
+		return false
+	}
+	l.objects.Add(e)
+	return true
+}
+
+// Remove removes the element at specified index and return the removed value.
+// It returns an error if the the index is out of bounds.
+func (l *SyncList[T]) Remove(index int) (T, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.objects.Remove(index)
+}
+
+// RemoveElement removes the element e from l if it is present.
+// In that case, the method returns true, otherwhise it returns false.
+func (l *SyncList[T]) RemoveElement(e T) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.objects.RemoveElement(e)
+}
+
+// CompareAndSwap sets e at the specified index if the element at that index is equal to old.
+// It returns false if the index is out of bounds or if the element is not equal to old.
+func (l *SyncList[T]) CompareAndSwap(index int, old T, e T) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	element, err := l.objects.Get(index)
+	if err != nil || !util.EqualFunction(old)(element) {
+		return false
+	}
+	l.objects.Set(index, e)
+	return true
+}
+
+// Clear removes all element from l.
+func (l *SyncList[T]) Clear() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.objects.Clear()
+}
+
+// View executes fun holding the read lock of l, so the list passed to fun is not modified by other goroutines.
+//
+// fun must not modify the list, call the methods of l or keep a reference to the list.
+func (l *SyncList[T]) View(fun func(list list.List[T])) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	fun(l.objects)
+}
+
+// Update executes fun holding the write lock of l, so that compound operations on the list passed to fun are atomic.
+//
+// fun must not call the methods of l or keep a reference to the list.
+func (l *SyncList[T]) Update(fun func(list list.List[T])) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	fun(l.objects)
+}
+
+// Each executes fun for all elements of a snapshot of l.
+func (l *SyncList[T]) Each(fun func(index int, element T)) {
+	for i, j := range l.ToSlice() {
+		fun(i, j)
+	}
+}
+
+// Stream returns a [list.Stream] rapresenting a snapshot of l.
+func (l *SyncList[T]) Stream() *list.Stream[T] {
+	return l.snapshot().Stream()
+}
+
+// Sort sorts the elements of l.
+//
+// This method panics if T does not implement [util.Comparer]
+func (l *SyncList[T]) Sort() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.objects.Sort()
+}
+
+// SortFunc sorts the elements of l as determined by the less function.
+func (l *SyncList[T]) SortFunc(less func(i T, j T) int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.objects.SortFunc(less)
+}
+
+// Iter returns a [list.Iterator] which permits to iterate a snapshot of l.
+//
+//	for i := l.Iter(); !i.End(); i = i.Next() {
+//		element := i.Element()
+//		index := i.Index()
+//		// Code
+//	}
+func (l *SyncList[T]) Iter() list.Iterator[T] {
+	return NewSyncListIterator(l)
+}
+
+// IterReverse returns a [list.Iterator] which permits to iterate a snapshot of l in reverse order.
+//
+//	for i := l.IterReverse(); !i.End(); i = i.Prev() {
+//		element := i.Element()
+//		index := i.Index()
+//		// Code
+//	}
+func (l *SyncList[T]) IterReverse() list.Iterator[T] {
+	return NewSyncListReverseIterator(l)
+}
+
+// RangeIter returns a function that allows to iterate a snapshot of l using the range keyword.
+//
+//	for i, j := range l.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [SyncList.Iter], it doesn't allow to remove elements during the iteration.
+func (l *SyncList[T]) RangeIter() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		for i, j := range l.ToSlice() {
+			if !yield(i, j) {
+				return
+			}
+		}
+	}
+}
+
+// RangeIterReverse returns a function that allows to iterate a snapshot of l using the range keyword in reverse order.
+//
+//	for i, j := range l.RangeIterReverse() {
+//		// Code
+//	}
+//
+// Unlike [SyncList.IterReverse], it doesn't allow to remove elements during the iteration.
+func (l *SyncList[T]) RangeIterReverse() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		slice := l.ToSlice()
+		for i := len(slice) - 1; i >= 0; i-- {
+			if !yield(i, slice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns true if l and st are both lists and their elements are equals.
+// In any other case, it returns false.
+func (l *SyncList[T]) Equal(st any) bool {
+	if other, ok := st.(*SyncList[T]); ok && other != nil {
+		st = other.snapshot()
+	}
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.Equal(st)
+}
+
+// Compare returns 0 if l and st are equals,
+// -1 if l is less than st,
+// 1 if l is greater than st,
+// -2 if st is not a list or if one between l and st is nil.
+//
+// The comparison is done by the list guarded by l.
+func (l *SyncList[T]) Compare(st any) int {
+	if other, ok := st.(*SyncList[T]); ok && other != nil {
+		st = other.snapshot()
+	}
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.Compare(st)
+}
+
+// Hash returns the hash code of l.
+func (l *SyncList[T]) Hash() uint64 {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.Hash()
+}
+
+// Copy returns a [SyncList] guarding a copy of the list guarded by l.
+func (l *SyncList[T]) Copy() list.List[T] {
+	return NewSyncList(l.snapshot())
+}
+
+// String returns a rapresentation of l in the form of a string.
+func (l *SyncList[T]) String() string {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return fmt.Sprintf("Sync%v", l.objects)
+}
+
+// MarshalJSON returns the JSON encoding of the guarded list.
+func (l *SyncList[T]) MarshalJSON() ([]byte, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return json.Marshal(l.objects)
+}
+
+// UnmarshalJSON replaces the elements of the guarded list with the ones decoded from data.
+func (l *SyncList[T]) UnmarshalJSON(data []byte) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return json.Unmarshal(data, l.objects)
+}
+
+// MarshalBinary returns the binary encoding of the guarded list.
+func (l *SyncList[T]) MarshalBinary() ([]byte, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return codec.Marshal(l.objects)
+}
+
+// UnmarshalBinary replaces the elements of the guarded list with the ones decoded from data.
+func (l *SyncList[T]) UnmarshalBinary(data []byte) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return codec.Unmarshal(data, l.objects)
+}
+
+// GobEncode returns the binary encoding of l as [SyncList.MarshalBinary].
+func (l *SyncList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// GobDecode decodes data into l as [SyncList.UnmarshalBinary].
+func (l *SyncList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+func (l *SyncList[T]) snapshot() list.List[T] {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return l.objects.Copy()
+}
+
+// remove removes the element at index from l, only if it is e.
+func (l *SyncList[T]) remove(index int, e T) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if element, err := l.objects.Get(index); err == nil && util.EqualFunction(e)(element) {
+		l.objects.Remove(index)
+	}
+}
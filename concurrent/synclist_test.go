@@ -0,0 +1,153 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/potex02/structures/list"
+)
+
+func TestNewSyncList(t *testing.T) {
+
+	var l list.List[int] = NewSyncList[int](list.NewArrayList[int](1, 2, 3))
+
+	if l.Len() != 3 {
+		t.Log("length is", l.Len())
+		t.Fail()
+	}
+	if !l.Equal(list.NewLinkedList[int](1, 2, 3)) || !l.Equal(l) || !l.Equal(l.Copy()) {
+		t.Log("lists are not equals")
+		t.Fail()
+	}
+	if l.String() != "SyncArrayList[int][1 2 3]" {
+		t.Log("string is", l.String())
+		t.Fail()
+	}
+}
+func TestConcurrentSyncList(t *testing.T) {
+
+	var l *SyncList[int] = NewSyncList[int](list.NewArrayList[int]())
+
+	var wg sync.WaitGroup
+	for i := 0; i != 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j != 100; j++ {
+				l.Add(j)
+				l.AddIfAbsent(-i - 1)
+				l.Contains(j)
+				for range l.RangeIter() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if l.Len() != 808 {
+		t.Log("length is", l.Len())
+		t.Fail()
+	}
+}
+func TestCompareAndSwapSyncList(t *testing.T) {
+
+	var l *SyncList[int] = NewSyncList[int](list.NewArrayList[int](1, 2, 3))
+
+	if l.CompareAndSwap(0, 2, 5) || l.CompareAndSwap(3, 2, 5) {
+		t.Log("swap is done")
+		t.Fail()
+	}
+	if !l.CompareAndSwap(1, 2, 5) || l.GetDefault(1) != 5 {
+		t.Log("list is", l)
+		t.Fail()
+	}
+	if l.AddIfAbsent(5) || !l.AddIfAbsent(4) {
+		t.Log("list is", l)
+		t.Fail()
+	}
+	l.Update(func(list list.List[int]) {
+		list.Add(list.Len())
+	})
+	if !l.Equal(list.NewArrayList[int](1, 5, 3, 4, 4)) {
+		t.Log("list is", l)
+		t.Fail()
+	}
+}
+func TestIterSyncList(t *testing.T) {
+
+	var l *SyncList[int] = NewSyncList[int](list.NewArrayList[int](1, 2, 3, 4))
+
+	j := 0
+	for i := l.Iter(); !i.End(); i = i.Next() {
+		if i.Element()%2 == 0 {
+			i = i.Remove()
+			l.Add(10)
+		}
+		j++
+	}
+	if j != 3 || !l.Equal(list.NewArrayList[int](1, 3, 10, 10)) {
+		t.Log("list is", l, j)
+		t.Fail()
+	}
+	j = 4
+	for i := l.IterReverse(); !i.End(); i = i.Prev() {
+		j--
+		if i.Index() != j {
+			t.Log("index is", i.Index())
+			t.Fail()
+		}
+	}
+	l.Each(func(index int, element int) {
+		l.Set(index, element+1)
+	})
+	if result := l.Stream().Count(func(index int, element int) bool {
+		return element == 11
+	}); result != 2 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestRemoveReverseSyncList(t *testing.T) {
+
+	var l *SyncList[int] = NewSyncList[int](list.NewArrayList[int](1, 2, 3, 4, 5))
+	var expected *list.ArrayList[int] = list.NewArrayList[int](1, 2, 3, 4, 5)
+
+	steps := 0
+	for i := l.IterReverse(); !i.End() && steps != 50; i = i.Prev() {
+		if i.Element()%2 == 0 {
+			i = i.Remove()
+		}
+		steps++
+	}
+	for i := expected.IterReverse(); !i.End(); i = i.Prev() {
+		if i.Element()%2 == 0 {
+			i = i.Remove()
+		}
+	}
+	if steps != 5 || !l.Equal(expected) || !l.Equal(list.NewArrayList[int](1, 3, 5)) {
+		t.Log("list is", l, "steps are", steps)
+		t.Fail()
+	}
+	l = NewSyncList[int](list.NewArrayList[int](4, 4))
+	i := l.Iter()
+	l.Set(0, 7)
+	if i = i.Remove(); i.Element() != 4 || !l.Equal(list.NewArrayList[int](7, 4)) {
+		t.Log("list is", l)
+		t.Fail()
+	}
+}
+func TestJSONSyncList(t *testing.T) {
+
+	var l *SyncList[int] = NewSyncList[int](list.NewArrayList(1, 2, 3))
+
+	data, err := json.Marshal(l)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result := NewSyncList[int](list.NewLinkedList[int]())
+	if err := json.Unmarshal(data, result); err != nil || !result.Equal(l) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,197 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/queue"
+)
+
+var _ queue.BaseQueue[int] = NewSyncQueue[int](queue.NewQueue[int]())
+
+// SyncQueue is a [queue.BaseQueue] safe for concurrent use, which guards another queue with a [sync.RWMutex].
+type SyncQueue[T any] struct {
+	// contains filtered or unexported fields
+	objects queue.BaseQueue[T]
+	lock    sync.RWMutex
+}
+
+// NewSyncQueue returns a new [SyncQueue] which guards q.
+//
+// q must not be used directly after this call.
+func NewSyncQueue[T any](q queue.BaseQueue[T]) *SyncQueue[T] {
+	return &SyncQueue[T]{objects: q}
+}
+
+// Len returns the length of q.
+func (q *SyncQueue[T]) Len() int {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.objects.Len()
+}
+
+// IsEmpty returns a bool which indicate if q is empty or not.
+func (q *SyncQueue[T]) IsEmpty() bool {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.objects.IsEmpty()
+}
+
+// Head returns the head element of q.
+// The method returns false if q is empty.
+func (q *SyncQueue[T]) Head() (T, bool) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.objects.Head()
+}
+
+// Tail returns the tail element of q.
+// The method returns false if q is empty.
+func (q *SyncQueue[T]) Tail() (T, bool) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.objects.Tail()
+}
+
+// ToSlice returns a slice which contains all elements of q.
+func (q *SyncQueue[T]) ToSlice() []T {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.objects.ToSlice()
+}
+
+// Push adds the elements e at the tail of q.
+func (q *SyncQueue[T]) Push(e ...T) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.objects.Push(e...)
+}
+
+// Pop removes an element from the head of q and returns the removed element.
+// The method returns false if q is empty.
+func (q *SyncQueue[T]) Pop() (T, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.objects.Pop()
+}
+
+// PopIf removes the head element of q if it satisfies fun and returns it.
+// The method returns false if q is empty or if the head element does not satisfy fun.
+func (q *SyncQueue[T]) PopIf(fun func(element T) bool) (T, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if element, ok := q.objects.Head(); !ok || !fun(element) {
+
+		var result T
+
+		return result, false
+	}
+	return q.objects.Pop()
+}
+
+// Drain removes all elements from q and returns them in the order they are popped.
+func (q *SyncQueue[T]) Drain() []T {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	result := make([]T, 0, q.objects.Len())
+	for element, ok := q.objects.Pop(); ok; element, ok = q.objects.Pop() {
+		result = append(result, element)
+	}
+	return result
+}
+
+// Clear removes all element from q.
+func (q *SyncQueue[T]) Clear() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.objects.Clear()
+}
+
+// Update executes fun holding the write lock of q, so that compound operations on the queue passed to fun are atomic.
+//
+// fun must not call the methods of q or keep a reference to the queue.
+func (q *SyncQueue[T]) Update(fun func(queue queue.BaseQueue[T])) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	fun(q.objects)
+}
+
+// Equal returns true if q and st are both queues and their elements are equals.
+// In any other case, it returns false.
+func (q *SyncQueue[T]) Equal(st any) bool {
+	if other, ok := st.(*SyncQueue[T]); ok && other != nil {
+		st = queue.NewQueueFromSlice(other.ToSlice())
+	}
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.objects.Equal(st)
+}
+
+// Compare returns 0 if q and st are equals,
+// -1 if q is less than st,
+// 1 if q is greater than st,
+// -2 if st is not a queue or if one between q and st is nil.
+//
+// The comparison is done by the queue guarded by q.
+func (q *SyncQueue[T]) Compare(st any) int {
+	if other, ok := st.(*SyncQueue[T]); ok && other != nil {
+		st = queue.NewQueueFromSlice(other.ToSlice())
+	}
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.objects.Compare(st)
+}
+
+// Hash returns the hash code of q.
+func (q *SyncQueue[T]) Hash() uint64 {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.objects.Hash()
+}
+
+// String returns a rapresentation of q in the form of a string.
+func (q *SyncQueue[T]) String() string {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return fmt.Sprintf("Sync%v", q.objects)
+}
+
+// MarshalJSON returns the JSON encoding of the guarded queue.
+func (q *SyncQueue[T]) MarshalJSON() ([]byte, error) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return json.Marshal(q.objects)
+}
+
+// UnmarshalJSON replaces the elements of the guarded queue with the ones decoded from data.
+func (q *SyncQueue[T]) UnmarshalJSON(data []byte) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return json.Unmarshal(data, q.objects)
+}
+
+// MarshalBinary returns the binary encoding of the guarded queue.
+func (q *SyncQueue[T]) MarshalBinary() ([]byte, error) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return codec.Marshal(q.objects)
+}
+
+// UnmarshalBinary replaces the elements of the guarded queue with the ones decoded from data.
+func (q *SyncQueue[T]) UnmarshalBinary(data []byte) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return codec.Unmarshal(data, q.objects)
+}
+
+// GobEncode returns the binary encoding of q as [SyncQueue.MarshalBinary].
+func (q *SyncQueue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// GobDecode decodes data into q as [SyncQueue.UnmarshalBinary].
+func (q *SyncQueue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
@@ -0,0 +1,88 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/potex02/structures/queue"
+)
+
+func TestNewSyncQueue(t *testing.T) {
+
+	var q queue.BaseQueue[int] = NewSyncQueue[int](queue.NewQueue[int](1, 2, 3))
+
+	if e, ok := q.Head(); !ok || e != 1 || q.Len() != 3 {
+		t.Log("queue is", q)
+		t.Fail()
+	}
+	if !q.Equal(queue.NewQueue[int](1, 2, 3)) || !q.Equal(q) || q.Compare(queue.NewQueue[int](1, 2)) != 1 {
+		t.Log("queues are not equals")
+		t.Fail()
+	}
+}
+func TestConcurrentSyncQueue(t *testing.T) {
+
+	var q *SyncQueue[int] = NewSyncQueue[int](queue.NewQueue[int]())
+
+	var wg sync.WaitGroup
+	popped := make([]int, 4)
+	for i := 0; i != 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j != 100; j++ {
+				q.Push(j)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j != 50; j++ {
+				if _, ok := q.Pop(); ok {
+					popped[i]++
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	sum := 0
+	for _, i := range popped {
+		sum += i
+	}
+	if q.Len()+sum != 400 {
+		t.Log("length is", q.Len(), sum)
+		t.Fail()
+	}
+	if result := q.Drain(); len(result)+sum != 400 || !q.IsEmpty() {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	q.Push(1, 2)
+	if _, ok := q.PopIf(func(element int) bool {
+		return element == 2
+	}); ok {
+		t.Log("2 is popped")
+		t.Fail()
+	}
+	if e, ok := q.PopIf(func(element int) bool {
+		return element == 1
+	}); !ok || e != 1 {
+		t.Log("popped element is", e)
+		t.Fail()
+	}
+}
+func TestJSONSyncQueue(t *testing.T) {
+
+	var q *SyncQueue[int] = NewSyncQueue[int](queue.NewQueue(1, 2, 3))
+
+	data, err := json.Marshal(q)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result := NewSyncQueue[int](queue.NewQueue[int]())
+	if err := json.Unmarshal(data, result); err != nil || !result.Equal(q) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
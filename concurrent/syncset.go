@@ -0,0 +1,245 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/set"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ set.Set[wrapper.Int] = NewSyncSet[wrapper.Int](set.NewHashSet[wrapper.Int]())
+
+// SyncSet is a [set.Set] safe for concurrent use, which guards another set with a [sync.RWMutex].
+//
+// Iter, RangeIter, Each and Stream work on a snapshot of the set taken when they are called,
+// so they are not affected by the modifications done by other goroutines and they can call the methods of the set.
+type SyncSet[T any] struct {
+	// contains filtered or unexported fields
+	objects set.Set[T]
+	lock    sync.RWMutex
+}
+
+// NewSyncSet returns a new [SyncSet] which guards s.
+//
+// s must not be used directly after this call.
+func NewSyncSet[T any](s set.Set[T]) *SyncSet[T] {
+	return &SyncSet[T]{objects: s}
+}
+
+// Len returns the length of s.
+func (s *SyncSet[T]) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Len()
+}
+
+// IsEmpty returns a bool which indicate if s is empty or not.
+func (s *SyncSet[T]) IsEmpty() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.IsEmpty()
+}
+
+// Contains returns if e is present in s.
+func (s *SyncSet[T]) Contains(e T) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Contains(e)
+}
+
+// ToSlice returns a slice which contains all elements of s.
+func (s *SyncSet[T]) ToSlice() []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.ToSlice()
+}
+
+// Add adds the elements e at s.
+func (s *SyncSet[T]) Add(e ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.objects.Add(e...)
+}
+
+// AddSlice adds the elements of e at s.
+func (s *SyncSet[T]) AddSlice(e []T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.objects.AddSlice(e)
+}
+
+// AddIfAbsent adds e at s if it is not present and returns true.
+// If e is already present, it returns false.
+func (s *SyncSet[T]) AddIfAbsent(e T) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.objects.Contains(e) {
+		return false
+	}
+	s.objects.Add(e)
+	return true
+}
+
+// Remove removes the element e from s if it is present.
+// In that case, the method returns true, otherwhise it returns false.
+func (s *SyncSet[T]) Remove(e T) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.objects.Remove(e)
+}
+
+// Clear removes all element from s.
+func (s *SyncSet[T]) Clear() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.objects.Clear()
+}
+
+// View executes fun holding the read lock of s, so the set passed to fun is not modified by other goroutines.
+//
+// fun must not modify the set, call the methods of s or keep a reference to the set.
+func (s *SyncSet[T]) View(fun func(set set.Set[T])) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	fun(s.objects)
+}
+
+// Update executes fun holding the write lock of s, so that compound operations on the set passed to fun are atomic.
+//
+// fun must not call the methods of s or keep a reference to the set.
+func (s *SyncSet[T]) Update(fun func(set set.Set[T])) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	fun(s.objects)
+}
+
+// Each executes fun for all elements of a snapshot of s.
+func (s *SyncSet[T]) Each(fun func(element T)) {
+	for _, i := range s.ToSlice() {
+		fun(i)
+	}
+}
+
+// Stream returns a [set.Stream] rapresenting a snapshot of s.
+func (s *SyncSet[T]) Stream() *set.Stream[T] {
+	return s.snapshot().Stream()
+}
+
+// Iter returns a [set.Iterator] which permits to iterate a snapshot of s.
+//
+//	for i := s.Iter(); !i.End(); i = i.Next() {
+//		element := i.Element()
+//		// Code
+//	}
+func (s *SyncSet[T]) Iter() set.Iterator[T] {
+	return NewSyncSetIterator(s)
+}
+
+// RangeIter returns a function that allows to iterate a snapshot of s using the range keyword.
+//
+//	for i := range s.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [SyncSet.Iter], it doesn't allow to remove elements during the iteration.
+func (s *SyncSet[T]) RangeIter() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for _, i := range s.ToSlice() {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns true if s and st are both sets and their elements are equals.
+// In any other case, it returns false.
+func (s *SyncSet[T]) Equal(st any) bool {
+	if other, ok := st.(*SyncSet[T]); ok && other != nil {
+		st = other.snapshot()
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Equal(st)
+}
+
+// Compare returns 0 if s and st are equals,
+// -1 if s is less than st,
+// 1 if s is greater than st,
+// -2 if st is not a set or if one between s and st is nil.
+//
+// The comparison is done by the set guarded by s.
+func (s *SyncSet[T]) Compare(st any) int {
+	if other, ok := st.(*SyncSet[T]); ok && other != nil {
+		st = other.snapshot()
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Compare(st)
+}
+
+// Hash returns the hash code of s.
+func (s *SyncSet[T]) Hash() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Hash()
+}
+
+// Copy returns a [SyncSet] guarding a copy of the set guarded by s.
+func (s *SyncSet[T]) Copy() set.Set[T] {
+	return NewSyncSet(s.snapshot())
+}
+
+// String returns a rapresentation of s in the form of a string.
+func (s *SyncSet[T]) String() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return fmt.Sprintf("Sync%v", s.objects)
+}
+
+// MarshalJSON returns the JSON encoding of the guarded set.
+func (s *SyncSet[T]) MarshalJSON() ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return json.Marshal(s.objects)
+}
+
+// UnmarshalJSON replaces the elements of the guarded set with the ones decoded from data.
+func (s *SyncSet[T]) UnmarshalJSON(data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return json.Unmarshal(data, s.objects)
+}
+
+// MarshalBinary returns the binary encoding of the guarded set.
+func (s *SyncSet[T]) MarshalBinary() ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return codec.Marshal(s.objects)
+}
+
+// UnmarshalBinary replaces the elements of the guarded set with the ones decoded from data.
+func (s *SyncSet[T]) UnmarshalBinary(data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return codec.Unmarshal(data, s.objects)
+}
+
+// GobEncode returns the binary encoding of s as [SyncSet.MarshalBinary].
+func (s *SyncSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [SyncSet.UnmarshalBinary].
+func (s *SyncSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+func (s *SyncSet[T]) snapshot() set.Set[T] {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Copy()
+}
@@ -0,0 +1,91 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/potex02/structures/set"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewSyncSet(t *testing.T) {
+
+	var s set.Set[wrapper.Int] = NewSyncSet[wrapper.Int](set.NewTreeSet[wrapper.Int](1, 2, 3))
+
+	if s.Len() != 3 {
+		t.Log("length is", s.Len())
+		t.Fail()
+	}
+	if !s.Equal(set.NewHashSet[wrapper.Int](1, 2, 3)) || !s.Equal(s) || s.Compare(s.Copy()) != 0 {
+		t.Log("sets are not equals")
+		t.Fail()
+	}
+}
+func TestConcurrentSyncSet(t *testing.T) {
+
+	var s *SyncSet[wrapper.Int] = NewSyncSet[wrapper.Int](set.NewHashSet[wrapper.Int]())
+
+	var wg sync.WaitGroup
+	added := make([]int, 8)
+	for i := 0; i != 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := wrapper.Int(0); j != 100; j++ {
+				if s.AddIfAbsent(j) {
+					added[i]++
+				}
+				s.Each(func(element wrapper.Int) {})
+			}
+		}()
+	}
+	wg.Wait()
+	sum := 0
+	for _, i := range added {
+		sum += i
+	}
+	if s.Len() != 100 || sum != 100 {
+		t.Log("length is", s.Len(), sum)
+		t.Fail()
+	}
+}
+func TestIterSyncSet(t *testing.T) {
+
+	var s *SyncSet[wrapper.Int] = NewSyncSet[wrapper.Int](set.NewTreeSet[wrapper.Int](1, 2, 3, 4))
+
+	for i := s.Iter(); !i.End(); {
+		if i.Element()%2 == 0 {
+			i = i.Remove()
+		} else {
+			s.Add(i.Element() + 10)
+			i = i.Next()
+		}
+	}
+	if !s.Equal(set.NewHashSet[wrapper.Int](1, 3, 11, 13)) {
+		t.Log("set is", s)
+		t.Fail()
+	}
+	s.Update(func(set set.Set[wrapper.Int]) {
+		set.Remove(1)
+	})
+	if s.Contains(1) {
+		t.Log("set is", s)
+		t.Fail()
+	}
+}
+func TestJSONSyncSet(t *testing.T) {
+
+	var s *SyncSet[wrapper.Int] = NewSyncSet[wrapper.Int](set.NewTreeSet[wrapper.Int](3, 1, 2))
+
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result := NewSyncSet[wrapper.Int](set.NewHashSet[wrapper.Int]())
+	if err := json.Unmarshal(data, result); err != nil || !result.Equal(s) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
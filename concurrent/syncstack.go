@@ -0,0 +1,188 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/stack"
+)
+
+var _ structures.Structure[int] = NewSyncStack[int](stack.NewStack[int]())
+
+// SyncStack is a [stack.Stack] safe for concurrent use, which guards another stack with a [sync.RWMutex].
+type SyncStack[T any] struct {
+	// contains filtered or unexported fields
+	objects *stack.Stack[T]
+	lock    sync.RWMutex
+}
+
+// NewSyncStack returns a new [SyncStack] which guards s.
+//
+// s must not be used directly after this call.
+func NewSyncStack[T any](s *stack.Stack[T]) *SyncStack[T] {
+	return &SyncStack[T]{objects: s}
+}
+
+// Len returns the length of s.
+func (s *SyncStack[T]) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Len()
+}
+
+// IsEmpty returns a bool which indicate if s is empty or not.
+func (s *SyncStack[T]) IsEmpty() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.IsEmpty()
+}
+
+// Top returns the top element of s.
+// The method returns false if s is empty.
+func (s *SyncStack[T]) Top() (T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Top()
+}
+
+// ToSlice returns a slice which contains all elements of s.
+func (s *SyncStack[T]) ToSlice() []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.ToSlice()
+}
+
+// Push adds the elements e at the top of s.
+func (s *SyncStack[T]) Push(e ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.objects.Push(e...)
+}
+
+// Pop removes an element from the top of s and returns the removed element.
+// The method returns false if s is empty.
+func (s *SyncStack[T]) Pop() (T, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.objects.Pop()
+}
+
+// PopIf removes the top element of s if it satisfies fun and returns it.
+// The method returns false if s is empty or if the top element does not satisfy fun.
+func (s *SyncStack[T]) PopIf(fun func(element T) bool) (T, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if element, ok := s.objects.Top(); !ok || !fun(element) {
+
+		var result T
+
+		return result, false
+	}
+	return s.objects.Pop()
+}
+
+// Drain removes all elements from s and returns them in the order they are popped.
+func (s *SyncStack[T]) Drain() []T {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	result := make([]T, 0, s.objects.Len())
+	for element, ok := s.objects.Pop(); ok; element, ok = s.objects.Pop() {
+		result = append(result, element)
+	}
+	return result
+}
+
+// Clear removes all element from s.
+func (s *SyncStack[T]) Clear() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.objects.Clear()
+}
+
+// Update executes fun holding the write lock of s, so that compound operations on the stack passed to fun are atomic.
+//
+// fun must not call the methods of s or keep a reference to the stack.
+func (s *SyncStack[T]) Update(fun func(stack *stack.Stack[T])) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	fun(s.objects)
+}
+
+// Equal returns true if s and st are both stacks and their elements are equals.
+// In any other case, it returns false.
+func (s *SyncStack[T]) Equal(st any) bool {
+	if other, ok := st.(*SyncStack[T]); ok && other != nil {
+		st = stack.NewStackFromSlice(other.ToSlice())
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Equal(st)
+}
+
+// Compare returns 0 if s and st are equals,
+// -1 if s is shorten than st,
+// 1 if s is longer than st,
+// -2 if st is not a stack or if one between s and st is nil.
+func (s *SyncStack[T]) Compare(st any) int {
+	if other, ok := st.(*SyncStack[T]); ok && other != nil {
+		st = stack.NewStackFromSlice(other.ToSlice())
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Compare(st)
+}
+
+// Hash returns the hash code of s.
+func (s *SyncStack[T]) Hash() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.objects.Hash()
+}
+
+// String returns a rapresentation of s in the form of a string.
+func (s *SyncStack[T]) String() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return fmt.Sprintf("Sync%v", s.objects)
+}
+
+// MarshalJSON returns the JSON encoding of the guarded stack.
+func (s *SyncStack[T]) MarshalJSON() ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return json.Marshal(s.objects)
+}
+
+// UnmarshalJSON replaces the elements of the guarded stack with the ones decoded from data.
+func (s *SyncStack[T]) UnmarshalJSON(data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return json.Unmarshal(data, s.objects)
+}
+
+// MarshalBinary returns the binary encoding of the guarded stack.
+func (s *SyncStack[T]) MarshalBinary() ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return codec.Marshal(s.objects)
+}
+
+// UnmarshalBinary replaces the elements of the guarded stack with the ones decoded from data.
+func (s *SyncStack[T]) UnmarshalBinary(data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return codec.Unmarshal(data, s.objects)
+}
+
+// GobEncode returns the binary encoding of s as [SyncStack.MarshalBinary].
+func (s *SyncStack[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [SyncStack.UnmarshalBinary].
+func (s *SyncStack[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
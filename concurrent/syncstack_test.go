@@ -0,0 +1,72 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/potex02/structures/stack"
+)
+
+func TestNewSyncStack(t *testing.T) {
+
+	var s *SyncStack[int] = NewSyncStack[int](stack.NewStack[int](1, 2, 3))
+
+	if e, ok := s.Top(); !ok || e != 3 || s.Len() != 3 {
+		t.Log("stack is", s)
+		t.Fail()
+	}
+	if !s.Equal(stack.NewStack[int](1, 2, 3)) || !s.Equal(s) || s.Compare(stack.NewStack[int](1, 2)) != 1 {
+		t.Log("stacks are not equals")
+		t.Fail()
+	}
+}
+func TestConcurrentSyncStack(t *testing.T) {
+
+	var s *SyncStack[int] = NewSyncStack[int](stack.NewStack[int]())
+
+	var wg sync.WaitGroup
+	for i := 0; i != 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j != 100; j++ {
+				s.Push(j)
+				s.PopIf(func(element int) bool {
+					return element%2 == 0
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	result := s.Drain()
+	odd := 0
+	for _, i := range result {
+		odd += i % 2
+	}
+	if odd != 200 || len(result) > 400 || !s.IsEmpty() {
+		t.Log("result is", len(result), odd)
+		t.Fail()
+	}
+	s.Push(1, 2, 3)
+	if result := s.Drain(); !slices.Equal(result, []int{3, 2, 1}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestJSONSyncStack(t *testing.T) {
+
+	var s *SyncStack[int] = NewSyncStack[int](stack.NewStack(1, 2, 3))
+
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result := NewSyncStack[int](stack.NewStack[int]())
+	if err := json.Unmarshal(data, result); err != nil || !slices.Equal(result.ToSlice(), []int{1, 2, 3}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
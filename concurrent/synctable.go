@@ -0,0 +1,317 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ table.Table[wrapper.Int, int] = NewSyncTable[wrapper.Int, int](table.NewHashTable[wrapper.Int, int]())
+
+// SyncTable is a [table.Table] safe for concurrent use, which guards another table with a [sync.RWMutex].
+//
+// Iter, RangeIter, Each and Stream work on a snapshot of the table taken when they are called,
+// so they are not affected by the modifications done by other goroutines and they can call the methods of the table.
+type SyncTable[K any, T any] struct {
+	// contains filtered or unexported fields
+	objects table.Table[K, T]
+	lock    sync.RWMutex
+}
+
+// NewSyncTable returns a new [SyncTable] which guards t.
+//
+// t must not be used directly after this call.
+func NewSyncTable[K any, T any](t table.Table[K, T]) *SyncTable[K, T] {
+	return &SyncTable[K, T]{objects: t}
+}
+
+// Len returns the length of t.
+func (t *SyncTable[K, T]) Len() int {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.Len()
+}
+
+// IsEmpty returns a bool which indicate if t is empty or not.
+func (t *SyncTable[K, T]) IsEmpty() bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.IsEmpty()
+}
+
+// ContainsKey returns true if the key is present in t.
+func (t *SyncTable[K, T]) ContainsKey(key K) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.ContainsKey(key)
+}
+
+// ContainsElement returns true if the element e is present in t.
+func (t *SyncTable[K, T]) ContainsElement(e T) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.ContainsElement(e)
+}
+
+// Keys returns a [list.List] which contains all keys of t.
+func (t *SyncTable[K, T]) Keys() list.List[K] {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.Keys()
+}
+
+// Elements returns a [list.List] which contains all elements of t.
+func (t *SyncTable[K, T]) Elements() list.List[T] {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.Elements()
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *SyncTable[K, T]) ToSlice() []T {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.ToSlice()
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+func (t *SyncTable[K, T]) Get(key K) (T, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.Get(key)
+}
+
+// Put sets the element e at the key and returns the overwritten value, if present.
+// If the element is not present, the method returns false.
+func (t *SyncTable[K, T]) Put(key K, e T) (T, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.objects.Put(key, e)
+}
+
+// PutSlice adds the elements of e at t.
+// It panics if key and e have different lengths.
+func (t *SyncTable[K, T]) PutSlice(key []K, e []T) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.objects.PutSlice(key, e)
+}
+
+// PutIfAbsent sets the element e at the key if the key is not present and returns e and false.
+// If the key is already present, it returns the element associated at the key and true.
+func (t *SyncTable[K, T]) PutIfAbsent(key K, e T) (T, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if element, ok := t.objects.Get(key); ok {
+		return element, true
+	}
+	t.objects.Put(key, e)
+	return e, false
+}
+
+// ComputeIfAbsent returns the element associated at the key.
+// If the key is not present, fun is called to compute the element, which is set at the key before being returned.
+//
+// fun is called holding the write lock of t, so it must not call the methods of t.
+func (t *SyncTable[K, T]) ComputeIfAbsent(key K, fun func(key K) T) T {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if element, ok := t.objects.Get(key); ok {
+		return element
+	}
+	element := fun(key)
+	t.objects.Put(key, element)
+	return element
+}
+
+// CompareAndSwap sets e at the key if the element associated at the key is equal to old.
+// It returns false if the key is not present or if the element is not equal to old.
+func (t *SyncTable[K, T]) CompareAndSwap(key K, old T, e T) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	element, ok := t.objects.Get(key)
+	if !ok || !util.EqualFunction(old)(element) {
+		return false
+	}
+	t.objects.Put(key, e)
+	return true
+}
+
+// Remove removes the key from t and returns the value associated at the key.
+// It returns false if the the key does not exists.
+func (t *SyncTable[K, T]) Remove(key K) (T, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.objects.Remove(key)
+}
+
+// Clear removes all element from t.
+func (t *SyncTable[K, T]) Clear() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.objects.Clear()
+}
+
+// View executes fun holding the read lock of t, so the table passed to fun is not modified by other goroutines.
+//
+// fun must not modify the table, call the methods of t or keep a reference to the table.
+func (t *SyncTable[K, T]) View(fun func(table table.Table[K, T])) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	fun(t.objects)
+}
+
+// Update executes fun holding the write lock of t, so that compound operations on the table passed to fun are atomic.
+//
+// fun must not call the methods of t or keep a reference to the table.
+func (t *SyncTable[K, T]) Update(fun func(table table.Table[K, T])) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	fun(t.objects)
+}
+
+// Each executes fun for all elements of a snapshot of t.
+func (t *SyncTable[K, T]) Each(fun func(key K, element T)) {
+	for _, i := range t.entries() {
+		fun(i.Key(), i.Element())
+	}
+}
+
+// Stream returns a [table.Stream] rapresenting a snapshot of t.
+func (t *SyncTable[K, T]) Stream() *table.Stream[K, T] {
+	return t.snapshot().Stream()
+}
+
+// Iter returns a [table.Iterator] which permits to iterate a snapshot of t.
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		key := i.Key()
+//		element := i.Element()
+//		// Code
+//	}
+func (t *SyncTable[K, T]) Iter() table.Iterator[K, T] {
+	return NewSyncTableIterator(t)
+}
+
+// RangeIter returns a function that allows to iterate a snapshot of t using the range keyword.
+//
+//	for i, j := range t.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [SyncTable.Iter], it doesn't allow to remove elements during the iteration.
+func (t *SyncTable[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		for _, i := range t.entries() {
+			if !yield(i.Key(), i.Element()) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns true if t and st are both tables and their entries are equals.
+// In any other case, it returns false.
+func (t *SyncTable[K, T]) Equal(st any) bool {
+	if other, ok := st.(*SyncTable[K, T]); ok && other != nil {
+		st = other.snapshot()
+	}
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.Equal(st)
+}
+
+// Compare returns 0 if t and st are equals,
+// -1 if t is less than st,
+// 1 if t is greater than st,
+// -2 if st is not a table or if one between t and st is nil.
+//
+// The comparison is done by the table guarded by t.
+func (t *SyncTable[K, T]) Compare(st any) int {
+	if other, ok := st.(*SyncTable[K, T]); ok && other != nil {
+		st = other.snapshot()
+	}
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.Compare(st)
+}
+
+// Hash returns the hash code of t.
+func (t *SyncTable[K, T]) Hash() uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.Hash()
+}
+
+// Copy returns a [SyncTable] guarding a copy of the table guarded by t.
+func (t *SyncTable[K, T]) Copy() table.Table[K, T] {
+	return NewSyncTable(t.snapshot())
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *SyncTable[K, T]) String() string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return fmt.Sprintf("Sync%v", t.objects)
+}
+
+// MarshalJSON returns the JSON encoding of the guarded table.
+func (t *SyncTable[K, T]) MarshalJSON() ([]byte, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return json.Marshal(t.objects)
+}
+
+// UnmarshalJSON replaces the elements of the guarded table with the ones decoded from data.
+func (t *SyncTable[K, T]) UnmarshalJSON(data []byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return json.Unmarshal(data, t.objects)
+}
+
+// MarshalBinary returns the binary encoding of the guarded table.
+func (t *SyncTable[K, T]) MarshalBinary() ([]byte, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return codec.Marshal(t.objects)
+}
+
+// UnmarshalBinary replaces the elements of the guarded table with the ones decoded from data.
+func (t *SyncTable[K, T]) UnmarshalBinary(data []byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return codec.Unmarshal(data, t.objects)
+}
+
+// GobEncode returns the binary encoding of t as [SyncTable.MarshalBinary].
+func (t *SyncTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [SyncTable.UnmarshalBinary].
+func (t *SyncTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+func (t *SyncTable[K, T]) snapshot() table.Table[K, T] {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.objects.Copy()
+}
+
+func (t *SyncTable[K, T]) entries() []*table.Entry[K, T] {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	result := make([]*table.Entry[K, T], 0, t.objects.Len())
+	for i, j := range t.objects.RangeIter() {
+		result = append(result, table.NewEntry(i, j))
+	}
+	return result
+}
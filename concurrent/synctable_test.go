@@ -0,0 +1,99 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewSyncTable(t *testing.T) {
+
+	var tab table.Table[wrapper.Int, int] = NewSyncTable[wrapper.Int, int](table.NewHashTableFromSlice([]wrapper.Int{1, 2}, []int{3, 4}))
+
+	if e, ok := tab.Get(2); !ok || e != 4 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if !tab.Equal(table.NewTreeTableFromSlice([]wrapper.Int{1, 2}, []int{3, 4})) || !tab.Equal(tab) || !tab.Equal(tab.Copy()) {
+		t.Log("tables are not equals")
+		t.Fail()
+	}
+}
+func TestComputeIfAbsentSyncTable(t *testing.T) {
+
+	var tab *SyncTable[wrapper.Int, int] = NewSyncTable[wrapper.Int, int](table.NewHashTable[wrapper.Int, int]())
+
+	var wg sync.WaitGroup
+	calls := make([]int, 8)
+	for i := 0; i != 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := wrapper.Int(0); j != 100; j++ {
+				tab.ComputeIfAbsent(j, func(key wrapper.Int) int {
+					calls[i]++
+					return int(key) * 2
+				})
+				for {
+					e, _ := tab.Get(j)
+					if tab.CompareAndSwap(j, e, e+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	sum := 0
+	for _, i := range calls {
+		sum += i
+	}
+	if e, _ := tab.Get(10); tab.Len() != 100 || sum != 100 || e != 28 {
+		t.Log("table is", tab.Len(), sum, e)
+		t.Fail()
+	}
+}
+func TestPutIfAbsentSyncTable(t *testing.T) {
+
+	var tab *SyncTable[wrapper.Int, int] = NewSyncTable[wrapper.Int, int](table.NewTreeTable[wrapper.Int, int]())
+
+	if e, ok := tab.PutIfAbsent(1, 10); ok || e != 10 {
+		t.Log("element is", e, ok)
+		t.Fail()
+	}
+	if e, ok := tab.PutIfAbsent(1, 20); !ok || e != 10 {
+		t.Log("element is", e, ok)
+		t.Fail()
+	}
+	tab.Put(2, 20)
+	for i := tab.Iter(); !i.End(); {
+		if i.Key() == 1 {
+			i = i.Remove()
+		} else {
+			tab.Put(i.Key()+10, i.Element())
+			i = i.Next()
+		}
+	}
+	if !tab.Equal(table.NewHashTableFromSlice([]wrapper.Int{2, 12}, []int{20, 20})) {
+		t.Log("table is", tab)
+		t.Fail()
+	}
+}
+func TestJSONSyncTable(t *testing.T) {
+
+	var tb *SyncTable[wrapper.String, int] = NewSyncTable[wrapper.String, int](table.NewTreeTableFromSlice([]wrapper.String{"a", "b"}, []int{1, 2}))
+
+	data, err := json.Marshal(tb)
+	if err != nil || string(data) != `{"a":1,"b":2}` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result := NewSyncTable[wrapper.String, int](table.NewHashTable[wrapper.String, int]())
+	if err := json.Unmarshal(data, result); err != nil || !result.Equal(tb) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
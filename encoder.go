@@ -0,0 +1,130 @@
+package structures
+
+import (
+	"errors"
+	"io"
+	"reflect"
+
+	"github.com/potex02/structures/internal/codec"
+)
+
+// Encoder writes the elements of type T on an [io.Writer] one at a time,
+// so that a structure can be encoded without keeping its whole encoding in memory.
+//
+// The elements use the same binary encoding of the MarshalBinary methods of the structures.
+// The stream must be ended with Close, otherwise the [Decoder] reading it returns [io.ErrUnexpectedEOF].
+type Encoder[T any] struct {
+	// contains filtered or unexported fields
+	stream *codec.StreamEncoder
+	codec  codec.Codec[T]
+}
+
+// NewEncoder returns a new [Encoder] which writes on w.
+func NewEncoder[T any](w io.Writer) *Encoder[T] {
+	return &Encoder[T]{stream: codec.NewStreamEncoder(w), codec: codec.NewCodec[T]()}
+}
+
+// Encode writes element.
+// It returns [ErrClosedEncoder] if e has been closed.
+func (e *Encoder[T]) Encode(element T) error {
+	if e.stream.Closed() {
+		return ErrClosedEncoder
+	}
+	return e.stream.Write(func(encoder *codec.Encoder) error {
+		return e.codec.Encode(encoder, element)
+	})
+}
+
+// EncodeStructure writes all elements of s.
+//
+// If s has a RangeIter method, like the lists, the sets and the trees, the elements are written while iterating s,
+// otherwise they are taken from ToSlice.
+func (e *Encoder[T]) EncodeStructure(s Structure[T]) error {
+	switch structure := s.(type) {
+	case interface {
+		RangeIter() func(yield func(T) bool)
+	}:
+		for i := range structure.RangeIter() {
+			if err := e.Encode(i); err != nil {
+				return err
+			}
+		}
+	case interface {
+		RangeIter() func(yield func(int, T) bool)
+	}:
+		for _, i := range structure.RangeIter() {
+			if err := e.Encode(i); err != nil {
+				return err
+			}
+		}
+	default:
+		for _, i := range s.ToSlice() {
+			if err := e.Encode(i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close ends the stream written by e.
+// It does not close the underlying [io.Writer].
+func (e *Encoder[T]) Close() error {
+	return e.stream.Close()
+}
+
+// Decoder reads the elements of type T written by an [Encoder] from an [io.Reader] one at a time.
+type Decoder[T any] struct {
+	// contains filtered or unexported fields
+	stream *codec.StreamDecoder
+	codec  codec.Codec[T]
+}
+
+// NewDecoder returns a new [Decoder] which reads from r.
+//
+// If r does not implement [io.ByteReader], it is buffered,
+// so the data following the end of the stream may be read from r.
+func NewDecoder[T any](r io.Reader) *Decoder[T] {
+	return &Decoder[T]{stream: codec.NewStreamDecoder(r), codec: codec.NewCodec[T]()}
+}
+
+// Decode reads the next element.
+// It returns [io.EOF] when the stream is ended.
+func (d *Decoder[T]) Decode() (T, error) {
+
+	var result T
+
+	err := d.stream.Read(func(decoder *codec.Decoder) error {
+		var err error
+		result, err = d.codec.Decode(decoder)
+		return err
+	})
+	return result, err
+}
+
+// DecodeStructure reads all the remaining elements and adds them at s.
+//
+// s must have an Add or a Push method which accepts a variable number of elements,
+// like the lists, the sets, the trees, the stacks and the queues.
+// The elements are added one at a time and the method returns when the stream is ended.
+func (d *Decoder[T]) DecodeStructure(s Structure[T]) error {
+	var add func(e ...T)
+	switch structure := s.(type) {
+	case interface{ Add(e ...T) }:
+		add = structure.Add
+	case interface{ Push(e ...T) }:
+		add = structure.Push
+	default:
+		return errors.New("Cannot add the decoded elements to a " + reflect.TypeOf(s).String())
+	}
+	for {
+		element, err := d.Decode()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		add(element)
+	}
+}
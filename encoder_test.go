@@ -0,0 +1,108 @@
+package structures
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestEncoder(t *testing.T) {
+
+	var structure *testStructure = &testStructure{1, 2, 3}
+	var buffer bytes.Buffer
+
+	encoder := NewEncoder[int](&buffer)
+	if err := encoder.EncodeStructure(structure); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := encoder.Encode(4); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := encoder.Close(); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := encoder.Encode(5); !errors.Is(err, ErrClosedEncoder) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	result := &testStructure{0}
+	decoder := NewDecoder[int](&buffer)
+	if err := decoder.DecodeStructure(result); err != nil || !slices.Equal(result.ToSlice(), []int{0, 1, 2, 3, 4}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if _, err := decoder.Decode(); err != io.EOF {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestDecoder(t *testing.T) {
+
+	var buffer bytes.Buffer
+
+	encoder := NewEncoder[[]string](&buffer)
+	encoder.Encode([]string{"a", "b"})
+	encoder.Encode(nil)
+	encoder.Close()
+	data := buffer.Bytes()
+	decoder := NewDecoder[[]string](bytes.NewReader(data))
+	if e, err := decoder.Decode(); err != nil || !slices.Equal(e, []string{"a", "b"}) {
+		t.Log("e is", e, "err is", err)
+		t.Fail()
+	}
+	if e, err := decoder.Decode(); err != nil || len(e) != 0 {
+		t.Log("e is", e, "err is", err)
+		t.Fail()
+	}
+	if _, err := decoder.Decode(); err != io.EOF {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	decoder = NewDecoder[[]string](bytes.NewReader(data[:len(data)-2]))
+	decoder.Decode()
+	if _, err := decoder.Decode(); err != io.ErrUnexpectedEOF {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+
+type testStructure []int
+
+func (s *testStructure) String() string {
+	return fmt.Sprint(*s)
+}
+func (s *testStructure) Equal(o any) bool {
+	other, ok := o.(*testStructure)
+	return ok && slices.Equal(*s, *other)
+}
+func (s *testStructure) Compare(o any) int {
+	other, ok := o.(*testStructure)
+	if !ok {
+		return -2
+	}
+	return slices.Compare(*s, *other)
+}
+func (s *testStructure) Hash() uint64 {
+	return uint64(len(*s))
+}
+func (s *testStructure) Len() int {
+	return len(*s)
+}
+func (s *testStructure) IsEmpty() bool {
+	return len(*s) == 0
+}
+func (s *testStructure) ToSlice() []int {
+	return slices.Clone(*s)
+}
+func (s *testStructure) Clear() {
+	*s = nil
+}
+func (s *testStructure) Add(e ...int) {
+	*s = append(*s, e...)
+}
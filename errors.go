@@ -0,0 +1,10 @@
+package structures
+
+import "errors"
+
+// ErrUnmodifiable is the error returned by the unmodifiable views of the structures when a modification is attempted.
+// The methods of the views which can not return an error panic with it.
+var ErrUnmodifiable = errors.New("Cannot modify an unmodifiable structure")
+
+// ErrClosedEncoder is the error returned by the encoders of the structures when they are used after being closed.
+var ErrClosedEncoder = errors.New("Cannot encode on a closed Encoder")
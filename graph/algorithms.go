@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/queue"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+)
+
+// Weight is the constraint of the weights used by the algorithms which sum them, like [Dijkstra] and [MinimumSpanningTree].
+// It is satisfied by all numbers, including the ones of the wrapper package, except the complex ones.
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64
+}
+
+// CycleError is the error returned by [Graph.TopologicalSort] when the graph contains a cycle.
+type CycleError[V util.Hasher] struct {
+	// contains filtered or unexported fields
+	cycle []V
+}
+
+// Cycle returns a [list.List] which contains the vertices of the cycle, in the order of its edges.
+// The first vertex is repeated at the end of the list.
+func (e *CycleError[V]) Cycle() list.List[V] {
+	return list.NewArrayListFromSlice(e.cycle)
+}
+
+// Error returns a description of e, which contains the vertices of the cycle.
+func (e *CycleError[V]) Error() string {
+	return fmt.Sprintf("Cannot sort a graph containing the cycle %v", e.cycle)
+}
+
+// Paths contains the shortest paths from a source vertex to all the vertices reachable from it.
+// It is returned by [Dijkstra].
+type Paths[V util.Hasher, W Weight] struct {
+	// contains filtered or unexported fields
+	source    V
+	distances *table.HashTable[V, W]
+	previous  *table.HashTable[V, V]
+}
+
+type distance[V util.Hasher, W Weight] struct {
+	vertex   *vertex[V, W]
+	distance W
+}
+
+// Dijkstra returns the shortest paths from the vertex source to all the vertices of g reachable from it,
+// using the Dijkstra algorithm on a [queue.PriorityQueue].
+// The length of a path is the sum of the weights of its edges.
+//
+// It runs in O((v + e) log v) time, where v is the number of vertices and e is the number of edges.
+//
+// It panics if an edge reachable from source has a negative weight.
+func Dijkstra[V util.Hasher, W Weight](g *Graph[V, W], source V) *Paths[V, W] {
+	paths := &Paths[V, W]{source: source, distances: table.NewHashTable[V, W](), previous: table.NewHashTable[V, V]()}
+	first, ok := g.vertices.Get(source)
+	if !ok {
+		return paths
+	}
+	distances := queue.NewPriorityQueueFunc(func(i distance[V, W], j distance[V, W]) int {
+		// The nearest vertex has the highest priority.
+		return cmp.Compare(j.distance, i.distance)
+	})
+	paths.distances.Put(source, 0)
+	distances.Push(distance[V, W]{vertex: first, distance: 0})
+	visited := table.NewHashTable[V, bool]()
+	for !distances.IsEmpty() {
+		current, _ := distances.Pop()
+		// A vertex can be pushed more times, but only its first extraction has the shortest distance.
+		if visited.ContainsKey(current.vertex.value) {
+			continue
+		}
+		visited.Put(current.vertex.value, true)
+		for _, i := range current.vertex.out {
+			if i.weight < 0 {
+				panic(fmt.Sprintf("Negative weight on the edge %v", i))
+			}
+			next := current.distance + i.weight
+			if old, ok := paths.distances.Get(i.to); ok && old <= next {
+				continue
+			}
+			paths.distances.Put(i.to, next)
+			paths.previous.Put(i.to, current.vertex.value)
+			vertex, _ := g.vertices.Get(i.to)
+			distances.Push(distance[V, W]{vertex: vertex, distance: next})
+		}
+	}
+	return paths
+}
+
+// Source returns the vertex where all the paths of p start.
+func (p *Paths[V, W]) Source() V {
+	return p.source
+}
+
+// Distance returns the length of the shortest path from the source to the vertex to.
+// The method returns false if to is not reachable from the source.
+func (p *Paths[V, W]) Distance(to V) (W, bool) {
+	return p.distances.Get(to)
+}
+
+// PathTo returns a [list.List] which contains the vertices of the shortest path from the source to the vertex to,
+// both included.
+// The method returns false if to is not reachable from the source.
+func (p *Paths[V, W]) PathTo(to V) (list.List[V], bool) {
+	if !p.distances.ContainsKey(to) {
+		return nil, false
+	}
+	var path []V
+	for i, ok := to, true; ok; i, ok = p.previous.Get(i) {
+		path = append(path, i)
+	}
+	slices.Reverse(path)
+	return list.NewArrayListFromSlice(path), true
+}
+
+// MinimumSpanningTree returns an undirected [Graph] which contains all the vertices of g
+// and the edges of g which connect them with the minimum total weight, using the Prim algorithm on a [queue.PriorityQueue].
+// If g is not connected, the result is a minimum spanning forest, made by a tree for each connected component.
+//
+// It runs in O(e log e) time, where e is the number of edges.
+//
+// It returns an error if g is directed.
+func MinimumSpanningTree[V util.Hasher, W Weight](g *Graph[V, W]) (*Graph[V, W], error) {
+	if g.directed {
+		return nil, errors.New("Cannot find the minimum spanning tree of a directed graph")
+	}
+	result := NewUndirectedGraph[V, W](g.ToSlice()...)
+	edges := queue.NewPriorityQueueFunc(func(i *Edge[V, W], j *Edge[V, W]) int {
+		// The lightest edge has the highest priority.
+		return cmp.Compare(j.weight, i.weight)
+	})
+	visited := table.NewHashTable[V, bool]()
+	for _, i := range g.order {
+		if visited.ContainsKey(i.value) {
+			continue
+		}
+		visited.Put(i.value, true)
+		edges.Push(i.out...)
+		for !edges.IsEmpty() {
+			edge, _ := edges.Pop()
+			if visited.ContainsKey(edge.to) {
+				continue
+			}
+			visited.Put(edge.to, true)
+			result.AddEdge(edge.from, edge.to, edge.weight)
+			vertex, _ := g.vertices.Get(edge.to)
+			edges.Push(vertex.out...)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestCycleError(t *testing.T) {
+
+	var err error = &CycleError[wrapper.Int]{cycle: []wrapper.Int{1, 2, 1}}
+
+	if err.Error() != "Cannot sort a graph containing the cycle [1 2 1]" {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestDijkstra(t *testing.T) {
+
+	var graph *Graph[wrapper.String, int] = NewDirectedGraph[wrapper.String, int]("a", "b", "c", "d", "e", "f")
+
+	graph.AddEdge("a", "b", 7)
+	graph.AddEdge("a", "c", 9)
+	graph.AddEdge("a", "f", 14)
+	graph.AddEdge("b", "c", 10)
+	graph.AddEdge("b", "d", 15)
+	graph.AddEdge("c", "d", 11)
+	graph.AddEdge("c", "f", 2)
+	graph.AddEdge("f", "e", 9)
+	graph.AddEdge("d", "e", 6)
+	paths := Dijkstra(graph, "a")
+	if paths.Source() != "a" {
+		t.Log("source is", paths.Source())
+		t.Fail()
+	}
+	for i, j := range map[wrapper.String]int{"a": 0, "b": 7, "c": 9, "d": 20, "e": 20, "f": 11} {
+		if distance, ok := paths.Distance(i); !ok || distance != j {
+			t.Log("distance of", i, "is", distance)
+			t.Fail()
+		}
+	}
+	if path, ok := paths.PathTo("e"); !ok || !reflect.DeepEqual(path.ToSlice(), []wrapper.String{"a", "c", "f", "e"}) {
+		t.Log("path is", path)
+		t.Fail()
+	}
+	if path, ok := paths.PathTo("a"); !ok || !reflect.DeepEqual(path.ToSlice(), []wrapper.String{"a"}) {
+		t.Log("path is", path)
+		t.Fail()
+	}
+	paths = Dijkstra(graph, "e")
+	if distance, ok := paths.Distance("a"); ok || distance != 0 {
+		t.Log("distance is", distance)
+		t.Fail()
+	}
+	if path, ok := paths.PathTo("a"); ok || path != nil {
+		t.Log("path is", path)
+		t.Fail()
+	}
+	if _, ok := Dijkstra(graph, "g").Distance("g"); ok {
+		t.Log("g is reachable")
+		t.Fail()
+	}
+}
+func TestDijkstraNegative(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, float64] = NewUndirectedGraph[wrapper.Int, float64]()
+
+	graph.AddEdge(1, 2, 0.5)
+	graph.AddEdge(2, 3, -1)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("Dijkstra does not panic")
+			t.Fail()
+		}
+	}()
+	Dijkstra(graph, 1)
+}
+func TestMinimumSpanningTree(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, float64] = NewUndirectedGraph[wrapper.Int, float64](1, 2, 3, 4, 5, 6)
+
+	graph.AddEdge(1, 2, 4)
+	graph.AddEdge(1, 3, 1)
+	graph.AddEdge(2, 3, 2)
+	graph.AddEdge(2, 4, 5)
+	graph.AddEdge(3, 4, 8)
+	graph.AddEdge(5, 6, 0.5)
+	result, err := MinimumSpanningTree(graph)
+	if err != nil || result.Len() != 6 || result.EdgeCount() != 4 {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var weight float64
+	for i := range result.RangeEdges() {
+		weight += i.Weight()
+	}
+	if weight != 8.5 {
+		t.Log("weight is", weight)
+		t.Fail()
+	}
+	for _, i := range [][2]wrapper.Int{{1, 3}, {2, 3}, {2, 4}, {5, 6}} {
+		if !result.ContainsEdge(i[0], i[1]) {
+			t.Log("edge", i, "not found")
+			t.Fail()
+		}
+	}
+	if _, err := MinimumSpanningTree(NewDirectedGraph[wrapper.Int, float64]()); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
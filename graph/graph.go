@@ -0,0 +1,827 @@
+// package graph implements generic directed and undirected graphs and the most common algorithms on them.
+package graph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[wrapper.Int] = NewDirectedGraph[wrapper.Int, int]()
+var _ structures.Structure[wrapper.Int] = NewUndirectedGraph[wrapper.Int, int]()
+
+// Graph provides a generic graph whose vertices are of type V and whose edges have a weight of type W.
+// It is implemented through adjacency lists, so the vertices are found through hashing
+// and the edges of a vertex are stored in the order in which they are added.
+//
+// A graph can be directed or undirected.
+// An edge of an undirected graph connects both its vertices, so it can be traversed in both directions.
+// Between two vertices there can be at most an edge for each direction.
+//
+// The vertices and the edges are iterated in the order in which they are added,
+// so all the algorithms on a graph are deterministic.
+//
+// The length of a graph is the number of its vertices.
+// The graph implements the interface [structures.Structure], whose elements are the vertices.
+//
+// If the edges have no weight, W can be any type, like struct{}.
+// The algorithms which sum the weights, like [Dijkstra] and [MinimumSpanningTree], require a W which satisfies [Weight].
+type Graph[V util.Hasher, W any] struct {
+	// contains filtered or unexported fields
+	vertices *table.HashTable[V, *vertex[V, W]]
+	order    []*vertex[V, W]
+	directed bool
+	edges    int
+}
+
+// Edge is an edge of a [Graph], which goes from a vertex to another one and has a weight.
+type Edge[V util.Hasher, W any] struct {
+	// contains filtered or unexported fields
+	from   V
+	to     V
+	weight W
+}
+
+type vertex[V util.Hasher, W any] struct {
+	value V
+	// out contains the edges starting from the vertex and, in an undirected graph, all the edges of the vertex.
+	out []*Edge[V, W]
+	// in contains the edges ending in the vertex of a directed graph.
+	in []*Edge[V, W]
+}
+
+// NewDirectedGraph returns a new directed [Graph] containing the vertices c and no edges.
+//
+// if no argument is passed, it will be created an empty [Graph].
+func NewDirectedGraph[V util.Hasher, W any](c ...V) *Graph[V, W] {
+	graph := &Graph[V, W]{vertices: table.NewHashTable[V, *vertex[V, W]](), directed: true}
+	graph.AddVertex(c...)
+	return graph
+}
+
+// NewUndirectedGraph returns a new undirected [Graph] containing the vertices c and no edges.
+//
+// if no argument is passed, it will be created an empty [Graph].
+func NewUndirectedGraph[V util.Hasher, W any](c ...V) *Graph[V, W] {
+	graph := &Graph[V, W]{vertices: table.NewHashTable[V, *vertex[V, W]](), directed: false}
+	graph.AddVertex(c...)
+	return graph
+}
+
+// NewEdge returns a new [Edge] which goes from the vertex from to the vertex to and has the weight parameter.
+func NewEdge[V util.Hasher, W any](from V, to V, weight W) *Edge[V, W] {
+	return &Edge[V, W]{from: from, to: to, weight: weight}
+}
+
+// From returns the vertex where e starts.
+func (e *Edge[V, W]) From() V {
+	return e.from
+}
+
+// To returns the vertex where e ends.
+func (e *Edge[V, W]) To() V {
+	return e.to
+}
+
+// Weight returns the weight of e.
+func (e *Edge[V, W]) Weight() W {
+	return e.weight
+}
+
+// String returns a rapresentation of e in the form of a string.
+func (e *Edge[V, W]) String() string {
+	return fmt.Sprintf("%v -> %v: %v", e.from, e.to, e.weight)
+}
+
+// Len returns the number of vertices of g.
+func (g *Graph[V, W]) Len() int {
+	return len(g.order)
+}
+
+// IsEmpty returns a bool which indicates if g has no vertices or not.
+func (g *Graph[V, W]) IsEmpty() bool {
+	return len(g.order) == 0
+}
+
+// IsDirected returns true if g is a directed graph.
+func (g *Graph[V, W]) IsDirected() bool {
+	return g.directed
+}
+
+// EdgeCount returns the number of edges of g.
+// An edge of an undirected graph is counted once.
+func (g *Graph[V, W]) EdgeCount() int {
+	return g.edges
+}
+
+// ToSlice returns a slice which contains all vertices of g, in the order in which they are added.
+func (g *Graph[V, W]) ToSlice() []V {
+	slice := make([]V, len(g.order))
+	for i, j := range g.order {
+		slice[i] = j.value
+	}
+	return slice
+}
+
+// Vertices returns a [list.List] which contains all vertices of g, in the order in which they are added.
+func (g *Graph[V, W]) Vertices() list.List[V] {
+	return list.NewArrayListFromSlice(g.ToSlice())
+}
+
+// Edges returns a [list.List] which contains all edges of g.
+// The edges of an undirected graph are contained once, starting from the vertex added first.
+func (g *Graph[V, W]) Edges() list.List[*Edge[V, W]] {
+	edges := list.NewArrayList[*Edge[V, W]]()
+	for i := range g.RangeEdges() {
+		edges.Add(i)
+	}
+	return edges
+}
+
+// ContainsVertex returns true if the vertex v is present on g.
+func (g *Graph[V, W]) ContainsVertex(v V) bool {
+	return g.vertices.ContainsKey(v)
+}
+
+// ContainsEdge returns true if there is an edge from the vertex from to the vertex to.
+// In an undirected graph, the direction of the edge is not considered.
+func (g *Graph[V, W]) ContainsEdge(from V, to V) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+// Weight returns the weight of the edge from the vertex from to the vertex to.
+// The method returns false if the edge is not found.
+func (g *Graph[V, W]) Weight(from V, to V) (W, bool) {
+
+	var result W
+
+	current, ok := g.vertices.Get(from)
+	if !ok {
+		return result, false
+	}
+	index := current.search(to)
+	if index == -1 {
+		return result, false
+	}
+	return current.out[index].weight, true
+}
+
+// Neighbors returns a [list.List] which contains the vertices reached by the edges starting from the vertex v,
+// in the order in which the edges are added.
+// In an undirected graph, they are all the vertices adjacent to v.
+//
+// The list is empty if v is not present on g.
+func (g *Graph[V, W]) Neighbors(v V) list.List[V] {
+	neighbors := list.NewArrayList[V]()
+	if current, ok := g.vertices.Get(v); ok {
+		for _, i := range current.out {
+			neighbors.Add(i.to)
+		}
+	}
+	return neighbors
+}
+
+// Degree returns the number of edges starting from the vertex v.
+// In an undirected graph, it is the number of edges of v.
+//
+// It returns -1 if v is not present on g.
+func (g *Graph[V, W]) Degree(v V) int {
+	current, ok := g.vertices.Get(v)
+	if !ok {
+		return -1
+	}
+	return len(current.out)
+}
+
+// InDegree returns the number of edges ending in the vertex v.
+// In an undirected graph, it is the same of [Graph.Degree].
+//
+// It returns -1 if v is not present on g.
+func (g *Graph[V, W]) InDegree(v V) int {
+	current, ok := g.vertices.Get(v)
+	if !ok {
+		return -1
+	}
+	if !g.directed {
+		return len(current.out)
+	}
+	return len(current.in)
+}
+
+// AddVertex adds the vertices v at g.
+// The vertices which are already present are ignored.
+func (g *Graph[V, W]) AddVertex(v ...V) {
+	for _, i := range v {
+		g.vertex(i)
+	}
+}
+
+// RemoveVertex removes the vertex v and all its edges from g.
+// It returns false if v is not present.
+func (g *Graph[V, W]) RemoveVertex(v V) bool {
+	current, ok := g.vertices.Remove(v)
+	if !ok {
+		return false
+	}
+	for _, i := range current.out {
+		if target, _ := g.vertices.Get(i.to); target != nil && g.directed {
+			target.removeIn(v)
+		} else if target != nil {
+			target.removeOut(v)
+		}
+	}
+	for _, i := range current.in {
+		if source, ok := g.vertices.Get(i.from); ok {
+			source.removeOut(v)
+		}
+	}
+	g.edges -= len(current.out) + len(current.in)
+	if g.directed && current.search(v) != -1 {
+		// A loop is both an edge starting from v and an edge ending in v.
+		g.edges++
+	}
+	g.order = slices.Delete(g.order, slices.Index(g.order, current), slices.Index(g.order, current)+1)
+	return true
+}
+
+// AddEdge adds an edge from the vertex from to the vertex to, with the weight parameter.
+// The vertices which are not present are added at g.
+//
+// If the edge is already present, its weight is replaced and the method returns the overwritten weight,
+// otherwise the method returns false.
+func (g *Graph[V, W]) AddEdge(from V, to V, weight W) (W, bool) {
+	source := g.vertex(from)
+	if index := source.search(to); index != -1 {
+		result := source.out[index].weight
+		source.out[index].weight = weight
+		if !g.directed {
+			target, _ := g.vertices.Get(to)
+			target.out[target.search(from)].weight = weight
+		}
+		return result, true
+	}
+	target := g.vertex(to)
+	edge := NewEdge(from, to, weight)
+	source.out = append(source.out, edge)
+	if g.directed {
+		target.in = append(target.in, edge)
+	} else if source != target {
+		target.out = append(target.out, NewEdge(to, from, weight))
+	}
+	g.edges++
+	return *new(W), false
+}
+
+// RemoveEdge removes the edge from the vertex from to the vertex to and returns its weight.
+// It returns false if the edge does not exists.
+func (g *Graph[V, W]) RemoveEdge(from V, to V) (W, bool) {
+
+	var result W
+
+	source, ok := g.vertices.Get(from)
+	if !ok {
+		return result, false
+	}
+	index := source.search(to)
+	if index == -1 {
+		return result, false
+	}
+	result = source.out[index].weight
+	source.removeOut(to)
+	target, _ := g.vertices.Get(to)
+	if g.directed {
+		target.removeIn(from)
+	} else if target != source {
+		target.removeOut(from)
+	}
+	g.edges--
+	return result, true
+}
+
+// Each executes fun for all vertices of g.
+//
+// This method should be used to remove vertices. Use ToSlice insted.
+func (g *Graph[V, W]) Each(fun func(vertex V)) {
+	for i := range g.RangeIter() {
+		fun(i)
+	}
+}
+
+// Clear removes all vertices and edges from g.
+func (g *Graph[V, W]) Clear() {
+	g.vertices = table.NewHashTable[V, *vertex[V, W]]()
+	g.order = nil
+	g.edges = 0
+}
+
+// RangeIter returns a function that allows to iterate the vertices of a [Graph] using the range keyword.
+//
+//	for i := range g.RangeIter() {
+//		// Code
+//	}
+//
+// It doesn't allow to remove vertices during the iteration.
+func (g *Graph[V, W]) RangeIter() func(yield func(V) bool) {
+	return func(yield func(V) bool) {
+		for _, i := range g.order {
+			if !yield(i.value) {
+				return
+			}
+		}
+	}
+}
+
+// RangeEdges returns a function that allows to iterate the edges of a [Graph] using the range keyword.
+// The edges of an undirected graph are iterated once, starting from the vertex added first.
+//
+//	for i := range g.RangeEdges() {
+//		// Code
+//	}
+//
+// It doesn't allow to remove edges during the iteration.
+func (g *Graph[V, W]) RangeEdges() func(yield func(*Edge[V, W]) bool) {
+	return func(yield func(*Edge[V, W]) bool) {
+		visited := table.NewHashTable[V, bool]()
+		for _, i := range g.order {
+			for _, j := range i.out {
+				if !g.directed && visited.ContainsKey(j.to) {
+					continue
+				}
+				if !yield(j) {
+					return
+				}
+			}
+			visited.Put(i.value, true)
+		}
+	}
+}
+
+// BFS returns a function that allows to iterate, with a breadth-first search, the vertices reachable from start.
+// The first vertex is start itself.
+//
+//	for i := range g.BFS(start) {
+//		// Code
+//	}
+//
+// Nothing is iterated if start is not present on g.
+func (g *Graph[V, W]) BFS(start V) func(yield func(V) bool) {
+	return func(yield func(V) bool) {
+		first, ok := g.vertices.Get(start)
+		if !ok {
+			return
+		}
+		visited := table.NewHashTable[V, bool]()
+		visited.Put(start, true)
+		vertices := []*vertex[V, W]{first}
+		for len(vertices) != 0 {
+			current := vertices[0]
+			vertices = vertices[1:]
+			if !yield(current.value) {
+				return
+			}
+			for _, i := range current.out {
+				if !visited.ContainsKey(i.to) {
+					visited.Put(i.to, true)
+					next, _ := g.vertices.Get(i.to)
+					vertices = append(vertices, next)
+				}
+			}
+		}
+	}
+}
+
+// DFS returns a function that allows to iterate, with a depth-first search, the vertices reachable from start.
+// The vertices are iterated in preorder and the first vertex is start itself.
+//
+//	for i := range g.DFS(start) {
+//		// Code
+//	}
+//
+// Nothing is iterated if start is not present on g.
+func (g *Graph[V, W]) DFS(start V) func(yield func(V) bool) {
+	return func(yield func(V) bool) {
+		first, ok := g.vertices.Get(start)
+		if !ok {
+			return
+		}
+		visited := table.NewHashTable[V, bool]()
+		vertices := []*vertex[V, W]{first}
+		for len(vertices) != 0 {
+			current := vertices[len(vertices)-1]
+			vertices = vertices[:len(vertices)-1]
+			if visited.ContainsKey(current.value) {
+				continue
+			}
+			visited.Put(current.value, true)
+			if !yield(current.value) {
+				return
+			}
+			// The neighbors are pushed in reverse order, so they are visited in the order of the edges.
+			for i := len(current.out) - 1; i >= 0; i-- {
+				if !visited.ContainsKey(current.out[i].to) {
+					next, _ := g.vertices.Get(current.out[i].to)
+					vertices = append(vertices, next)
+				}
+			}
+		}
+	}
+}
+
+// TopologicalSort returns a [list.List] which contains the vertices of g ordered so that
+// every edge goes from a vertex to a following one.
+// The vertices are placed with the Kahn algorithm, so the result depends only on the order in which the vertices and the edges are added.
+//
+// If g contains a cycle, the method returns a [*CycleError] containing one of the cycles.
+// The method returns an error also if g is undirected.
+func (g *Graph[V, W]) TopologicalSort() (list.List[V], error) {
+	if !g.directed {
+		return nil, errors.New("Cannot sort an undirected graph")
+	}
+	degrees := make(map[*vertex[V, W]]int, len(g.order))
+	vertices := make([]*vertex[V, W], 0, len(g.order))
+	for _, i := range g.order {
+		degrees[i] = len(i.in)
+		if len(i.in) == 0 {
+			vertices = append(vertices, i)
+		}
+	}
+	result := list.NewArrayList[V]()
+	for len(vertices) != 0 {
+		current := vertices[0]
+		vertices = vertices[1:]
+		result.Add(current.value)
+		for _, i := range current.out {
+			next, _ := g.vertices.Get(i.to)
+			degrees[next]--
+			if degrees[next] == 0 {
+				vertices = append(vertices, next)
+			}
+		}
+	}
+	if result.Len() != len(g.order) {
+		return nil, &CycleError[V]{cycle: g.cycle(degrees)}
+	}
+	return result, nil
+}
+
+// ConnectedComponents returns a [list.List] which contains the connected components of g.
+// Each component is a [list.List] containing its vertices in the order in which they are visited by a breadth-first search.
+// The components are ordered by their first vertex.
+//
+// The components of a directed graph are the weakly connected ones, so the direction of the edges is not considered.
+func (g *Graph[V, W]) ConnectedComponents() list.List[list.List[V]] {
+	components := list.NewArrayList[list.List[V]]()
+	visited := table.NewHashTable[V, bool]()
+	for _, i := range g.order {
+		if visited.ContainsKey(i.value) {
+			continue
+		}
+		component := list.NewArrayList[V]()
+		visited.Put(i.value, true)
+		vertices := []*vertex[V, W]{i}
+		for len(vertices) != 0 {
+			current := vertices[0]
+			vertices = vertices[1:]
+			component.Add(current.value)
+			for _, j := range current.out {
+				if !visited.ContainsKey(j.to) {
+					visited.Put(j.to, true)
+					next, _ := g.vertices.Get(j.to)
+					vertices = append(vertices, next)
+				}
+			}
+			for _, j := range current.in {
+				if !visited.ContainsKey(j.from) {
+					visited.Put(j.from, true)
+					next, _ := g.vertices.Get(j.from)
+					vertices = append(vertices, next)
+				}
+			}
+		}
+		components.Add(component)
+	}
+	return components
+}
+
+// Equal returns true if g and st are both graphs of the same kind, with the same vertices and the same edges.
+// The weights of the edges are checked with the Equal method if W implements [util.Equaler],
+// otherwise with [reflect.DeepEqual].
+// In any other case, it returns false.
+func (g *Graph[V, W]) Equal(st any) bool {
+	graph, ok := st.(*Graph[V, W])
+	if !ok || g == nil || graph == nil || g.directed != graph.directed || g.Len() != graph.Len() || g.edges != graph.edges {
+		return false
+	}
+	for _, i := range g.order {
+		if !graph.ContainsVertex(i.value) {
+			return false
+		}
+		for _, j := range i.out {
+			weight, ok := graph.Weight(j.from, j.to)
+			if !ok || !util.EqualFunction(j.weight)(weight) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Compare returns 0 if g and st have the same number of vertices,
+// -1 if g has less vertices than st,
+// 1 if g has more vertices than st,
+// -2 if st is not a [Graph] or if one between g and st is nil.
+func (g *Graph[V, W]) Compare(st any) int {
+	graph, ok := st.(*Graph[V, W])
+	if ok && g != nil && graph != nil {
+		if g.Len() < graph.Len() {
+			return -1
+		}
+		if g.Len() > graph.Len() {
+			return 1
+		}
+		return 0
+	}
+	return -2
+}
+
+// Hash returns the hash code of g.
+// It depends only on the vertices and on the edges, so it does not change with the order in which they are added.
+func (g *Graph[V, W]) Hash() uint64 {
+	var result uint64
+	if g.directed {
+		result = 1
+	}
+	for _, i := range g.order {
+		result += i.value.Hash()
+		for _, j := range i.out {
+			if g.directed {
+				result += j.from.Hash()*util.Prime + j.to.Hash()
+			} else {
+				// Both the directions are added, so the hash does not depend on the direction of the edges.
+				result += j.from.Hash() * j.to.Hash()
+			}
+		}
+	}
+	return result
+}
+
+// Copy returns a graph containing a copy of the vertices and of the weights of g.
+//
+// This method uses [util.Copy] to make copies of the vertices and of the weights.
+func (g *Graph[V, W]) Copy() *Graph[V, W] {
+	result := &Graph[V, W]{vertices: table.NewHashTable[V, *vertex[V, W]](), directed: g.directed}
+	vertices := make(map[*vertex[V, W]]V, len(g.order))
+	for _, i := range g.order {
+		vertices[i] = util.Copy(i.value)
+		result.AddVertex(vertices[i])
+	}
+	for _, i := range g.order {
+		for _, j := range i.out {
+			to, _ := g.vertices.Get(j.to)
+			if g.directed || !result.ContainsEdge(vertices[to], vertices[i]) {
+				result.AddEdge(vertices[i], vertices[to], util.Copy(j.weight))
+			}
+		}
+	}
+	return result
+}
+
+// String returns a rapresentation of g in the form of a string.
+// Every vertex is followed by the vertices reached by its edges and the weights of the edges.
+func (g *Graph[V, W]) String() string {
+	check := []string{reflect.TypeOf(new(V)).String(), reflect.TypeOf(new(W)).String()}
+	name := "UndirectedGraph"
+	if g.directed {
+		name = "DirectedGraph"
+	}
+	result := fmt.Sprintf("%v[%v, %v][", name, check[0][1:], check[1][1:])
+	for i, j := range g.order {
+		if i != 0 {
+			result += ", "
+		}
+		result += fmt.Sprintf("%v: [", j.value)
+		for k, l := range j.out {
+			if k != 0 {
+				result += ", "
+			}
+			result += fmt.Sprintf("%v: %v", l.to, l.weight)
+		}
+		result += "]"
+	}
+	result += "]"
+	return result
+}
+
+// MarshalJSON returns the JSON encoding of g, which is an object containing if g is directed,
+// the array of its vertices and the array of its edges.
+//
+//	{"directed": true, "vertices": [1, 2], "edges": [{"from": 1, "to": 2, "weight": 5}]}
+func (g *Graph[V, W]) MarshalJSON() ([]byte, error) {
+	objects := jsonGraph[V, W]{Directed: g.directed, Vertices: g.ToSlice(), Edges: make([]jsonEdge[V, W], 0, g.edges)}
+	for i := range g.RangeEdges() {
+		objects.Edges = append(objects.Edges, jsonEdge[V, W]{From: i.from, To: i.to, Weight: i.weight})
+	}
+	return json.Marshal(objects)
+}
+
+// UnmarshalJSON replaces the vertices and the edges of g with the ones decoded from the JSON object data.
+// The graph becomes directed or undirected according to data.
+func (g *Graph[V, W]) UnmarshalJSON(data []byte) error {
+	var objects jsonGraph[V, W]
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return err
+	}
+	g.Clear()
+	g.directed = objects.Directed
+	g.AddVertex(objects.Vertices...)
+	for _, i := range objects.Edges {
+		g.AddEdge(i.From, i.To, i.Weight)
+	}
+	return nil
+}
+
+// MarshalBinary returns the binary encoding of g, which contains if g is directed,
+// the number of its vertices, the vertices and the edges as vertex, vertex and weight triples.
+func (g *Graph[V, W]) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder(2 + len(g.order) + 3*g.edges)
+	vertices := codec.NewCodec[V]()
+	weights := codec.NewCodec[W]()
+	if err := codec.NewCodec[bool]().Encode(e, g.directed); err != nil {
+		return nil, err
+	}
+	if err := codec.NewCodec[int]().Encode(e, len(g.order)); err != nil {
+		return nil, err
+	}
+	for i := range g.RangeIter() {
+		if err := vertices.Encode(e, i); err != nil {
+			return nil, err
+		}
+	}
+	for i := range g.RangeEdges() {
+		if err := vertices.Encode(e, i.from); err != nil {
+			return nil, err
+		}
+		if err := vertices.Encode(e, i.to); err != nil {
+			return nil, err
+		}
+		if err := weights.Encode(e, i.weight); err != nil {
+			return nil, err
+		}
+	}
+	return e.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the vertices and the edges of g with the ones decoded from the binary encoding data.
+// The graph becomes directed or undirected according to data.
+func (g *Graph[V, W]) UnmarshalBinary(data []byte) error {
+	d, n, err := codec.NewDecoder(data)
+	if err != nil {
+		return err
+	}
+	directed, err := codec.NewCodec[bool]().Decode(d)
+	if err != nil {
+		return err
+	}
+	length, err := codec.NewCodec[int]().Decode(d)
+	if err != nil {
+		return err
+	}
+	if length < 0 || n-2-length < 0 || (n-2-length)%3 != 0 {
+		return errors.New("Invalid number of vertices " + strconv.Itoa(length))
+	}
+	vertices := codec.NewCodec[V]()
+	weights := codec.NewCodec[W]()
+	result := &Graph[V, W]{vertices: table.NewHashTable[V, *vertex[V, W]](), directed: directed}
+	for i := 0; i != length; i++ {
+		v, err := vertices.Decode(d)
+		if err != nil {
+			return err
+		}
+		result.AddVertex(v)
+	}
+	for i := 0; i != (n-2-length)/3; i++ {
+		from, err := vertices.Decode(d)
+		if err != nil {
+			return err
+		}
+		to, err := vertices.Decode(d)
+		if err != nil {
+			return err
+		}
+		weight, err := weights.Decode(d)
+		if err != nil {
+			return err
+		}
+		result.AddEdge(from, to, weight)
+	}
+	if err := d.Close(); err != nil {
+		return err
+	}
+	*g = *result
+	return nil
+}
+
+// GobEncode returns the binary encoding of g as [Graph.MarshalBinary].
+func (g *Graph[V, W]) GobEncode() ([]byte, error) {
+	return g.MarshalBinary()
+}
+
+// GobDecode decodes data into g as [Graph.UnmarshalBinary].
+func (g *Graph[V, W]) GobDecode(data []byte) error {
+	return g.UnmarshalBinary(data)
+}
+
+// vertex returns the vertex v of g, adding it if it is not present.
+func (g *Graph[V, W]) vertex(v V) *vertex[V, W] {
+	if g.vertices == nil {
+		g.Clear()
+	}
+	result, ok := g.vertices.Get(v)
+	if !ok {
+		result = &vertex[V, W]{value: v}
+		g.vertices.Put(v, result)
+		g.order = append(g.order, result)
+	}
+	return result
+}
+
+// cycle returns a cycle among the vertices whose degree is not zero after a topological sort.
+// The first vertex of the cycle is repeated at its end.
+func (g *Graph[V, W]) cycle(degrees map[*vertex[V, W]]int) []V {
+	// Every remaining vertex has an incoming edge from another remaining vertex,
+	// so going backwards through these edges a vertex is eventually repeated.
+	var current *vertex[V, W]
+	for _, i := range g.order {
+		if degrees[i] != 0 {
+			current = i
+			break
+		}
+	}
+	position := map[*vertex[V, W]]int{}
+	path := []*vertex[V, W]{}
+	for {
+		if index, ok := position[current]; ok {
+			path = path[index:]
+			break
+		}
+		position[current] = len(path)
+		path = append(path, current)
+		for _, i := range current.in {
+			previous, _ := g.vertices.Get(i.from)
+			if degrees[previous] != 0 {
+				current = previous
+				break
+			}
+		}
+	}
+	result := make([]V, len(path)+1)
+	for i, j := range path {
+		result[len(path)-i] = j.value
+	}
+	result[0] = result[len(path)]
+	return result
+}
+
+// search returns the position of the edge of v which ends in the vertex to, or -1 if it is not present.
+func (v *vertex[V, W]) search(to V) int {
+	return slices.IndexFunc(v.out, func(i *Edge[V, W]) bool {
+		return i.to.Compare(to) == 0
+	})
+}
+
+// removeOut removes the edge of v which ends in the vertex to.
+func (v *vertex[V, W]) removeOut(to V) {
+	v.out = slices.DeleteFunc(v.out, func(i *Edge[V, W]) bool {
+		return i.to.Compare(to) == 0
+	})
+}
+
+// removeIn removes the edge of v which starts from the vertex from.
+func (v *vertex[V, W]) removeIn(from V) {
+	v.in = slices.DeleteFunc(v.in, func(i *Edge[V, W]) bool {
+		return i.from.Compare(from) == 0
+	})
+}
+
+type jsonGraph[V util.Hasher, W any] struct {
+	Directed bool             `json:"directed"`
+	Vertices []V              `json:"vertices"`
+	Edges    []jsonEdge[V, W] `json:"edges"`
+}
+
+type jsonEdge[V util.Hasher, W any] struct {
+	From   V `json:"from"`
+	To     V `json:"to"`
+	Weight W `json:"weight"`
+}
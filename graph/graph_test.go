@@ -0,0 +1,404 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewDirectedGraph(t *testing.T) {
+
+	var graph structures.Structure[wrapper.Int] = NewDirectedGraph[wrapper.Int, int]()
+
+	if graph == nil {
+		t.Log("graph is nil")
+		t.Fail()
+	}
+	if graph.Len() != 0 {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+}
+func TestNewUndirectedGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.String, float64] = NewUndirectedGraph[wrapper.String, float64]("a", "b", "a")
+
+	if graph.Len() != 2 {
+		t.Log("length is not 2")
+		t.Fail()
+	}
+	if graph.IsDirected() {
+		t.Log("graph is directed")
+		t.Fail()
+	}
+	if !reflect.DeepEqual(graph.ToSlice(), []wrapper.String{"a", "b"}) {
+		t.Log("vertices are", graph.ToSlice())
+		t.Fail()
+	}
+}
+func TestAddEdgeDirectedGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, int] = NewDirectedGraph[wrapper.Int, int](1)
+
+	if _, ok := graph.AddEdge(1, 2, 5); ok {
+		t.Log("edge already present")
+		t.Fail()
+	}
+	graph.AddEdge(1, 3, 2)
+	graph.AddEdge(3, 1, 4)
+	if e, ok := graph.AddEdge(1, 2, 6); !ok || e != 5 {
+		t.Log("weight is", e)
+		t.Fail()
+	}
+	if graph.Len() != 3 || graph.EdgeCount() != 3 {
+		t.Log("length is", graph.Len(), "edges are", graph.EdgeCount())
+		t.Fail()
+	}
+	if e, ok := graph.Weight(1, 2); !ok || e != 6 {
+		t.Log("weight is", e)
+		t.Fail()
+	}
+	if graph.ContainsEdge(2, 1) {
+		t.Log("edge 2 -> 1 found")
+		t.Fail()
+	}
+	if !reflect.DeepEqual(graph.Neighbors(1).ToSlice(), []wrapper.Int{2, 3}) {
+		t.Log("neighbors are", graph.Neighbors(1))
+		t.Fail()
+	}
+	if graph.Degree(1) != 2 || graph.InDegree(1) != 1 || graph.InDegree(2) != 1 || graph.Degree(4) != -1 {
+		t.Log("degrees are", graph.Degree(1), graph.InDegree(1), graph.InDegree(2), graph.Degree(4))
+		t.Fail()
+	}
+	if !graph.Neighbors(4).IsEmpty() {
+		t.Log("neighbors are", graph.Neighbors(4))
+		t.Fail()
+	}
+}
+func TestAddEdgeUndirectedGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, int] = NewUndirectedGraph[wrapper.Int, int]()
+
+	graph.AddEdge(1, 2, 5)
+	graph.AddEdge(3, 1, 2)
+	graph.AddEdge(3, 3, 1)
+	if e, ok := graph.AddEdge(2, 1, 7); !ok || e != 5 {
+		t.Log("weight is", e)
+		t.Fail()
+	}
+	if graph.EdgeCount() != 3 {
+		t.Log("edges are", graph.EdgeCount())
+		t.Fail()
+	}
+	if e, ok := graph.Weight(1, 2); !ok || e != 7 {
+		t.Log("weight is", e)
+		t.Fail()
+	}
+	if !graph.ContainsEdge(1, 3) {
+		t.Log("edge 1 - 3 not found")
+		t.Fail()
+	}
+	if !reflect.DeepEqual(graph.Neighbors(3).ToSlice(), []wrapper.Int{1, 3}) {
+		t.Log("neighbors are", graph.Neighbors(3))
+		t.Fail()
+	}
+	if graph.Degree(1) != 2 || graph.InDegree(1) != 2 {
+		t.Log("degrees are", graph.Degree(1), graph.InDegree(1))
+		t.Fail()
+	}
+	if graph.Edges().Len() != 3 {
+		t.Log("edges are", graph.Edges())
+		t.Fail()
+	}
+}
+func TestRemoveEdgeGraph(t *testing.T) {
+
+	var directed *Graph[wrapper.Int, int] = NewDirectedGraph[wrapper.Int, int]()
+	var undirected *Graph[wrapper.Int, int] = NewUndirectedGraph[wrapper.Int, int]()
+
+	directed.AddEdge(1, 2, 5)
+	directed.AddEdge(2, 1, 3)
+	if e, ok := directed.RemoveEdge(1, 2); !ok || e != 5 {
+		t.Log("weight is", e)
+		t.Fail()
+	}
+	if e, ok := directed.RemoveEdge(1, 2); ok || e != 0 {
+		t.Log("weight is", e)
+		t.Fail()
+	}
+	if !directed.ContainsEdge(2, 1) || directed.EdgeCount() != 1 || directed.InDegree(2) != 0 {
+		t.Log("graph is", directed)
+		t.Fail()
+	}
+	undirected.AddEdge(1, 2, 5)
+	undirected.AddEdge(2, 2, 1)
+	if e, ok := undirected.RemoveEdge(2, 1); !ok || e != 5 {
+		t.Log("weight is", e)
+		t.Fail()
+	}
+	if e, ok := undirected.RemoveEdge(2, 2); !ok || e != 1 {
+		t.Log("weight is", e)
+		t.Fail()
+	}
+	if undirected.ContainsEdge(1, 2) || undirected.EdgeCount() != 0 || undirected.Degree(1) != 0 || undirected.Len() != 2 {
+		t.Log("graph is", undirected)
+		t.Fail()
+	}
+}
+func TestRemoveVertexGraph(t *testing.T) {
+
+	var directed *Graph[wrapper.Int, int] = NewDirectedGraph[wrapper.Int, int]()
+	var undirected *Graph[wrapper.Int, int] = NewUndirectedGraph[wrapper.Int, int]()
+
+	directed.AddEdge(1, 2, 1)
+	directed.AddEdge(2, 3, 1)
+	directed.AddEdge(3, 2, 1)
+	directed.AddEdge(2, 2, 1)
+	directed.AddEdge(3, 1, 1)
+	if !directed.RemoveVertex(2) || directed.RemoveVertex(2) {
+		t.Log("vertex 2 not removed")
+		t.Fail()
+	}
+	if directed.Len() != 2 || directed.EdgeCount() != 1 || directed.Degree(1) != 0 || directed.InDegree(3) != 0 {
+		t.Log("graph is", directed)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(directed.ToSlice(), []wrapper.Int{1, 3}) {
+		t.Log("vertices are", directed.ToSlice())
+		t.Fail()
+	}
+	undirected.AddEdge(1, 2, 1)
+	undirected.AddEdge(2, 3, 1)
+	undirected.AddEdge(2, 2, 1)
+	undirected.AddEdge(3, 1, 1)
+	undirected.RemoveVertex(2)
+	if undirected.Len() != 2 || undirected.EdgeCount() != 1 || undirected.Degree(1) != 1 || undirected.Degree(3) != 1 {
+		t.Log("graph is", undirected)
+		t.Fail()
+	}
+}
+func TestBFSGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, struct{}] = NewDirectedGraph[wrapper.Int, struct{}]()
+	var result []wrapper.Int
+
+	graph.AddEdge(1, 2, struct{}{})
+	graph.AddEdge(1, 3, struct{}{})
+	graph.AddEdge(2, 4, struct{}{})
+	graph.AddEdge(3, 4, struct{}{})
+	graph.AddEdge(4, 1, struct{}{})
+	graph.AddEdge(5, 1, struct{}{})
+	for i := range graph.BFS(1) {
+		result = append(result, i)
+	}
+	if !reflect.DeepEqual(result, []wrapper.Int{1, 2, 3, 4}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	result = nil
+	for i := range graph.BFS(1) {
+		result = append(result, i)
+		if len(result) == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(result, []wrapper.Int{1, 2}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	for i := range graph.BFS(6) {
+		t.Log("vertex is", i)
+		t.Fail()
+	}
+}
+func TestDFSGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, struct{}] = NewUndirectedGraph[wrapper.Int, struct{}]()
+	var result []wrapper.Int
+
+	graph.AddEdge(1, 2, struct{}{})
+	graph.AddEdge(1, 3, struct{}{})
+	graph.AddEdge(2, 4, struct{}{})
+	graph.AddEdge(3, 4, struct{}{})
+	graph.AddEdge(4, 5, struct{}{})
+	for i := range graph.DFS(1) {
+		result = append(result, i)
+	}
+	if !reflect.DeepEqual(result, []wrapper.Int{1, 2, 4, 3, 5}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestTopologicalSortGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.String, struct{}] = NewDirectedGraph[wrapper.String, struct{}]("app", "http", "json", "log", "io")
+
+	graph.AddEdge("io", "log", struct{}{})
+	graph.AddEdge("io", "json", struct{}{})
+	graph.AddEdge("log", "http", struct{}{})
+	graph.AddEdge("json", "http", struct{}{})
+	graph.AddEdge("http", "app", struct{}{})
+	graph.AddEdge("log", "app", struct{}{})
+	result, err := graph.TopologicalSort()
+	if err != nil || !reflect.DeepEqual(result.ToSlice(), []wrapper.String{"io", "log", "json", "http", "app"}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	graph.AddEdge("app", "log", struct{}{})
+	result, err = graph.TopologicalSort()
+	var cycle *CycleError[wrapper.String]
+	if result != nil || !errors.As(err, &cycle) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	} else if !reflect.DeepEqual(cycle.Cycle().ToSlice(), []wrapper.String{"app", "log", "http", "app"}) {
+		t.Log("cycle is", cycle.Cycle())
+		t.Fail()
+	}
+	graph.AddEdge("json", "json", struct{}{})
+	if _, err := graph.TopologicalSort(); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	if _, err := NewUndirectedGraph[wrapper.String, struct{}]().TopologicalSort(); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestConnectedComponentsGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, int] = NewDirectedGraph[wrapper.Int, int](1, 2, 3, 4, 5, 6)
+
+	graph.AddEdge(2, 1, 1)
+	graph.AddEdge(3, 2, 1)
+	graph.AddEdge(4, 5, 1)
+	result := graph.ConnectedComponents()
+	if result.Len() != 3 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	for i, j := range [][]wrapper.Int{{1, 2, 3}, {4, 5}, {6}} {
+		if component, _ := result.Get(i); !reflect.DeepEqual(component.ToSlice(), j) {
+			t.Log("component is", component)
+			t.Fail()
+		}
+	}
+}
+func TestEqualGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, int] = NewUndirectedGraph[wrapper.Int, int]()
+	var other *Graph[wrapper.Int, int] = NewUndirectedGraph[wrapper.Int, int](3)
+
+	graph.AddEdge(1, 2, 5)
+	graph.AddEdge(2, 3, 1)
+	other.AddEdge(3, 2, 1)
+	other.AddEdge(2, 1, 5)
+	if !graph.Equal(other) || graph.Hash() != other.Hash() {
+		t.Log("graphs are not equal")
+		t.Fail()
+	}
+	if !graph.Equal(graph.Copy()) {
+		t.Log("copy is not equal")
+		t.Fail()
+	}
+	other.AddEdge(2, 1, 4)
+	if graph.Equal(other) {
+		t.Log("graphs are equal")
+		t.Fail()
+	}
+	directed := NewDirectedGraph[wrapper.Int, int]()
+	directed.AddEdge(1, 2, 5)
+	directed.AddEdge(2, 3, 1)
+	if graph.Equal(directed) {
+		t.Log("graphs are equal")
+		t.Fail()
+	}
+	if graph.Equal(NewUndirectedGraph[wrapper.String, int]()) {
+		t.Log("graphs are equal")
+		t.Fail()
+	}
+}
+func TestCompareGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, int] = NewDirectedGraph[wrapper.Int, int](1, 2)
+
+	if graph.Compare(NewUndirectedGraph[wrapper.Int, int](3, 4)) != 0 {
+		t.Log("compare is not 0")
+		t.Fail()
+	}
+	if graph.Compare(NewDirectedGraph[wrapper.Int, int](1)) != 1 {
+		t.Log("compare is not 1")
+		t.Fail()
+	}
+	if graph.Compare(NewDirectedGraph[wrapper.Int, int](1, 2, 3)) != -1 {
+		t.Log("compare is not -1")
+		t.Fail()
+	}
+	if graph.Compare(NewDirectedGraph[wrapper.String, int]()) != -2 {
+		t.Log("compare is not -2")
+		t.Fail()
+	}
+}
+func TestStringGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, int] = NewDirectedGraph[wrapper.Int, int](3)
+
+	graph.AddEdge(1, 2, 5)
+	graph.AddEdge(1, 3, 4)
+	if graph.String() != "DirectedGraph[wrapper.Int, int][3: [], 1: [2: 5, 3: 4], 2: []]" {
+		t.Log("string is", graph)
+		t.Fail()
+	}
+}
+func TestJSONGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, int] = NewUndirectedGraph[wrapper.Int, int](3)
+
+	graph.AddEdge(1, 2, 5)
+	data, err := json.Marshal(graph)
+	if err != nil || string(data) != `{"directed":false,"vertices":[3,1,2],"edges":[{"from":1,"to":2,"weight":5}]}` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result Graph[wrapper.Int, int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(graph) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`[1]`), &result); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestBinaryGraph(t *testing.T) {
+
+	var graph *Graph[wrapper.Int, float64] = NewDirectedGraph[wrapper.Int, float64](3)
+	var result *Graph[wrapper.Int, float64] = NewUndirectedGraph[wrapper.Int, float64](4)
+
+	graph.AddEdge(1, 2, 0.5)
+	graph.AddEdge(2, 1, 1.5)
+	data, err := graph.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !result.Equal(graph) || !reflect.DeepEqual(result.ToSlice(), graph.ToSlice()) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var buffer bytes.Buffer
+	var zero Graph[wrapper.Int, float64]
+	if err := gob.NewEncoder(&buffer).Encode(graph); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := gob.NewDecoder(&buffer).Decode(&zero); err != nil || !(&zero).Equal(graph) {
+		t.Log("result is", &zero, "err is", err)
+		t.Fail()
+	}
+}
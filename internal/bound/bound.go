@@ -0,0 +1,246 @@
+// package bound implements the ranges shared by the views of the ordered structures of the library.
+package bound
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/potex02/structures/tree"
+)
+
+// Range rapresents an interval of a [tree.RedBlackTree], whose elements of type E are ordered by a key of type K.
+// Every side of the interval can be unbounded.
+//
+// The methods of a range read the tree every time they are called,
+// so the modifications of the tree are always visible through the range.
+type Range[E any, K any] struct {
+	// contains filtered or unexported fields
+	objects       *tree.RedBlackTree[E]
+	compare       func(i K, j K) int
+	key           func(element E) K
+	probe         func(key K) E
+	from          K
+	to            K
+	hasFrom       bool
+	hasTo         bool
+	fromInclusive bool
+	toInclusive   bool
+}
+
+// New returns a new unbounded [Range] of objects.
+//
+// compare is the function which orders the keys, key returns the key of an element of objects
+// and probe returns an element with the key passed to it, which is used to search the key in objects.
+func New[E any, K any](objects *tree.RedBlackTree[E], compare func(i K, j K) int, key func(element E) K, probe func(key K) E) Range[E, K] {
+	return Range[E, K]{objects: objects, compare: compare, key: key, probe: probe}
+}
+
+// From returns a copy of r whose keys are greater than from, or equal to it if inclusive is true.
+// If r already has a stricter lower bound, it is kept.
+func (r Range[E, K]) From(from K, inclusive bool) Range[E, K] {
+	if r.hasFrom {
+		check := r.compare(from, r.from)
+		if check < 0 || (check == 0 && (inclusive || !r.fromInclusive)) {
+			return r
+		}
+	}
+	r.from, r.hasFrom, r.fromInclusive = from, true, inclusive
+	return r
+}
+
+// To returns a copy of r whose keys are less than to, or equal to it if inclusive is true.
+// If r already has a stricter upper bound, it is kept.
+func (r Range[E, K]) To(to K, inclusive bool) Range[E, K] {
+	if r.hasTo {
+		check := r.compare(to, r.to)
+		if check > 0 || (check == 0 && (inclusive || !r.toInclusive)) {
+			return r
+		}
+	}
+	r.to, r.hasTo, r.toInclusive = to, true, inclusive
+	return r
+}
+
+// TooLow returns true if key is lower than the lower bound of r.
+func (r Range[E, K]) TooLow(key K) bool {
+	if !r.hasFrom {
+		return false
+	}
+	check := r.compare(key, r.from)
+	return check < 0 || (check == 0 && !r.fromInclusive)
+}
+
+// TooHigh returns true if key is greater than the upper bound of r.
+func (r Range[E, K]) TooHigh(key K) bool {
+	if !r.hasTo {
+		return false
+	}
+	check := r.compare(key, r.to)
+	return check > 0 || (check == 0 && !r.toInclusive)
+}
+
+// InRange returns true if key is between the bounds of r.
+func (r Range[E, K]) InRange(key K) bool {
+	return !r.TooLow(key) && !r.TooHigh(key)
+}
+
+// Find returns the [tree.Node] containing key.
+// The method returns nil if key is not present or is out of r.
+func (r Range[E, K]) Find(key K) *tree.Node[E] {
+	if !r.InRange(key) {
+		return nil
+	}
+	return r.objects.Find(r.probe(key))
+}
+
+// Len returns the number of elements of r.
+//
+// This method runs in O(log n) time.
+func (r Range[E, K]) Len() int {
+	low := r.offset()
+	high := r.objects.Len()
+	if r.hasTo {
+		high = r.objects.Rank(r.probe(r.to))
+		if r.toInclusive && r.objects.Contains(r.probe(r.to)) {
+			high++
+		}
+	}
+	return max(high-low, 0)
+}
+
+// Index returns the position of node in r.
+//
+// This method runs in O(log n) time.
+func (r Range[E, K]) Index(node *tree.Node[E]) int {
+	return node.Index() - r.offset()
+}
+
+// First returns the [tree.Node] containing the min element of r.
+// The method returns nil if r is empty.
+func (r Range[E, K]) First() *tree.Node[E] {
+
+	var node *tree.Node[E]
+
+	switch {
+	case r.hasFrom && r.fromInclusive:
+		node = r.objects.Ceiling(r.probe(r.from))
+	case r.hasFrom:
+		node = r.objects.Higher(r.probe(r.from))
+	case !r.objects.IsEmpty():
+		node = r.objects.Root().Min()
+	}
+	return r.high(node)
+}
+
+// Last returns the [tree.Node] containing the max element of r.
+// The method returns nil if r is empty.
+func (r Range[E, K]) Last() *tree.Node[E] {
+
+	var node *tree.Node[E]
+
+	switch {
+	case r.hasTo && r.toInclusive:
+		node = r.objects.Floor(r.probe(r.to))
+	case r.hasTo:
+		node = r.objects.Lower(r.probe(r.to))
+	case !r.objects.IsEmpty():
+		node = r.objects.Root().Max()
+	}
+	return r.low(node)
+}
+
+// Floor returns the [tree.Node] of r containing the greatest element less than or equal to key.
+// The method returns nil if there is no such element.
+func (r Range[E, K]) Floor(key K) *tree.Node[E] {
+	if r.TooHigh(key) {
+		return r.Last()
+	}
+	return r.low(r.objects.Floor(r.probe(key)))
+}
+
+// Ceiling returns the [tree.Node] of r containing the least element greater than or equal to key.
+// The method returns nil if there is no such element.
+func (r Range[E, K]) Ceiling(key K) *tree.Node[E] {
+	if r.TooLow(key) {
+		return r.First()
+	}
+	return r.high(r.objects.Ceiling(r.probe(key)))
+}
+
+// Lower returns the [tree.Node] of r containing the greatest element strictly less than key.
+// The method returns nil if there is no such element.
+func (r Range[E, K]) Lower(key K) *tree.Node[E] {
+	if r.TooHigh(key) {
+		return r.Last()
+	}
+	return r.low(r.objects.Lower(r.probe(key)))
+}
+
+// Higher returns the [tree.Node] of r containing the least element strictly greater than key.
+// The method returns nil if there is no such element.
+func (r Range[E, K]) Higher(key K) *tree.Node[E] {
+	if r.TooLow(key) {
+		return r.First()
+	}
+	return r.high(r.objects.Higher(r.probe(key)))
+}
+
+// Next returns the [tree.Node] following node in r.
+// The method returns nil if node is the last node of r.
+func (r Range[E, K]) Next(node *tree.Node[E]) *tree.Node[E] {
+	return r.high(node.Successor())
+}
+
+// Remove removes node from the tree of r and returns the [tree.Node] following it in r.
+// The method returns nil if node was the last node of r.
+func (r Range[E, K]) Remove(node *tree.Node[E]) *tree.Node[E] {
+	return r.high(r.objects.RemoveNode(node))
+}
+
+// RangeIter returns a function that allows to iterate the elements of r using the range keyword.
+func (r Range[E, K]) RangeIter() func(yield func(E) bool) {
+	return func(yield func(E) bool) {
+		for node := r.First(); node != nil; node = r.Next(node) {
+			if !yield(node.Element()) {
+				return
+			}
+		}
+	}
+}
+
+// Hash returns the hash code of the elements of r, which is the same of a [tree.RedBlackTree] containing only them.
+func (r Range[E, K]) Hash() uint64 {
+	h := fnv.New64()
+	for node := r.First(); node != nil; node = r.Next(node) {
+		h.Write([]byte(fmt.Sprintf("%v", node.Hash())))
+	}
+	return h.Sum64()
+}
+
+// offset returns the number of elements of the tree lower than the lower bound of r.
+func (r Range[E, K]) offset() int {
+	if !r.hasFrom {
+		return 0
+	}
+	result := r.objects.Rank(r.probe(r.from))
+	if !r.fromInclusive && r.objects.Contains(r.probe(r.from)) {
+		result++
+	}
+	return result
+}
+
+// low returns node if it is not lower than the lower bound of r, otherwise it returns nil.
+func (r Range[E, K]) low(node *tree.Node[E]) *tree.Node[E] {
+	if node == nil || r.TooLow(r.key(node.Element())) {
+		return nil
+	}
+	return node
+}
+
+// high returns node if it is not greater than the upper bound of r, otherwise it returns nil.
+func (r Range[E, K]) high(node *tree.Node[E]) *tree.Node[E] {
+	if node == nil || r.TooHigh(r.key(node.Element())) {
+		return nil
+	}
+	return node
+}
@@ -0,0 +1,382 @@
+package codec
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Version is the version of the binary format written by [Encoder].
+//
+// The format is made by the version, the number of encoded values and the values themselves.
+// The booleans, the numbers and the strings, including the ones of the wrapper package, are written directly,
+// while any other value is encoded with [gob] and prefixed with its length.
+const Version byte = 1
+
+var gobEncoder = reflect.TypeFor[gob.GobEncoder]()
+var binaryMarshaler = reflect.TypeFor[encoding.BinaryMarshaler]()
+
+// Encoder writes the binary encoding of a structure.
+type Encoder struct {
+	// contains filtered or unexported fields
+	data    []byte
+	buffer  bytes.Buffer
+	encoder *gob.Encoder
+}
+
+// NewEncoder returns a new [Encoder] for a structure containing len values.
+func NewEncoder(len int) *Encoder {
+	e := &Encoder{data: []byte{Version}}
+	e.data = binary.AppendUvarint(e.data, uint64(len))
+	return e
+}
+
+// Bytes returns the data written by e.
+func (e *Encoder) Bytes() []byte {
+	return e.data
+}
+
+// Decoder reads the binary encoding written by an [Encoder].
+type Decoder struct {
+	// contains filtered or unexported fields
+	data    []byte
+	buffer  bytes.Buffer
+	decoder *gob.Decoder
+}
+
+// NewDecoder returns a new [Decoder] reading data and the number of values contained in data.
+// It returns an error if data has not been written by an [Encoder] of the same [Version].
+func NewDecoder(data []byte) (*Decoder, int, error) {
+	if len(data) == 0 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	if data[0] != Version {
+		return nil, 0, errors.New("Unsupported binary version " + strconv.Itoa(int(data[0])))
+	}
+	d := &Decoder{data: data[1:]}
+	n, err := d.uvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	if n > uint64(len(d.data)) {
+		return nil, 0, errors.New("Invalid binary length " + strconv.FormatUint(n, 10))
+	}
+	return d, int(n), nil
+}
+
+// Close returns an error if not all data has been read by d.
+func (d *Decoder) Close() error {
+	if len(d.data) != 0 {
+		return errors.New("Unexpected " + strconv.Itoa(len(d.data)) + " bytes at the end of binary data")
+	}
+	return nil
+}
+
+func (d *Decoder) uvarint() (uint64, error) {
+	result, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	d.data = d.data[n:]
+	return result, nil
+}
+
+func (d *Decoder) varint() (int64, error) {
+	result, n := binary.Varint(d.data)
+	if n <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	d.data = d.data[n:]
+	return result, nil
+}
+
+func (d *Decoder) next(n uint64) ([]byte, error) {
+	if n > uint64(len(d.data)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	result := d.data[:n]
+	d.data = d.data[n:]
+	return result, nil
+}
+
+// Codec encodes and decodes the values of type T.
+type Codec[T any] struct {
+	// contains filtered or unexported fields
+	kind reflect.Kind
+}
+
+// NewCodec returns a new [Codec] for the type T.
+//
+// The values whose type implements [gob.GobEncoder] or [encoding.BinaryMarshaler] are always encoded with [gob],
+// so that their own encoding is used.
+func NewCodec[T any]() Codec[T] {
+	value := reflect.TypeFor[T]()
+	if value.Kind() != reflect.Interface && (value.Implements(gobEncoder) || value.Implements(binaryMarshaler)) {
+		return Codec[T]{kind: reflect.Invalid}
+	}
+	return Codec[T]{kind: value.Kind()}
+}
+
+// Encode writes value in e.
+func (c Codec[T]) Encode(e *Encoder, value T) error {
+	if c.kind == reflect.Bool || (c.kind >= reflect.Int && c.kind <= reflect.Complex128) || c.kind == reflect.String {
+		e.data = appendValue(e.data, reflect.ValueOf(&value).Elem())
+		return nil
+	}
+	if e.encoder == nil {
+		e.encoder = gob.NewEncoder(&e.buffer)
+	}
+	e.buffer.Reset()
+	if err := e.encoder.Encode(&value); err != nil {
+		return err
+	}
+	e.data = binary.AppendUvarint(e.data, uint64(e.buffer.Len()))
+	e.data = append(e.data, e.buffer.Bytes()...)
+	return nil
+}
+
+// Decode reads a value from d.
+func (c Codec[T]) Decode(d *Decoder) (T, error) {
+
+	var result T
+
+	value := reflect.ValueOf(&result).Elem()
+	switch c.kind {
+	case reflect.Bool:
+		data, err := d.next(1)
+		if err != nil {
+			return result, err
+		}
+		value.SetBool(data[0] != 0)
+		return result, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := d.varint()
+		if err == nil && value.OverflowInt(n) {
+			err = errors.New("Value " + strconv.FormatInt(n, 10) + " overflows " + value.Type().String())
+		}
+		if err != nil {
+			return result, err
+		}
+		value.SetInt(n)
+		return result, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := d.uvarint()
+		if err == nil && value.OverflowUint(n) {
+			err = errors.New("Value " + strconv.FormatUint(n, 10) + " overflows " + value.Type().String())
+		}
+		if err != nil {
+			return result, err
+		}
+		value.SetUint(n)
+		return result, nil
+	case reflect.Float32:
+		data, err := d.next(4)
+		if err != nil {
+			return result, err
+		}
+		value.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(data))))
+		return result, nil
+	case reflect.Float64:
+		data, err := d.next(8)
+		if err != nil {
+			return result, err
+		}
+		value.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(data)))
+		return result, nil
+	case reflect.Complex64:
+		data, err := d.next(8)
+		if err != nil {
+			return result, err
+		}
+		r := math.Float32frombits(binary.LittleEndian.Uint32(data))
+		i := math.Float32frombits(binary.LittleEndian.Uint32(data[4:]))
+		value.SetComplex(complex(float64(r), float64(i)))
+		return result, nil
+	case reflect.Complex128:
+		data, err := d.next(16)
+		if err != nil {
+			return result, err
+		}
+		r := math.Float64frombits(binary.LittleEndian.Uint64(data))
+		i := math.Float64frombits(binary.LittleEndian.Uint64(data[8:]))
+		value.SetComplex(complex(r, i))
+		return result, nil
+	case reflect.String:
+		n, err := d.uvarint()
+		if err != nil {
+			return result, err
+		}
+		data, err := d.next(n)
+		if err != nil {
+			return result, err
+		}
+		value.SetString(string(data))
+		return result, nil
+	}
+	n, err := d.uvarint()
+	if err != nil {
+		return result, err
+	}
+	data, err := d.next(n)
+	if err != nil {
+		return result, err
+	}
+	if d.decoder == nil {
+		d.decoder = gob.NewDecoder(&d.buffer)
+	}
+	d.buffer.Write(data)
+	err = d.decoder.Decode(&result)
+	if err == nil && d.buffer.Len() != 0 {
+		err = errors.New("Unexpected " + strconv.Itoa(d.buffer.Len()) + " bytes in gob value")
+	}
+	return result, err
+}
+
+func appendValue(data []byte, value reflect.Value) []byte {
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			return append(data, 1)
+		}
+		return append(data, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(data, value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(data, value.Uint())
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(data, math.Float32bits(float32(value.Float())))
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(data, math.Float64bits(value.Float()))
+	case reflect.Complex64:
+		data = binary.LittleEndian.AppendUint32(data, math.Float32bits(float32(real(value.Complex()))))
+		return binary.LittleEndian.AppendUint32(data, math.Float32bits(float32(imag(value.Complex()))))
+	case reflect.Complex128:
+		data = binary.LittleEndian.AppendUint64(data, math.Float64bits(real(value.Complex())))
+		return binary.LittleEndian.AppendUint64(data, math.Float64bits(imag(value.Complex())))
+	}
+	data = binary.AppendUvarint(data, uint64(value.Len()))
+	return append(data, value.String()...)
+}
+
+// MarshalBinary returns the binary encoding of the len values produced by seq.
+func MarshalBinary[T any](len int, seq func(yield func(T) bool)) ([]byte, error) {
+	e := NewEncoder(len)
+	c := NewCodec[T]()
+	for i := range seq {
+		if err := c.Encode(e, i); err != nil {
+			return nil, err
+		}
+	}
+	return e.Bytes(), nil
+}
+
+// MarshalBinarySlice returns the binary encoding of the values of s.
+func MarshalBinarySlice[T any](s []T) ([]byte, error) {
+	return MarshalBinary(len(s), func(yield func(T) bool) {
+		for _, i := range s {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+}
+
+// UnmarshalBinary returns the values decoded from the binary encoding data.
+func UnmarshalBinary[T any](data []byte) ([]T, error) {
+	d, len, err := NewDecoder(data)
+	if err != nil {
+		return nil, err
+	}
+	c := NewCodec[T]()
+	result := make([]T, len)
+	for i := range result {
+		if result[i], err = c.Decode(d); err != nil {
+			return nil, err
+		}
+	}
+	return result, d.Close()
+}
+
+// DecodeBinary decodes the values of the binary encoding data and passes them to decode.
+// It is used by the UnmarshalBinary methods of the structures, which replace their elements in decode.
+func DecodeBinary[T any](data []byte, decode func(objects []T) error) error {
+	objects, err := UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	return decode(objects)
+}
+
+// MarshalBinaryEntries returns the binary encoding of the len entries produced by seq.
+// Every key is followed by its element.
+func MarshalBinaryEntries[K any, T any](len int, seq func(yield func(K, T) bool)) ([]byte, error) {
+	e := NewEncoder(len)
+	keys := NewCodec[K]()
+	elements := NewCodec[T]()
+	for i, j := range seq {
+		if err := keys.Encode(e, i); err != nil {
+			return nil, err
+		}
+		if err := elements.Encode(e, j); err != nil {
+			return nil, err
+		}
+	}
+	return e.Bytes(), nil
+}
+
+// UnmarshalBinaryEntries returns the keys and the elements decoded from the binary encoding data.
+func UnmarshalBinaryEntries[K any, T any](data []byte) ([]K, []T, error) {
+	d, len, err := NewDecoder(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := NewCodec[K]()
+	elements := NewCodec[T]()
+	resultKeys := make([]K, len)
+	resultElements := make([]T, len)
+	for i := 0; i != len; i++ {
+		if resultKeys[i], err = keys.Decode(d); err != nil {
+			return nil, nil, err
+		}
+		if resultElements[i], err = elements.Decode(d); err != nil {
+			return nil, nil, err
+		}
+	}
+	return resultKeys, resultElements, d.Close()
+}
+
+// DecodeBinaryEntries decodes the keys and the elements of the binary encoding data and passes them to decode.
+// It is used by the UnmarshalBinary methods of the tables, which replace their entries in decode.
+func DecodeBinaryEntries[K any, T any](data []byte, decode func(keys []K, elements []T) error) error {
+	keys, elements, err := UnmarshalBinaryEntries[K, T](data)
+	if err != nil {
+		return err
+	}
+	return decode(keys, elements)
+}
+
+// Marshal returns the binary encoding of value, which must implement [encoding.BinaryMarshaler].
+// It is used by the structures which wrap another structure.
+func Marshal(value any) ([]byte, error) {
+	marshaler, ok := value.(encoding.BinaryMarshaler)
+	if !ok {
+		return nil, errors.New("Cannot encode a " + reflect.TypeOf(value).String() + " which does not implement encoding.BinaryMarshaler")
+	}
+	return marshaler.MarshalBinary()
+}
+
+// Unmarshal decodes data into value, which must implement [encoding.BinaryUnmarshaler].
+// It is used by the structures which wrap another structure.
+func Unmarshal(data []byte, value any) error {
+	unmarshaler, ok := value.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return errors.New("Cannot decode a " + reflect.TypeOf(value).String() + " which does not implement encoding.BinaryUnmarshaler")
+	}
+	return unmarshaler.UnmarshalBinary(data)
+}
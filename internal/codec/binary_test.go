@@ -0,0 +1,176 @@
+package codec
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+type test struct {
+	N1 int
+	N2 []string
+}
+
+func TestMarshalBinary(t *testing.T) {
+
+	var ints []int = []int{1, -200, 3}
+	var strings []wrapper.String = []wrapper.String{"a", "", "bc"}
+	var complexes []complex64 = []complex64{1 + 2i, -0.5i}
+	var structs []test = []test{{N1: 1, N2: []string{"a"}}, {N1: -2}}
+
+	data, err := MarshalBinarySlice(ints)
+	if err != nil || data[0] != Version {
+		t.Log("data is", data, "err is", err)
+		t.Fail()
+	}
+	if result, err := UnmarshalBinary[int](data); err != nil || !reflect.DeepEqual(result, ints) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	data, _ = MarshalBinarySlice(strings)
+	if result, err := UnmarshalBinary[wrapper.String](data); err != nil || !reflect.DeepEqual(result, strings) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	data, _ = MarshalBinarySlice(complexes)
+	if result, err := UnmarshalBinary[complex64](data); err != nil || !reflect.DeepEqual(result, complexes) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	data, _ = MarshalBinarySlice(structs)
+	if result, err := UnmarshalBinary[test](data); err != nil || !reflect.DeepEqual(result, structs) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	data, _ = MarshalBinarySlice(ints)
+	if _, err := UnmarshalBinary[int8](data); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestMarshalBinaryEntries(t *testing.T) {
+
+	var keys []string = []string{"a", "b"}
+	var elements []test = []test{{N1: 1}, {N1: 2, N2: []string{"c"}}}
+
+	data, err := MarshalBinaryEntries(len(keys), func(yield func(string, test) bool) {
+		for i := range keys {
+			if !yield(keys[i], elements[i]) {
+				return
+			}
+		}
+	})
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	resultKeys, resultElements, err := UnmarshalBinaryEntries[string, test](data)
+	if err != nil || !reflect.DeepEqual(resultKeys, keys) || !reflect.DeepEqual(resultElements, elements) {
+		t.Log("keys are", resultKeys, "elements are", resultElements, "err is", err)
+		t.Fail()
+	}
+	for i := 0; i != len(data); i++ {
+		if _, _, err := UnmarshalBinaryEntries[string, test](data[:i]); err == nil {
+			t.Log("err is nil for", i, "bytes")
+			t.Fail()
+		}
+	}
+}
+func TestDecodeBinary(t *testing.T) {
+
+	var ints []int = []int{1, 2, 3}
+	var calls int = 0
+
+	data, _ := MarshalBinarySlice(ints)
+	decode := func(objects []int) error {
+		calls++
+		if !reflect.DeepEqual(objects, ints) {
+			return io.ErrUnexpectedEOF
+		}
+		return nil
+	}
+	if err := DecodeBinary(data, decode); err != nil || calls != 1 {
+		t.Log("err is", err, "calls are", calls)
+		t.Fail()
+	}
+	if err := DecodeBinary(data[:len(data)-1], decode); err == nil || calls != 1 {
+		t.Log("err is", err, "calls are", calls)
+		t.Fail()
+	}
+	data, _ = MarshalBinaryEntries(len(ints), func(yield func(int, int) bool) {
+		for i, j := range ints {
+			if !yield(i, j) {
+				return
+			}
+		}
+	})
+	if err := DecodeBinaryEntries(data, func(keys []int, elements []int) error {
+		calls++
+		return decode(elements)
+	}); err != nil || calls != 3 {
+		t.Log("err is", err, "calls are", calls)
+		t.Fail()
+	}
+}
+func TestUnmarshalBinaryTruncated(t *testing.T) {
+
+	var ints []int = []int{1, 300, -70000}
+	var structs []test = []test{{N1: 1, N2: []string{"a"}}, {N1: -2}}
+
+	data, _ := MarshalBinarySlice(ints)
+	for i := 0; i != len(data); i++ {
+		if _, err := UnmarshalBinary[int](data[:i]); err == nil {
+			t.Log("err is nil for", i, "bytes")
+			t.Fail()
+		}
+	}
+	if _, err := UnmarshalBinary[int](nil); err != io.ErrUnexpectedEOF {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	data, _ = MarshalBinarySlice(structs)
+	for i := 0; i != len(data); i++ {
+		if _, err := UnmarshalBinary[test](data[:i]); err == nil {
+			t.Log("err is nil for", i, "bytes")
+			t.Fail()
+		}
+	}
+	if _, err := UnmarshalBinary[test](append(data, 0)); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestUnmarshalBinaryVersion(t *testing.T) {
+
+	var ints []int = []int{1, 2, 3}
+
+	data, _ := MarshalBinarySlice(ints)
+	data[0]++
+	if _, err := UnmarshalBinary[int](data); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	if _, _, err := UnmarshalBinaryEntries[int, int](data); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	if _, _, err := NewDecoder([]byte{Version, 5, 1}); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestMarshal(t *testing.T) {
+
+	var value test = test{N1: 1}
+
+	if _, err := Marshal(value); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	if err := Unmarshal([]byte{Version, 0}, &value); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
@@ -0,0 +1,43 @@
+// package codec implements the helpers shared by the encoding methods of the structures of the library.
+package codec
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/potex02/structures/util"
+)
+
+var comparer = reflect.TypeFor[util.Comparer]()
+
+// Compare returns a comparison function which uses the Compare method of T.
+//
+// It is used to rebuild an ordered structure decoded into a zero value,
+// so it returns nil if T does not implement [util.Comparer].
+func Compare[T any]() func(i T, j T) int {
+	if !reflect.TypeFor[T]().Implements(comparer) {
+		return nil
+	}
+	return func(i T, j T) int {
+		return any(i).(util.Comparer).Compare(j)
+	}
+}
+
+// MarshalSlice returns the JSON encoding of s.
+// Unlike [json.Marshal], a nil slice is encoded as an empty JSON array.
+func MarshalSlice[T any](s []T) ([]byte, error) {
+	if s == nil {
+		s = make([]T, 0)
+	}
+	return json.Marshal(s)
+}
+
+// DecodeJSON decodes the values of the JSON array data and passes them to decode.
+// It is used by the UnmarshalJSON methods of the structures, which replace their elements in decode.
+func DecodeJSON[T any](data []byte, decode func(objects []T) error) error {
+	var objects []T
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return err
+	}
+	return decode(objects)
+}
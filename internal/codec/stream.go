@@ -0,0 +1,158 @@
+package codec
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+)
+
+// StreamEncoder writes a stream of records on an [io.Writer].
+//
+// The stream is made by the [Version], the records prefixed with their length and a zero length which ends the stream.
+// Each record is written by an [Encoder] which is shared by all the records, so that the types encoded with gob are sent only once.
+type StreamEncoder struct {
+	// contains filtered or unexported fields
+	writer  io.Writer
+	encoder Encoder
+	length  []byte
+	header  bool
+	closed  bool
+}
+
+// NewStreamEncoder returns a new [StreamEncoder] which writes on w.
+func NewStreamEncoder(w io.Writer) *StreamEncoder {
+	return &StreamEncoder{writer: w}
+}
+
+// Closed returns true if s has been closed.
+func (s *StreamEncoder) Closed() bool {
+	return s.closed
+}
+
+// Write writes a record containing the values written by encode.
+// It must not be called after Close.
+func (s *StreamEncoder) Write(encode func(e *Encoder) error) error {
+	if err := s.writeHeader(); err != nil {
+		return err
+	}
+	s.encoder.data = s.encoder.data[:0]
+	if err := encode(&s.encoder); err != nil {
+		return err
+	}
+	s.length = binary.AppendUvarint(s.length[:0], uint64(len(s.encoder.data)))
+	if _, err := s.writer.Write(s.length); err != nil {
+		return err
+	}
+	_, err := s.writer.Write(s.encoder.data)
+	return err
+}
+
+// Close ends the stream.
+// It does not close the underlying [io.Writer].
+func (s *StreamEncoder) Close() error {
+	if s.closed {
+		return nil
+	}
+	if err := s.writeHeader(); err != nil {
+		return err
+	}
+	s.closed = true
+	_, err := s.writer.Write([]byte{0})
+	return err
+}
+
+func (s *StreamEncoder) writeHeader() error {
+	if s.header {
+		return nil
+	}
+	if _, err := s.writer.Write([]byte{Version}); err != nil {
+		return err
+	}
+	s.header = true
+	return nil
+}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// StreamDecoder reads a stream of records written by a [StreamEncoder] from an [io.Reader].
+type StreamDecoder struct {
+	// contains filtered or unexported fields
+	reader  byteReader
+	decoder Decoder
+	buffer  []byte
+	header  bool
+	end     bool
+}
+
+// NewStreamDecoder returns a new [StreamDecoder] which reads from r.
+//
+// If r does not implement [io.ByteReader], it is wrapped in a [bufio.Reader],
+// so the data following the end of the stream may be read from r.
+func NewStreamDecoder(r io.Reader) *StreamDecoder {
+	reader, ok := r.(byteReader)
+	if !ok {
+		reader = bufio.NewReader(r)
+	}
+	return &StreamDecoder{reader: reader}
+}
+
+// Read reads a record using decode.
+// It returns [io.EOF] if the stream is ended and [io.ErrUnexpectedEOF] if the reader ends before the end of the stream.
+func (s *StreamDecoder) Read(decode func(d *Decoder) error) error {
+	if s.end {
+		return io.EOF
+	}
+	if !s.header {
+		version, err := s.reader.ReadByte()
+		if err != nil {
+			return unexpected(err)
+		}
+		if version != Version {
+			return errors.New("Unsupported binary version " + strconv.Itoa(int(version)))
+		}
+		s.header = true
+	}
+	n, err := binary.ReadUvarint(s.reader)
+	if err != nil {
+		return unexpected(err)
+	}
+	if n == 0 {
+		s.end = true
+		return io.EOF
+	}
+	if n <= uint64(cap(s.buffer)) {
+		s.buffer = s.buffer[:n]
+		if _, err := io.ReadFull(s.reader, s.buffer); err != nil {
+			return unexpected(err)
+		}
+	} else {
+		// The buffer grows with the data actually read, so a corrupted length does not allocate it all at once.
+		if n > math.MaxInt64 {
+			return errors.New("Invalid binary length " + strconv.FormatUint(n, 10))
+		}
+		if s.buffer, err = io.ReadAll(io.LimitReader(s.reader, int64(n))); err != nil {
+			return err
+		}
+		if uint64(len(s.buffer)) != n {
+			return io.ErrUnexpectedEOF
+		}
+	}
+	s.decoder.data = s.buffer
+	if err := decode(&s.decoder); err != nil {
+		return err
+	}
+	return s.decoder.Close()
+}
+
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
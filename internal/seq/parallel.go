@@ -0,0 +1,202 @@
+package seq
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Workers returns the number of workers to use for a parallel operation.
+// If n is less than 1, it returns [runtime.GOMAXPROCS].
+func Workers(n int) int {
+	if n < 1 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return n
+}
+
+// batchFactor is the number of elements for each worker read from a sequence before executing a parallel operation.
+const batchFactor = 1024
+
+// ParallelFilterMap returns a sequence containing the results of fun that satisfy it, computed by at most workers goroutines.
+// The elements of s are read in batches of at most 1024 elements for each worker, so s can be infinite.
+// Every batch is split in a contiguous part for each worker, and index is the position of the element in s.
+//
+// If ordered is true, the results of a batch are yielded in the order of s after all workers are terminated,
+// otherwise they are yielded as soon as they are computed.
+// In both cases, the results of a batch are yielded before the ones of the next batch.
+//
+// A panic in fun is propagated to the goroutine which iterates the sequence.
+func ParallelFilterMap[T any, R any](s iter.Seq[T], workers int, ordered bool, fun func(index int, element T) (R, bool)) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for offset, batch := range batches(s, workers) {
+			if !filterMap(batch, offset, workers, ordered, fun, yield) {
+				return
+			}
+		}
+	}
+}
+
+// ParallelAny returns true if at least one element of s satisfies fun, checking the elements with at most workers goroutines.
+// The elements of s are read in batches as [ParallelFilterMap],
+// and the workers stop as soon as an element satisfying fun is found, without reading the next batches.
+//
+// A panic in fun is propagated to the caller.
+func ParallelAny[T any](s iter.Seq[T], workers int, fun func(index int, element T) bool) bool {
+	var found atomic.Bool
+	for offset, batch := range batches(s, workers) {
+		run(len(batch), workers, func(_ int, from int, to int) {
+			for i := from; i != to && !found.Load(); i++ {
+				if fun(offset+i, batch[i]) {
+					found.Store(true)
+				}
+			}
+		})
+		if found.Load() {
+			return true
+		}
+	}
+	return false
+}
+
+// ParallelCount returns the number of elements of s that satisfy fun, counting them with at most workers goroutines.
+// The elements of s are read in batches as [ParallelFilterMap], so s must be finite.
+// Every worker counts the elements of its part and the partial results are summed.
+//
+// A panic in fun is propagated to the caller.
+func ParallelCount[T any](s iter.Seq[T], workers int, fun func(index int, element T) bool) int {
+	counts := make([]int, Workers(workers))
+	for offset, batch := range batches(s, workers) {
+		run(len(batch), workers, func(worker int, from int, to int) {
+			for i := from; i != to; i++ {
+				if fun(offset+i, batch[i]) {
+					counts[worker]++
+				}
+			}
+		})
+	}
+	result := 0
+	for _, i := range counts {
+		result += i
+	}
+	return result
+}
+
+// batches returns a sequence of the batches of s read by a parallel operation with workers goroutines,
+// together with the position in s of their first element.
+//
+// The slice of a batch is reused for the next one, so it must not be retained after the iteration step.
+func batches[T any](s iter.Seq[T], workers int) iter.Seq2[int, []T] {
+	return func(yield func(int, []T) bool) {
+		size := Workers(workers) * batchFactor
+		batch := make([]T, 0, size)
+		offset := 0
+		for i := range s {
+			batch = append(batch, i)
+			if len(batch) != size {
+				continue
+			}
+			if !yield(offset, batch) {
+				return
+			}
+			offset += size
+			batch = batch[:0]
+		}
+		if len(batch) != 0 {
+			yield(offset, batch)
+		}
+	}
+}
+
+// filterMap executes fun on the elements of batch with at most workers goroutines and yields the results that satisfy it.
+// offset is the position in the whole sequence of the first element of batch.
+// It returns false if yield has stopped the iteration.
+func filterMap[T any, R any](batch []T, offset int, workers int, ordered bool, fun func(index int, element T) (R, bool), yield func(R) bool) bool {
+	if ordered {
+		results := make([]R, len(batch))
+		oks := make([]bool, len(batch))
+		run(len(batch), workers, func(_ int, from int, to int) {
+			for i := from; i != to; i++ {
+				results[i], oks[i] = fun(offset+i, batch[i])
+			}
+		})
+		for i := range results {
+			if oks[i] && !yield(results[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	results := make(chan R, Workers(workers))
+	done := make(chan struct{})
+	defer close(done)
+	var failure panicValue
+	go func() {
+		defer close(results)
+		failure = start(len(batch), workers, func(_ int, from int, to int) {
+			for i := from; i != to; i++ {
+				element, ok := fun(offset+i, batch[i])
+				if !ok {
+					continue
+				}
+				select {
+				case results <- element:
+				case <-done:
+					return
+				}
+			}
+		})
+	}()
+	for i := range results {
+		if !yield(i) {
+			return false
+		}
+	}
+	failure.repanic()
+	return true
+}
+
+type panicValue struct {
+	value any
+	ok    bool
+}
+
+func (p panicValue) repanic() {
+	if p.ok {
+		panic(p.value)
+	}
+}
+
+// run executes fun on the parts of [0, n) with at most workers goroutines and waits for them,
+// propagating the first panic of a worker.
+func run(n int, workers int, fun func(worker int, from int, to int)) {
+	start(n, workers, fun).repanic()
+}
+
+// start executes fun on the parts of [0, n) with at most workers goroutines and waits for them,
+// returning the first panic of a worker.
+func start(n int, workers int, fun func(worker int, from int, to int)) panicValue {
+	workers = min(Workers(workers), max(n, 1))
+	size := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	var once sync.Once
+	var result panicValue
+	for i := 0; i != workers; i++ {
+		from, to := min(i*size, n), min((i+1)*size, n)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() {
+						result = panicValue{value: r, ok: true}
+					})
+				}
+			}()
+			fun(i, from, to)
+		}()
+	}
+	wg.Wait()
+	return result
+}
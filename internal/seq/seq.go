@@ -0,0 +1,141 @@
+// package seq implements the lazy operations on [iter.Seq] shared by the streams of the library.
+package seq
+
+import (
+	"iter"
+	"slices"
+)
+
+// Map returns a sequence containing the results of fun for all elements of s.
+func Map[T any, R any](s iter.Seq[T], fun func(index int, element T) R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		index := 0
+		for i := range s {
+			if !yield(fun(index, i)) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// FlatMap returns a sequence containing the concatenation of the results of fun for all elements of s.
+func FlatMap[T any, R any](s iter.Seq[T], fun func(index int, element T) []R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		index := 0
+		for i := range s {
+			for _, j := range fun(index, i) {
+				if !yield(j) {
+					return
+				}
+			}
+			index++
+		}
+	}
+}
+
+// Limit returns a sequence containing at most the first n elements of s.
+func Limit[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		for i := range s {
+			if !yield(i) {
+				return
+			}
+			count++
+			if count == n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a sequence containing the elements of s except the first n.
+func Skip[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		count := 0
+		for i := range s {
+			if count < n {
+				count++
+				continue
+			}
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// DistinctFunc returns a sequence containing the elements of s whose key, computed by fun, has not been already found.
+func DistinctFunc[T any, K comparable](s iter.Seq[T], fun func(element T) K) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		found := map[K]struct{}{}
+		for i := range s {
+			key := fun(i)
+			if _, ok := found[key]; ok {
+				continue
+			}
+			found[key] = struct{}{}
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns a sequence containing the elements of s ordered by compare.
+// The sort is stable and it needs to read all elements of s before yielding the first one.
+func Sorted[T any](s iter.Seq[T], compare func(i T, j T) int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		slice := slices.Collect(s)
+		slices.SortStableFunc(slice, compare)
+		for _, i := range slice {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Zip returns a sequence containing the results of fun for the pairs of elements of s and other in the same position.
+// The sequence ends when the shortest between s and other ends.
+func Zip[T any, U any, R any](s iter.Seq[T], other iter.Seq[U], fun func(i T, j U) R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		next, stop := iter.Pull(other)
+		defer stop()
+		for i := range s {
+			j, ok := next()
+			if !ok || !yield(fun(i, j)) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns a sequence containing slices of size elements of s.
+// The last slice can have less than size elements.
+//
+// This function panics if size is less than 1.
+func Chunk[T any](s iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("Cannot create chunks with a size less than 1")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for i := range s {
+			chunk = append(chunk, i)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) != 0 {
+			yield(chunk)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/util"
 )
 
@@ -371,3 +372,39 @@ func (l *ArrayList[T]) String() string {
 	check := reflect.TypeOf(new(T)).String()
 	return fmt.Sprintf("ArrayList[%v]%v", check[1:], l.objects)
 }
+
+// MarshalJSON returns the JSON encoding of l, which is an array containing its elements.
+func (l *ArrayList[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(l.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of l with the ones decoded from the JSON array data.
+func (l *ArrayList[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, l.decode)
+}
+
+// MarshalBinary returns the binary encoding of l, which contains its elements.
+func (l *ArrayList[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(l.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of l with the ones decoded from the binary encoding data.
+func (l *ArrayList[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, l.decode)
+}
+
+// GobEncode returns the binary encoding of l as [ArrayList.MarshalBinary].
+func (l *ArrayList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// GobDecode decodes data into l as [ArrayList.UnmarshalBinary].
+func (l *ArrayList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of l with objects.
+func (l *ArrayList[T]) decode(objects []T) error {
+	l.objects = objects
+	return nil
+}
@@ -1,6 +1,7 @@
 package list
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -470,6 +471,29 @@ func TestCopyArrayList(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONArrayList(t *testing.T) {
+
+	var list *ArrayList[wrapper.String] = NewArrayList[wrapper.String]("a", "b", "c")
+
+	data, err := json.Marshal(list)
+	if err != nil || string(data) != `["a","b","c"]` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result ArrayList[wrapper.String]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(list) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	if data, err := json.Marshal(NewArrayList[int]()); err != nil || string(data) != "[]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`[1, 2]`), &result); err == nil || !result.Equal(list) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
 
 type test struct {
 	n1, n2 int
@@ -0,0 +1,27 @@
+package list
+
+import "github.com/potex02/structures"
+
+// ToArrayList returns a [structures.Collector] which collects the elements into an [ArrayList].
+func ToArrayList[T any]() structures.Collector[T, *ArrayList[T]] {
+	return structures.NewCollector(func() *ArrayList[T] {
+		return NewArrayList[T]()
+	}, func(result *ArrayList[T], element T) *ArrayList[T] {
+		result.Add(element)
+		return result
+	}, func(result *ArrayList[T]) *ArrayList[T] {
+		return result
+	})
+}
+
+// ToLinkedList returns a [structures.Collector] which collects the elements into a [LinkedList].
+func ToLinkedList[T any]() structures.Collector[T, *LinkedList[T]] {
+	return structures.NewCollector(func() *LinkedList[T] {
+		return NewLinkedList[T]()
+	}, func(result *LinkedList[T], element T) *LinkedList[T] {
+		result.Add(element)
+		return result
+	}, func(result *LinkedList[T]) *LinkedList[T] {
+		return result
+	})
+}
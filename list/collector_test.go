@@ -0,0 +1,52 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/potex02/structures"
+)
+
+func TestToArrayList(t *testing.T) {
+
+	var stream *Stream[int] = NewLinkedList[int](1, 2, -10, 20).Stream()
+
+	stream.Filter(func(index int, element int) bool {
+		return element > 0
+	})
+	if result := CollectTo(stream, ToArrayList[int]()); !result.Equal(NewArrayList[int](1, 2, 20)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestToLinkedList(t *testing.T) {
+
+	var stream *Stream[int] = NewStreamFromSeq(func(yield func(int) bool) {
+		for i := 0; i != 3; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	if result := CollectTo(stream, ToLinkedList[int]()); !result.Equal(NewLinkedList[int](0, 1, 2)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestJoining(t *testing.T) {
+
+	var stream *Stream[int] = NewArrayList[int](1, 2, -10, 20).Stream()
+
+	if result := CollectTo(stream, structures.Joining[int](", ")); result != "1, 2, -10, 20" {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := CollectTo(stream, structures.Counting[int]()); result != 4 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := CollectTo(NewArrayList[int]().Stream(), structures.Joining[int](", ")); result != "" {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
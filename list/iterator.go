@@ -6,6 +6,7 @@ import (
 
 var _ Iterator[int] = NewArrayListIterator[int](NewArrayList[int]())
 var _ Iterator[int] = NewLinkedListIterator[int](NewLinkedList[int]())
+var _ Iterator[int] = NewUnmodifiableListIterator[int](NewArrayList[int]().Iter())
 var _ Iterator[int] = &endIterator[int]{}
 
 // Iterator provides the methods to iterate over a [List].
@@ -233,6 +234,58 @@ func (i *LinkedListIterator[T]) End() bool {
 	return false
 }
 
+// UnmodifiableListIterator is an iterator of an [UnmodifiableList].
+type UnmodifiableListIterator[T any] struct {
+	// contains filtered or unexported fields
+	iterator Iterator[T]
+}
+
+// NewUnmodifiableListIterator returns a new [UnmodifiableListIterator] associated at the iterator parameter.
+func NewUnmodifiableListIterator[T any](iterator Iterator[T]) Iterator[T] {
+	if iterator.End() {
+		return &endIterator[T]{}
+	}
+	return &UnmodifiableListIterator[T]{iterator: iterator}
+}
+
+// Elements returns the element of i.
+func (i *UnmodifiableListIterator[T]) Element() T {
+	return i.iterator.Element()
+}
+
+// Index returns the index of the element of i.
+func (i *UnmodifiableListIterator[T]) Index() int {
+	return i.iterator.Index()
+}
+
+// Remove always panics with [structures.ErrUnmodifiable].
+func (i *UnmodifiableListIterator[T]) Remove() Iterator[T] {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Prev returns the iterator of the previous element.
+func (i *UnmodifiableListIterator[T]) Prev() Iterator[T] {
+	i.iterator = i.iterator.Prev()
+	if i.iterator.End() {
+		return &endIterator[T]{}
+	}
+	return i
+}
+
+// Next returns the iterator of the next element.
+func (i *UnmodifiableListIterator[T]) Next() Iterator[T] {
+	i.iterator = i.iterator.Next()
+	if i.iterator.End() {
+		return &endIterator[T]{}
+	}
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *UnmodifiableListIterator[T]) End() bool {
+	return false
+}
+
 type endIterator[T any] struct{}
 
 func (i *endIterator[T]) Element() T {
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/util"
 )
 
@@ -239,6 +240,46 @@ func (l *LinkedList[T]) RemoveElement(e T) bool {
 	return false
 }
 
+// FirstEntry returns the entry containing the first element of l.
+// The method returns nil if l is empty.
+func (l *LinkedList[T]) FirstEntry() *structures.Entry[T] {
+	return l.root
+}
+
+// LastEntry returns the entry containing the last element of l.
+// The method returns nil if l is empty.
+func (l *LinkedList[T]) LastEntry() *structures.Entry[T] {
+	return l.tail
+}
+
+// AddFirst adds the element e at the start of l and returns the entry containing it.
+//
+// The entry can be passed at [LinkedList.MoveToFirst] and [LinkedList.RemoveEntry], which run in O(1) time.
+func (l *LinkedList[T]) AddFirst(e T) *structures.Entry[T] {
+	entry := structures.NewEntry(e, nil, nil)
+	l.linkFirst(entry)
+	return entry
+}
+
+// MoveToFirst moves entry at the start of l.
+//
+// entry must be an entry of l.
+func (l *LinkedList[T]) MoveToFirst(entry *structures.Entry[T]) {
+	if entry == l.root {
+		return
+	}
+	l.removeEntry(entry)
+	l.linkFirst(entry)
+}
+
+// RemoveEntry removes entry from l and returns its element.
+//
+// entry must be an entry of l.
+func (l *LinkedList[T]) RemoveEntry(entry *structures.Entry[T]) T {
+	l.removeEntry(entry)
+	return entry.Element()
+}
+
 // Each executes fun for all elements of l.
 //
 // This method should be used to remove elements. Use Iter insted.
@@ -439,6 +480,43 @@ func (l *LinkedList[T]) String() string {
 	return fmt.Sprintf("LinkedList[%v]%v", check[1:], l.ToSlice())
 }
 
+// MarshalJSON returns the JSON encoding of l, which is an array containing its elements.
+func (l *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(l.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of l with the ones decoded from the JSON array data.
+func (l *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, l.decode)
+}
+
+// MarshalBinary returns the binary encoding of l, which contains its elements.
+func (l *LinkedList[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(l.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of l with the ones decoded from the binary encoding data.
+func (l *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, l.decode)
+}
+
+// GobEncode returns the binary encoding of l as [LinkedList.MarshalBinary].
+func (l *LinkedList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// GobDecode decodes data into l as [LinkedList.UnmarshalBinary].
+func (l *LinkedList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of l with objects.
+func (l *LinkedList[T]) decode(objects []T) error {
+	l.Clear()
+	l.AddSlice(objects)
+	return nil
+}
+
 func (l *LinkedList[T]) getElementAtIndex(index int) *structures.Entry[T] {
 	if index <= l.len/2 {
 		result := l.root
@@ -473,3 +551,15 @@ func (l *LinkedList[T]) removeEntry(entry *structures.Entry[T]) {
 	}
 	l.len--
 }
+
+func (l *LinkedList[T]) linkFirst(entry *structures.Entry[T]) {
+	entry.SetPrev(nil)
+	entry.SetNext(l.root)
+	if l.root == nil {
+		l.tail = entry
+	} else {
+		l.root.SetPrev(entry)
+	}
+	l.root = entry
+	l.len++
+}
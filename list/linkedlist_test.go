@@ -1,6 +1,7 @@
 package list
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -283,6 +284,33 @@ func TestRemoveLinkedList(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestEntriesLinkedList(t *testing.T) {
+
+	var list *LinkedList[int] = NewLinkedList(2, 3)
+
+	first := list.AddFirst(1)
+	if list.FirstEntry() != first || list.LastEntry().Element() != 3 || !reflect.DeepEqual(list.ToSlice(), []int{1, 2, 3}) {
+		t.Log("list is", list)
+		t.Fail()
+	}
+	last := list.LastEntry()
+	list.MoveToFirst(last)
+	if list.FirstEntry() != last || list.LastEntry().Element() != 2 || !reflect.DeepEqual(list.ToSlice(), []int{3, 1, 2}) {
+		t.Log("list is", list)
+		t.Fail()
+	}
+	list.MoveToFirst(last)
+	if element := list.RemoveEntry(first); element != 1 || list.Len() != 2 || !reflect.DeepEqual(list.ToSlice(), []int{3, 2}) {
+		t.Log("list is", list, "element is", element)
+		t.Fail()
+	}
+	list.RemoveEntry(list.LastEntry())
+	list.RemoveEntry(list.FirstEntry())
+	if !list.IsEmpty() || list.FirstEntry() != nil || list.LastEntry() != nil {
+		t.Log("list is", list)
+		t.Fail()
+	}
+}
 func TestSortLinkedList(t *testing.T) {
 
 	var list List[wrapper.Int] = NewLinkedList[wrapper.Int](1, -2, 5, -3)
@@ -473,3 +501,23 @@ func TestCopyLinkedList(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONLinkedList(t *testing.T) {
+
+	var list *LinkedList[int] = NewLinkedList(1, 2, 3)
+
+	data, err := json.Marshal(list)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result := NewLinkedList(4)
+	if err := json.Unmarshal(data, result); err != nil || !result.Equal(list) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var zero LinkedList[int]
+	if err := json.Unmarshal(data, &zero); err != nil || !zero.Equal(list) {
+		t.Log("result is", &zero, "err is", err)
+		t.Fail()
+	}
+}
@@ -2,12 +2,14 @@
 package list
 
 import (
+	"fmt"
+
 	"github.com/potex02/structures"
 	"github.com/potex02/structures/util"
 )
 
 // List provides all methods to use a generic dynamic list.
-// A list contains all the methods of [structures.Structure].
+// A list contains all the methods of [structures.Structure] and of [ReadOnlyList].
 //
 // A list is indexed starting from 0, but negative indexes are supported. Negative indexes start from the end, meaning that -1 corresponds to the last element.
 //
@@ -15,24 +17,8 @@ import (
 // otherwise it is done with [reflect.DeepEqual].
 type List[T any] interface {
 	structures.Structure[T]
+	ReadOnlyList[T]
 	util.Copier[List[T]]
-	// Contains returns if e is present in the list.
-	Contains(e T) bool
-	// IndexOf returns the first position of e in the list.
-	// If e is not present, the result is -1.
-	IndexOf(e T) int
-	// LastIndexOf returns the last position of e in the list.
-	// If e is not present, the result is -1.
-	LastIndexOf(e T) int
-	// Get returns the elements at the specifies index.
-	// It returns an error if the the index is out of bounds.
-	Get(index int) (T, error)
-	// GetDefault returns the elements at the specifies index.
-	// It returns the T zero value if the the index is out of bounds.
-	GetDefault(index int) T
-	// GetDefaultValue returns the elements at the specifies index.
-	// It returns value if the the index is out of bounds.
-	GetDefaultValue(index int, value T) T
 	// Set sets the value of element at the specified index and returns the overwritten value.
 	// It returns an error if the the index is out of bounds.
 	Set(index int, e T) (T, error)
@@ -52,12 +38,6 @@ type List[T any] interface {
 	// RemoveElement removes the element e from the list if it is presentt.
 	// In that case, the method returns true, otherwhise it returns false.
 	RemoveElement(e T) bool
-	// Each executes fun for all elements of the list.
-	//
-	// This method should be used to remove elements. Use Iter insted.
-	Each(fun func(index int, element T))
-	// Stream returns a [Stream] rapresenting the list.
-	Stream() *Stream[T]
 	// Sort sorts the elements of the list.
 	//
 	// This method panics if T does not implement [util.Comparer]
@@ -80,7 +60,49 @@ type List[T any] interface {
 	//		// Code
 	//	}
 	IterReverse() Iterator[T]
-	// RangeIter returns a function that allows to iterate a [List] using the range keyword.
+}
+
+// ReadOnlyList provides the methods to read a generic list without modifying it.
+// It is satisfied by every [List], by [PersistentList] and by the views returned by [Unmodifiable].
+//
+// A list is indexed starting from 0, but negative indexes are supported. Negative indexes start from the end, meaning that -1 corresponds to the last element.
+//
+// The check on the equality of the elements is done with the Equal method if T implements [util.Equaler],
+// otherwise it is done with [reflect.DeepEqual].
+type ReadOnlyList[T any] interface {
+	fmt.Stringer
+	util.Equaler
+	util.Hasher
+	// Len returns the numbers of elements in the list.
+	Len() int
+	// IsEmpty returns a bool which indicates if the list is empty or not.
+	IsEmpty() bool
+	// ToSlice returns a slice which contains all elements of the list.
+	ToSlice() []T
+	// Contains returns if e is present in the list.
+	Contains(e T) bool
+	// IndexOf returns the first position of e in the list.
+	// If e is not present, the result is -1.
+	IndexOf(e T) int
+	// LastIndexOf returns the last position of e in the list.
+	// If e is not present, the result is -1.
+	LastIndexOf(e T) int
+	// Get returns the elements at the specifies index.
+	// It returns an error if the the index is out of bounds.
+	Get(index int) (T, error)
+	// GetDefault returns the elements at the specifies index.
+	// It returns the T zero value if the the index is out of bounds.
+	GetDefault(index int) T
+	// GetDefaultValue returns the elements at the specifies index.
+	// It returns value if the the index is out of bounds.
+	GetDefaultValue(index int, value T) T
+	// Each executes fun for all elements of the list.
+	//
+	// This method should be used to remove elements. Use Iter insted.
+	Each(fun func(index int, element T))
+	// Stream returns a [Stream] rapresenting the list.
+	Stream() *Stream[T]
+	// RangeIter returns a function that allows to iterate the list using the range keyword.
 	//
 	//	for i, j := range list.RangeIter() {
 	//		// Code
@@ -88,7 +110,7 @@ type List[T any] interface {
 	//
 	// Unlike [List.Iter], it doesn't allow to remove elements during the iteration.
 	RangeIter() func(yield func(int, T) bool)
-	// RangeIterReverse returns a function that allows to iterate a [List] using the range keyword in reverse order.
+	// RangeIterReverse returns a function that allows to iterate the list using the range keyword in reverse order.
 	//
 	//	for i, j := range list.RangeIterReverse() {
 	//		// Code
@@ -98,7 +120,7 @@ type List[T any] interface {
 	RangeIterReverse() func(yield func(int, T) bool)
 }
 
-func rangeCheck[T any](list List[T], index *int) bool {
+func rangeCheck[T any](list ReadOnlyList[T], index *int) bool {
 	if *index < 0 {
 		*index += list.Len()
 	}
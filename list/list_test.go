@@ -0,0 +1,53 @@
+package list
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	"github.com/potex02/structures/util"
+)
+
+func TestBinary(t *testing.T) {
+
+	var tests []binaryTest = []binaryTest{
+		{"ArrayList", NewArrayList(1, 2, 3), NewArrayList(4), &ArrayList[int]{}},
+		{"LinkedList", NewLinkedList(1, 2, 3), NewLinkedList(4), &LinkedList[int]{}},
+		{"PersistentList", NewPersistentList(1, 2, 3), NewPersistentList(4), &PersistentList[int]{}},
+	}
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
@@ -0,0 +1,477 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/util"
+)
+
+var _ ReadOnlyList[int] = NewPersistentList[int]()
+
+const (
+	persistentBits  uint = 5
+	persistentWidth int  = 1 << persistentBits
+	persistentMask  int  = persistentWidth - 1
+)
+
+// PersistentList provides a generic immutable list implemented with a bitmapped vector trie.
+//
+// The elements are stored in the leaves of a trie where each node has 32 children, while the last elements are kept in a separate tail.
+// Add, Set and Remove do not modify the list, but they return a new version which shares most of its nodes with the old one,
+// so keeping many versions of the list is cheap.
+//
+// Get and Set run in O(log32 n) time, while Add runs in amortized O(1) time.
+// Removing the last element runs in O(log32 n) time, while removing an element at index i requires to rebuild the elements after i.
+//
+// The zero value is not a valid list: use [NewPersistentList] to create an empty one.
+//
+// It implements the interface [ReadOnlyList].
+type PersistentList[T any] struct {
+	// contains filtered or unexported fields
+	root  *persistentNode[T]
+	tail  []T
+	len   int
+	shift uint
+}
+
+type persistentNode[T any] struct {
+	children []*persistentNode[T]
+	elements []T
+}
+
+// NewPersistentList returns a new [PersistentList] containing the elements c.
+//
+// if no argument is passed, it will be created an empty [PersistentList].
+func NewPersistentList[T any](c ...T) *PersistentList[T] {
+	return NewPersistentListFromSlice(c)
+}
+
+// NewPersistentListFromSlice returns a new [PersistentList] containing the elements of slice c.
+func NewPersistentListFromSlice[T any](c []T) *PersistentList[T] {
+	list := &PersistentList[T]{root: &persistentNode[T]{}, tail: []T{}, shift: persistentBits}
+	return list.Add(c...)
+}
+
+// NewPersistentListFromStructure is a wrapper for NewPersistentListFromSlice(c.ToSlice()).
+func NewPersistentListFromStructure[T any](c structures.Structure[T]) *PersistentList[T] {
+	return NewPersistentListFromSlice(c.ToSlice())
+}
+
+// Len returns the length of l.
+func (l *PersistentList[T]) Len() int {
+	return l.len
+}
+
+// IsEmpty returns a bool which indicates if l is empty or not.
+func (l *PersistentList[T]) IsEmpty() bool {
+	return l.len == 0
+}
+
+// Contains returns if e is present in l.
+func (l *PersistentList[T]) Contains(e T) bool {
+	return l.IndexOf(e) >= 0
+}
+
+// IndexOf returns the first position of e in l.
+// If e is not present, the result is -1.
+func (l *PersistentList[T]) IndexOf(e T) int {
+	fun := util.EqualFunction(e)
+	for i, j := range l.RangeIter() {
+		if fun(j) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns the last position of e in l.
+// If e is not present, the result is -1.
+func (l *PersistentList[T]) LastIndexOf(e T) int {
+	fun := util.EqualFunction(e)
+	for i, j := range l.RangeIterReverse() {
+		if fun(j) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ToSlice returns a slice which contains all elements of l.
+func (l *PersistentList[T]) ToSlice() []T {
+	slice := make([]T, 0, l.len)
+	for i := 0; i < l.len; i += persistentWidth {
+		slice = append(slice, l.leaf(i)...)
+	}
+	return slice
+}
+
+// ToArrayList returns an [ArrayList] containing the elements of l.
+func (l *PersistentList[T]) ToArrayList() *ArrayList[T] {
+	return NewArrayListFromSlice(l.ToSlice())
+}
+
+// Get returns the elements at the specifies index.
+// It returns an error if the index is out of bounds.
+func (l *PersistentList[T]) Get(index int) (T, error) {
+	if !rangeCheck[T](l, &index) {
+
+		var result T
+
+		return result, errors.New("Index " + strconv.Itoa(index) + " for size " + strconv.Itoa(l.len))
+	}
+	return l.leaf(index)[index&persistentMask], nil
+}
+
+// GetDefault returns the elements at the specifies index.
+// It returns the T zero value if the index is out of bounds.
+func (l *PersistentList[T]) GetDefault(index int) T {
+	if !rangeCheck[T](l, &index) {
+
+		var result T
+
+		return result
+	}
+	return l.leaf(index)[index&persistentMask]
+}
+
+// GetDefaultValue returns the elements at the specifies index.
+// It returns value if the index is out of bounds.
+func (l *PersistentList[T]) GetDefaultValue(index int, value T) T {
+	if !rangeCheck[T](l, &index) {
+		return value
+	}
+	return l.leaf(index)[index&persistentMask]
+}
+
+// Set returns a new version of l where the element at the specified index is e.
+// If index is equal to the length of l, e is added at the end of the list.
+// It returns an error if the index is out of bounds.
+func (l *PersistentList[T]) Set(index int, e T) (*PersistentList[T], error) {
+	if index == l.len {
+		return l.Add(e), nil
+	}
+	if !rangeCheck[T](l, &index) {
+		return l, errors.New("Index " + strconv.Itoa(index) + " for size " + strconv.Itoa(l.len))
+	}
+	if index >= l.tailOffset() {
+		tail := slices.Clone(l.tail)
+		tail[index&persistentMask] = e
+		return &PersistentList[T]{root: l.root, tail: tail, len: l.len, shift: l.shift}, nil
+	}
+	return &PersistentList[T]{root: l.set(l.shift, l.root, index, e), tail: l.tail, len: l.len, shift: l.shift}, nil
+}
+
+// Add returns a new version of l with the elements e added at the end.
+//
+// The elements are copied in the tail in blocks, so adding many elements at once is cheaper than adding them one by one.
+func (l *PersistentList[T]) Add(e ...T) *PersistentList[T] {
+	result := l
+	for len(e) != 0 {
+		count := result.len - result.tailOffset()
+		if count == persistentWidth {
+			result = result.push(e[0])
+			e = e[1:]
+			continue
+		}
+		n := min(persistentWidth-count, len(e))
+		tail := make([]T, count+n, persistentWidth)
+		copy(tail, result.tail)
+		copy(tail[count:], e[:n])
+		result = &PersistentList[T]{root: result.root, tail: tail, len: result.len + n, shift: result.shift}
+		e = e[n:]
+	}
+	return result
+}
+
+// Remove returns a new version of l without the element at the specified index.
+// It returns an error if the index is out of bounds.
+//
+// Only the elements before index are shared with l: they are kept in O(log32 n) time,
+// while the elements after index are added again in blocks of 32, so removing the last element is the cheapest removal.
+func (l *PersistentList[T]) Remove(index int) (*PersistentList[T], error) {
+	if !rangeCheck[T](l, &index) {
+		return l, errors.New("Index " + strconv.Itoa(index) + " for size " + strconv.Itoa(l.len))
+	}
+	elements := make([]T, 0, l.len-index-1)
+	for i := index + 1; i < l.len; {
+		leaf := l.leaf(i)[i&persistentMask:]
+		elements = append(elements, leaf...)
+		i += len(leaf)
+	}
+	return l.take(index).Add(elements...), nil
+}
+
+// Each executes fun for all elements of l.
+func (l *PersistentList[T]) Each(fun func(index int, element T)) {
+	for i, j := range l.RangeIter() {
+		fun(i, j)
+	}
+}
+
+// Stream returns a [Stream] rapresenting l.
+//
+// The Collect method of the stream returns an [ArrayList], since a [PersistentList] can not be modified in place.
+func (l *PersistentList[T]) Stream() *Stream[T] {
+	return NewStream[T](l, reflect.ValueOf(NewArrayList[T]))
+}
+
+// RangeIter returns a function that allows to iterate a [PersistentList] using the range keyword.
+//
+//	for i, j := range l.RangeIter() {
+//		// Code
+//	}
+func (l *PersistentList[T]) RangeIter() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < l.len; i += persistentWidth {
+			for j, k := range l.leaf(i) {
+				if !yield(i+j, k) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// RangeIterReverse returns a function that allows to iterate a [PersistentList] using the range keyword in reverse order.
+//
+//	for i, j := range l.RangeIterReverse() {
+//		// Code
+//	}
+func (l *PersistentList[T]) RangeIterReverse() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		for i := l.len - 1; i >= 0; i -= persistentWidth {
+			leaf := l.leaf(i)
+			offset := i &^ persistentMask
+			for j := i - offset; j >= 0; j-- {
+				if !yield(offset+j, leaf[j]) {
+					return
+				}
+			}
+			i = offset + persistentMask
+		}
+	}
+}
+
+// Equal returns true if l and st are both read-only lists and their elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is an [ArrayList],
+// but the elements of l and the elements of st are equals, this method returns anyway true.
+func (l *PersistentList[T]) Equal(st any) bool {
+	list, ok := st.(ReadOnlyList[T])
+	if ok && l != nil && list != nil {
+		if l.Len() != list.Len() {
+			return false
+		}
+		other := list.ToSlice()
+		for i, j := range l.RangeIter() {
+			if !util.EqualFunction(j)(other[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Compare returns 0 if l and st are equals,
+// -1 if l is shorten than st,
+// 1 if l is longer than st,
+// -2 if st is not a [ReadOnlyList] or if one between l and st is nil.
+//
+// If l and st have the same length, the result is the comparison
+// between the first different element of the two lists if T implemets [util.Comparer],
+// otherwhise the result is 0.
+func (l *PersistentList[T]) Compare(st any) int {
+	list, ok := st.(ReadOnlyList[T])
+	if ok && l != nil && list != nil {
+		if l.Len() < list.Len() {
+			return -1
+		}
+		if l.Len() > list.Len() {
+			return 1
+		}
+		other := list.ToSlice()
+		for i, j := range l.RangeIter() {
+			element, ok := interface{}(j).(util.Comparer)
+			if !ok {
+				return 0
+			}
+			if result := element.Compare(other[i]); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+	return -2
+}
+
+// Hash returns the hash code of l.
+//
+// The hash code is the same of an [ArrayList] containing the same elements.
+func (l *PersistentList[T]) Hash() uint64 {
+	return l.ToArrayList().Hash()
+}
+
+// String returns a rapresentation of l in the form of a string.
+func (l *PersistentList[T]) String() string {
+	check := reflect.TypeOf(new(T)).String()
+	return fmt.Sprintf("PersistentList[%v]%v", check[1:], l.ToSlice())
+}
+
+// MarshalJSON returns the JSON encoding of l, which is an array containing its elements.
+func (l *PersistentList[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(l.ToSlice())
+}
+
+// UnmarshalJSON sets l to a new version containing the elements decoded from the JSON array data.
+// The versions derived from l before this call are not affected.
+func (l *PersistentList[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, l.decode)
+}
+
+// MarshalBinary returns the binary encoding of l, which contains its elements.
+func (l *PersistentList[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(l.ToSlice())
+}
+
+// UnmarshalBinary sets l to a new version containing the elements decoded from the binary encoding data.
+// The versions derived from l before this call are not affected.
+func (l *PersistentList[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, l.decode)
+}
+
+// GobEncode returns the binary encoding of l as [PersistentList.MarshalBinary].
+func (l *PersistentList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// GobDecode decodes data into l as [PersistentList.UnmarshalBinary].
+func (l *PersistentList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of l with objects.
+func (l *PersistentList[T]) decode(objects []T) error {
+	*l = *NewPersistentListFromSlice(objects)
+	return nil
+}
+
+// tailOffset returns the index of the first element of the tail.
+func (l *PersistentList[T]) tailOffset() int {
+	if l.len < persistentWidth {
+		return 0
+	}
+	return ((l.len - 1) >> persistentBits) << persistentBits
+}
+
+// leaf returns the elements of the leaf which contains the element at index.
+func (l *PersistentList[T]) leaf(index int) []T {
+	if index >= l.tailOffset() {
+		return l.tail
+	}
+	node := l.root
+	for level := l.shift; level > 0; level -= persistentBits {
+		node = node.children[(index>>level)&persistentMask]
+	}
+	return node.elements
+}
+
+func (l *PersistentList[T]) set(level uint, node *persistentNode[T], index int, e T) *persistentNode[T] {
+	if level == 0 {
+		elements := slices.Clone(node.elements)
+		elements[index&persistentMask] = e
+		return &persistentNode[T]{elements: elements}
+	}
+	children := slices.Clone(node.children)
+	child := (index >> level) & persistentMask
+	children[child] = l.set(level-persistentBits, node.children[child], index, e)
+	return &persistentNode[T]{children: children}
+}
+
+// push returns a new version of l with e added at the end.
+func (l *PersistentList[T]) push(e T) *PersistentList[T] {
+	if l.len-l.tailOffset() < persistentWidth {
+		tail := make([]T, len(l.tail)+1, persistentWidth)
+		copy(tail, l.tail)
+		tail[len(l.tail)] = e
+		return &PersistentList[T]{root: l.root, tail: tail, len: l.len + 1, shift: l.shift}
+	}
+	leaf := &persistentNode[T]{elements: l.tail}
+	root := l.root
+	shift := l.shift
+	if l.len>>persistentBits > 1<<l.shift {
+		root = &persistentNode[T]{children: []*persistentNode[T]{l.root, newPersistentPath(l.shift, leaf)}}
+		shift += persistentBits
+	} else {
+		root = l.pushLeaf(l.shift, l.root, leaf)
+	}
+	tail := make([]T, 1, persistentWidth)
+	tail[0] = e
+	return &PersistentList[T]{root: root, tail: tail, len: l.len + 1, shift: shift}
+}
+
+func (l *PersistentList[T]) pushLeaf(level uint, node *persistentNode[T], leaf *persistentNode[T]) *persistentNode[T] {
+	child := ((l.len - 1) >> level) & persistentMask
+	children := slices.Clone(node.children)
+	var insert *persistentNode[T]
+	if level == persistentBits {
+		insert = leaf
+	} else if child < len(node.children) {
+		insert = l.pushLeaf(level-persistentBits, node.children[child], leaf)
+	} else {
+		insert = newPersistentPath(level-persistentBits, leaf)
+	}
+	if child < len(children) {
+		children[child] = insert
+	} else {
+		children = append(children, insert)
+	}
+	return &persistentNode[T]{children: children}
+}
+
+// take returns a new version of l containing only its first n elements.
+func (l *PersistentList[T]) take(n int) *PersistentList[T] {
+	if n == 0 {
+		return NewPersistentList[T]()
+	}
+	if n > l.tailOffset() {
+		return &PersistentList[T]{root: l.root, tail: l.tail[:n-l.tailOffset()], len: n, shift: l.shift}
+	}
+	result := &PersistentList[T]{root: &persistentNode[T]{}, len: n, shift: persistentBits}
+	offset := result.tailOffset()
+	result.tail = l.leaf(n - 1)[:n-offset]
+	if offset == 0 {
+		return result
+	}
+	result.root = l.truncate(l.shift, l.root, offset)
+	result.shift = l.shift
+	for result.shift > persistentBits && len(result.root.children) == 1 {
+		result.root = result.root.children[0]
+		result.shift -= persistentBits
+	}
+	return result
+}
+
+// truncate returns a copy of the subtree of node, at the specified level, containing only the leaves of the first n elements.
+func (l *PersistentList[T]) truncate(level uint, node *persistentNode[T], n int) *persistentNode[T] {
+	child := ((n - 1) >> level) & persistentMask
+	children := slices.Clone(node.children[:child+1])
+	if level > persistentBits {
+		children[child] = l.truncate(level-persistentBits, node.children[child], n)
+	}
+	return &persistentNode[T]{children: children}
+}
+
+func newPersistentPath[T any](level uint, node *persistentNode[T]) *persistentNode[T] {
+	if level == 0 {
+		return node
+	}
+	return &persistentNode[T]{children: []*persistentNode[T]{newPersistentPath(level-persistentBits, node)}}
+}
@@ -0,0 +1,212 @@
+package list
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewPersistentList(t *testing.T) {
+
+	var list ReadOnlyList[int] = NewPersistentList[int]()
+	var listSlice *PersistentList[int] = NewPersistentListFromSlice([]int{1, 2, 3})
+
+	if list.Len() != 0 || !list.IsEmpty() {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+	if listSlice.Len() != 3 || !slices.Equal(listSlice.ToSlice(), []int{1, 2, 3}) {
+		t.Log("list objects are", listSlice.ToSlice())
+		t.Fail()
+	}
+	if listSlice.String() != "PersistentList[int][1 2 3]" {
+		t.Log("list is", listSlice)
+		t.Fail()
+	}
+	if !NewPersistentListFromStructure[int](NewArrayList(1, 2, 3)).Equal(listSlice) {
+		t.Log("lists are not equals")
+		t.Fail()
+	}
+}
+func TestGetPersistentList(t *testing.T) {
+
+	var list *PersistentList[int] = NewPersistentList[int]()
+
+	elements := make([]int, 0)
+	for i := 0; i != 5000; i++ {
+		list = list.Add(i)
+		elements = append(elements, i)
+	}
+	if !slices.Equal(list.ToSlice(), elements) {
+		t.Log("list objects are different")
+		t.Fail()
+	}
+	for _, i := range []int{0, 31, 32, 1023, 1024, 1056, 4999} {
+		if e, err := list.Get(i); err != nil || e != i {
+			t.Log("e is", e, "err is", err)
+			t.Fail()
+		}
+	}
+	if e, err := list.Get(-1); err != nil || e != 4999 {
+		t.Log("e is", e, "err is", err)
+		t.Fail()
+	}
+	if _, err := list.Get(5000); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	if list.GetDefaultValue(5000, -1) != -1 || list.IndexOf(1500) != 1500 || list.LastIndexOf(5001) != -1 {
+		t.Log("elements are not found")
+		t.Fail()
+	}
+	reverse := make([]int, 0, list.Len())
+	for i, j := range list.RangeIterReverse() {
+		if i != j {
+			t.Log("index is", i, "element is", j)
+			t.Fail()
+		}
+		reverse = append(reverse, j)
+	}
+	slices.Reverse(reverse)
+	if !slices.Equal(reverse, elements) {
+		t.Log("reversed objects are different")
+		t.Fail()
+	}
+}
+func TestSetPersistentList(t *testing.T) {
+
+	var list *PersistentList[int] = NewPersistentListFromSlice(make([]int, 2000))
+
+	versions := []*PersistentList[int]{list}
+	for i := 0; i < 2000; i += 7 {
+		next, err := versions[len(versions)-1].Set(i, i)
+		if err != nil {
+			t.Log("err is", err)
+			t.Fail()
+		}
+		versions = append(versions, next)
+	}
+	for i, j := range versions {
+		for k := 0; k < 2000; k += 7 {
+			expected := 0
+			if k/7 < i {
+				expected = k
+			}
+			if e := j.GetDefault(k); e != expected {
+				t.Log("version", i, "element", k, "is", e)
+				t.Fail()
+			}
+		}
+	}
+	if _, err := list.Set(2001, 1); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	if added, _ := list.Set(2000, 1); added.Len() != 2001 || list.Len() != 2000 {
+		t.Log("lengths are", added.Len(), list.Len())
+		t.Fail()
+	}
+}
+func TestRemovePersistentList(t *testing.T) {
+
+	var list *PersistentList[int] = NewPersistentList[int]()
+
+	elements := make([]int, 0)
+	for i := 0; i != 1100; i++ {
+		list = list.Add(i)
+		elements = append(elements, i)
+	}
+	old := list
+	for _, i := range []int{-1, 0, 500, 1024, 1056, 32} {
+		var err error
+		list, err = list.Remove(i)
+		if err != nil {
+			t.Log("err is", err)
+			t.Fail()
+		}
+		if i < 0 {
+			i += len(elements)
+		}
+		elements = slices.Delete(elements, i, i+1)
+		if !slices.Equal(list.ToSlice(), elements) {
+			t.Log("list objects are different after removing", i)
+			t.Fail()
+		}
+	}
+	for list.Len() != 0 {
+		list, _ = list.Remove(-1)
+	}
+	if !list.IsEmpty() || old.Len() != 1100 || old.GetDefault(1099) != 1099 {
+		t.Log("lists are", list.Len(), old.Len())
+		t.Fail()
+	}
+	if _, err := list.Remove(0); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	elements = make([]int, 40000)
+	for i := range elements {
+		elements[i] = i
+	}
+	list = NewPersistentListFromSlice(elements)
+	for _, i := range []int{39999, 33000, 32768, 32767, 1024, 0} {
+		list, _ = list.Remove(i)
+		elements = slices.Delete(elements, i, i+1)
+		list = list.Add(-i)
+		elements = append(elements, -i)
+		list, _ = list.Set(i/2, i)
+		elements[i/2] = i
+		if !slices.Equal(list.ToSlice(), elements) || list.GetDefault(list.Len()-1) != -i {
+			t.Log("list objects are different after removing", i)
+			t.Fail()
+		}
+	}
+}
+func TestEqualPersistentList(t *testing.T) {
+
+	var list *PersistentList[wrapper.Int] = NewPersistentList[wrapper.Int](1, 2, 3)
+
+	if !list.Equal(NewArrayList[wrapper.Int](1, 2, 3)) || !list.Equal(NewLinkedList[wrapper.Int](1, 2, 3)) {
+		t.Log("lists are not equals")
+		t.Fail()
+	}
+	if list.Compare(NewPersistentList[wrapper.Int](1, 2, 4)) != -1 || list.Compare(NewArrayList[wrapper.Int](1, 2)) != 1 {
+		t.Log("compare is wrong")
+		t.Fail()
+	}
+	if list.Hash() != NewArrayList[wrapper.Int](1, 2, 3).Hash() || !list.ToArrayList().Equal(NewArrayList[wrapper.Int](1, 2, 3)) {
+		t.Log("hashes are different")
+		t.Fail()
+	}
+	if sum := CollectTo(list.Stream(), ToArrayList[wrapper.Int]()).Len(); sum != 3 {
+		t.Log("length is", sum)
+		t.Fail()
+	}
+	var collected List[wrapper.Int] = list.Stream().Collect()
+	if _, ok := collected.(*ArrayList[wrapper.Int]); !ok || collected.Hash() != list.Hash() {
+		t.Log("result is", collected)
+		t.Fail()
+	}
+}
+func TestJSONPersistentList(t *testing.T) {
+
+	var list *PersistentList[int] = NewPersistentList(1, 2, 3)
+
+	data, err := json.Marshal(list)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result := list.Add(4)
+	previous := result
+	if err := json.Unmarshal([]byte("[5, 6]"), result); err != nil || !slices.Equal(result.ToSlice(), []int{5, 6}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if previous != result || !slices.Equal(list.ToSlice(), []int{1, 2, 3}) {
+		t.Log("list is", list)
+		t.Fail()
+	}
+}
@@ -1,26 +1,91 @@
 package list
 
 import (
+	"iter"
 	"reflect"
+	"slices"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/seq"
 )
 
 // Stream provides aggregate operations for a [List].
+//
+// A stream is lazy: Map, Filter and FilterMap only add a stage to the pipeline,
+// which is executed element by element when a terminal operation (Any, All, None, Count, Collect or RangeIter) is called.
+// The terminal operations stop pulling elements as soon as the result is known.
+//
+// Since the source is read only by the terminal operations, the modifications of the source done before them are visible in the result,
+// and every terminal operation runs the whole pipeline again.
+//
+// A stream can be set in parallel mode by Parallel.
+// In this mode, the stages added by Map, Filter, FilterMap, [MapTo] and [FlatMap] and the operations Any, All, None and Count
+// split the elements produced by the previous stage between a pool of workers, so the functions passed to them must be safe for concurrent use.
+// The other stages are executed sequentially.
 type Stream[T any] struct {
 	// contains filtered or unexported fields
-	objects     []T
+	objects     iter.Seq[T]
 	constructor reflect.Value
+	workers     int
+	ordered     bool
 }
 
 // NewStream returns a new [Stream] associated at the list parameter.
 //
 // Constructor a [reflect.Value] rapresenting the function that create the resulting list from the stream.
 // This function must have no parameters or must be a variadic function and must returns a List[T].
-func NewStream[T any](list List[T], constructor reflect.Value) *Stream[T] {
-	objects := make([]T, 0)
-	list.Each(func(_ int, element T) {
-		objects = append(objects, element)
-	})
-	return &Stream[T]{objects: objects, constructor: constructor}
+// It is used only by Collect, so it can be the zero [reflect.Value] if s is collected through [CollectTo].
+//
+// Deprecated: the constructor is checked only when Collect is called, which panics if it is wrong.
+// Use [NewStreamFromSeq] and collect the stream through [CollectTo].
+func NewStream[T any](list ReadOnlyList[T], constructor reflect.Value) *Stream[T] {
+	return &Stream[T]{objects: values(list), constructor: constructor}
+}
+
+// NewStreamFromSeq returns a new [Stream] whose elements are produced by seq.
+// seq can be infinite, as long as the stream is consumed by a short-circuiting operation.
+//
+// The stream has no constructor, so it must be collected through [CollectTo].
+func NewStreamFromSeq[T any](seq iter.Seq[T]) *Stream[T] {
+	return &Stream[T]{objects: seq}
+}
+
+// values returns a sequence of the elements of list.
+func values[T any](list ReadOnlyList[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, i := range list.RangeIter() {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Parallel sets s in parallel mode, using at most workers goroutines for each parallel stage.
+// If workers is less than 1, the number of workers is [runtime.GOMAXPROCS].
+//
+// If ordered is true, the parallel stages produce the elements in the same order of the sequential mode,
+// otherwise they produce them as soon as they are computed.
+// The index passed to the functions of a stage is always the position of the element in the output of the previous stage.
+//
+// The mode is applied to the stages added after the call of this method.
+func (s *Stream[T]) Parallel(workers int, ordered bool) *Stream[T] {
+	s.workers = seq.Workers(workers)
+	s.ordered = ordered
+	return s
+}
+
+// Sequential sets s in sequential mode, which is the default.
+//
+// The mode is applied to the stages added after the call of this method.
+func (s *Stream[T]) Sequential() *Stream[T] {
+	s.workers = 0
+	return s
+}
+
+// IsParallel returns true if s is in parallel mode.
+func (s *Stream[T]) IsParallel() bool {
+	return s.workers != 0
 }
 
 // Map executes fun for all elements of s and returns a [Stream] containing the resulting elements.
@@ -28,12 +93,9 @@ func NewStream[T any](list List[T], constructor reflect.Value) *Stream[T] {
 // This method modifies the state of s, so it is not necessary to assign to resulting stream, but it
 // can be useful for concatenate operations in a single instruction.
 func (s *Stream[T]) Map(fun func(index int, element T) T) *Stream[T] {
-	result := make([]T, 0)
-	for i := range s.objects {
-		result = append(result, fun(i, s.objects[i]))
-	}
-	s.objects = result
-	return s
+	return s.FilterMap(func(index int, element T) (T, bool) {
+		return fun(index, element), true
+	})
 }
 
 // Filter returns a [Stream] containing the elements that satisfy fun.
@@ -41,14 +103,9 @@ func (s *Stream[T]) Map(fun func(index int, element T) T) *Stream[T] {
 // This method modifies the state of s, so it is not necessary to assign to resulting stream, but it
 // can be useful for concatenate operations in a single instruction.
 func (s *Stream[T]) Filter(fun func(index int, element T) bool) *Stream[T] {
-	result := make([]T, 0)
-	for i := range s.objects {
-		if fun(i, s.objects[i]) {
-			result = append(result, s.objects[i])
-		}
-	}
-	s.objects = result
-	return s
+	return s.FilterMap(func(index int, element T) (T, bool) {
+		return element, fun(index, element)
+	})
 }
 
 // FilterMap executes fun for all elements of s and returns a [Stream] containing the resulting elements that satisfy fun.
@@ -56,20 +113,30 @@ func (s *Stream[T]) Filter(fun func(index int, element T) bool) *Stream[T] {
 // This method modifies the state of s, so it is not necessary to assign to resulting stream, but it
 // can be useful for concatenate operations in a single instruction.
 func (s *Stream[T]) FilterMap(fun func(index int, element T) (T, bool)) *Stream[T] {
-	result := make([]T, 0)
-	for i := range s.objects {
-		if element, ok := fun(i, s.objects[i]); ok {
-			result = append(result, element)
+	objects := s.objects
+	if s.IsParallel() {
+		s.objects = seq.ParallelFilterMap(objects, s.workers, s.ordered, fun)
+		return s
+	}
+	s.objects = func(yield func(T) bool) {
+		index := 0
+		for i := range objects {
+			if element, ok := fun(index, i); ok && !yield(element) {
+				return
+			}
+			index++
 		}
 	}
-	s.objects = result
 	return s
 }
 
 // Any returns true if at least one element of s satisfies fun.
 func (s *Stream[T]) Any(fun func(index int, element T) bool) bool {
-	for i := range s.objects {
-		if fun(i, s.objects[i]) {
+	if s.IsParallel() {
+		return seq.ParallelAny(s.objects, s.workers, fun)
+	}
+	for i, j := range s.RangeIter() {
+		if fun(i, j) {
 			return true
 		}
 	}
@@ -78,8 +145,13 @@ func (s *Stream[T]) Any(fun func(index int, element T) bool) bool {
 
 // All returns true if all elements of s satisfy fun.
 func (s *Stream[T]) All(fun func(index int, element T) bool) bool {
-	for i := range s.objects {
-		if !fun(i, s.objects[i]) {
+	if s.IsParallel() {
+		return !seq.ParallelAny(s.objects, s.workers, func(index int, element T) bool {
+			return !fun(index, element)
+		})
+	}
+	for i, j := range s.RangeIter() {
+		if !fun(i, j) {
 			return false
 		}
 	}
@@ -88,34 +160,189 @@ func (s *Stream[T]) All(fun func(index int, element T) bool) bool {
 
 // None returns true if none of the elements of s satisfies fun.
 func (s *Stream[T]) None(fun func(index int, element T) bool) bool {
-	for i := range s.objects {
-		if fun(i, s.objects[i]) {
-			return false
-		}
-	}
-	return true
+	return !s.Any(fun)
 }
 
 // Count returns the number of elements that satisfy fun.
 func (s *Stream[T]) Count(fun func(index int, element T) bool) int {
+	if s.IsParallel() {
+		return seq.ParallelCount(s.objects, s.workers, fun)
+	}
 	result := 0
-	for i := range s.objects {
-		if fun(i, s.objects[i]) {
+	for i, j := range s.RangeIter() {
+		if fun(i, j) {
 			result++
 		}
 	}
 	return result
 }
 
+// RangeIter returns a function that allows to iterate the elements of s using the range keyword.
+//
+//	for i, j := range s.RangeIter() {
+//		// Code
+//	}
+func (s *Stream[T]) RangeIter() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for i := range s.objects {
+			if !yield(index, i) {
+				return
+			}
+			index++
+		}
+	}
+}
+
 // Collect returns a [List] from s.
 //
 // the effective type of the result is the same the constructor.
 //
 // This method panics if constructor have wrong parameters or not returns a List[T].
+//
+// Deprecated: use [CollectTo] with a typed collector, like [ToArrayList].
 func (s *Stream[T]) Collect() List[T] {
 	result := s.constructor.Call([]reflect.Value{})[0].Interface().(List[T])
-	for _, i := range s.objects {
+	for i := range s.objects {
 		result.Add(i)
 	}
 	return result
 }
+
+// CollectTo returns the result of collector applied at the elements of s.
+func CollectTo[T any, R any](s *Stream[T], collector structures.Collector[T, R]) R {
+	return collector.Collect(s.objects)
+}
+
+// derive returns a new [Stream] producing objects with the same mode of s.
+func derive[T any, R any](s *Stream[T], objects iter.Seq[R], constructor reflect.Value) *Stream[R] {
+	return &Stream[R]{objects: objects, constructor: constructor, workers: s.workers, ordered: s.ordered}
+}
+
+// MapTo executes fun for all elements of s and returns a new [Stream] containing the resulting elements,
+// which can be of a different type than the elements of s.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+func MapTo[T any, R any](s *Stream[T], fun func(index int, element T) R) *Stream[R] {
+	if s.IsParallel() {
+		return derive(s, seq.ParallelFilterMap(s.objects, s.workers, s.ordered, func(index int, element T) (R, bool) {
+			return fun(index, element), true
+		}), reflect.Value{})
+	}
+	return derive(s, seq.Map(s.objects, fun), reflect.Value{})
+}
+
+// FlatMap executes fun for all elements of s and returns a new [Stream] containing the concatenation of the resulting slices.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+func FlatMap[T any, R any](s *Stream[T], fun func(index int, element T) []R) *Stream[R] {
+	if s.IsParallel() {
+		return derive(s, seq.FlatMap(seq.ParallelFilterMap(s.objects, s.workers, s.ordered, func(index int, element T) ([]R, bool) {
+			return fun(index, element), true
+		}), func(_ int, element []R) []R {
+			return element
+		}), reflect.Value{})
+	}
+	return derive(s, seq.FlatMap(s.objects, fun), reflect.Value{})
+}
+
+// Reduce combines the elements of s from the first to the last through fun, using the first element as initial result.
+// The function returns false if s is empty.
+func Reduce[T any](s *Stream[T], fun func(result T, element T) T) (T, bool) {
+
+	var result T
+
+	found := false
+	for i := range s.objects {
+		if found {
+			result = fun(result, i)
+		} else {
+			result = i
+			found = true
+		}
+	}
+	return result, found
+}
+
+// Fold combines the elements of s from the first to the last through fun, starting from initial.
+func Fold[T any, R any](s *Stream[T], initial R, fun func(result R, element T) R) R {
+	result := initial
+	for i := range s.objects {
+		result = fun(result, i)
+	}
+	return result
+}
+
+// GroupBy returns a map which associates every key returned by fun to the result of collector
+// applied at the elements of s with that key.
+func GroupBy[T any, K comparable, R any](s *Stream[T], fun func(index int, element T) K, collector structures.Collector[T, R]) map[K]R {
+	groups := map[K][]T{}
+	for i, j := range s.RangeIter() {
+		key := fun(i, j)
+		groups[key] = append(groups[key], j)
+	}
+	result := make(map[K]R, len(groups))
+	for i, j := range groups {
+		result[i] = collector.Collect(slices.Values(j))
+	}
+	return result
+}
+
+// Partition returns the result of collector applied at the elements of s that satisfy fun
+// and the result of collector applied at the other elements.
+func Partition[T any, R any](s *Stream[T], fun func(index int, element T) bool, collector structures.Collector[T, R]) (R, R) {
+
+	var accepted, rejected []T
+
+	for i, j := range s.RangeIter() {
+		if fun(i, j) {
+			accepted = append(accepted, j)
+		} else {
+			rejected = append(rejected, j)
+		}
+	}
+	return collector.Collect(slices.Values(accepted)), collector.Collect(slices.Values(rejected))
+}
+
+// Distinct returns a new [Stream] containing the elements of s without duplicates.
+// The first occurrence of every element is kept.
+func Distinct[T comparable](s *Stream[T]) *Stream[T] {
+	return derive(s, seq.DistinctFunc(s.objects, func(element T) T {
+		return element
+	}), s.constructor)
+}
+
+// Sorted returns a new [Stream] containing the elements of s ordered by compare.
+//
+// The sort is stable, but all elements of s must be read before producing the first one.
+func Sorted[T any](s *Stream[T], compare func(i T, j T) int) *Stream[T] {
+	return derive(s, seq.Sorted(s.objects, compare), s.constructor)
+}
+
+// Limit returns a new [Stream] containing at most the first n elements of s.
+func Limit[T any](s *Stream[T], n int) *Stream[T] {
+	return derive(s, seq.Limit(s.objects, n), s.constructor)
+}
+
+// Skip returns a new [Stream] containing the elements of s except the first n.
+func Skip[T any](s *Stream[T], n int) *Stream[T] {
+	return derive(s, seq.Skip(s.objects, n), s.constructor)
+}
+
+// Zip returns a new [Stream] containing the results of fun for the elements of s and other in the same position.
+// The resulting stream ends when the shortest between s and other ends.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+func Zip[T any, U any, R any](s *Stream[T], other *Stream[U], fun func(i T, j U) R) *Stream[R] {
+	return derive(s, seq.Zip(s.objects, other.objects, fun), reflect.Value{})
+}
+
+// Chunk returns a new [Stream] containing slices of size consecutive elements of s.
+// The last slice can have less than size elements.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+//
+// This function panics if size is less than 1.
+func Chunk[T any](s *Stream[T], size int) *Stream[[]T] {
+	return derive(s, seq.Chunk(s.objects, size), reflect.Value{})
+}
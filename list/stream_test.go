@@ -1,8 +1,13 @@
 package list
 
 import (
+	"cmp"
+	"fmt"
 	"reflect"
+	"slices"
 	"testing"
+
+	"github.com/potex02/structures"
 )
 
 func TestNewStream(t *testing.T) {
@@ -130,3 +135,233 @@ func TestCount(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestLazyStream(t *testing.T) {
+
+	var stream *Stream[int] = NewStreamFromSeq(func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	calls := 0
+	stream.Map(func(index int, element int) int {
+		calls++
+		return element * 3
+	}).Filter(func(index int, element int) bool {
+		return element%2 == 0
+	})
+	if !stream.Any(func(index int, element int) bool {
+		return index == 4 && element == 24
+	}) {
+		t.Log("result is false")
+		t.Fail()
+	}
+	if calls != 9 {
+		t.Log("map is called", calls, "times")
+		t.Fail()
+	}
+	list := NewArrayList[int](1, 2)
+	stream = list.Stream()
+	list.Add(3)
+	if !stream.Collect().Equal(NewArrayList[int](1, 2, 3)) {
+		t.Log("result is", stream.Collect())
+		t.Fail()
+	}
+}
+func TestMapTo(t *testing.T) {
+
+	var stream *Stream[int] = NewArrayList[int](1, 2, -10, 20).Stream()
+
+	result := CollectTo(MapTo(stream, func(index int, element int) float64 {
+		return float64(element) / 2
+	}), ToLinkedList[float64]())
+	if !result.Equal(NewArrayList[float64](0.5, 1, -5, 10)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	other := CollectTo(MapTo(FlatMap(stream, func(index int, element int) []int {
+		return []int{element, index}
+	}), func(index int, element int) float64 {
+		return float64(element)
+	}), ToArrayList[float64]())
+	if !other.Equal(NewArrayList[float64](1, 0, 2, 1, -10, 2, 20, 3)) {
+		t.Log("result is", other)
+		t.Fail()
+	}
+}
+func TestReduce(t *testing.T) {
+
+	var stream *Stream[int] = NewArrayList[int](1, 2, -10, 20).Stream()
+
+	if result, ok := Reduce(stream, func(result int, element int) int {
+		return result + element
+	}); !ok || result != 13 {
+		t.Log("result is", result, ok)
+		t.Fail()
+	}
+	if result := Fold(stream, "", func(result string, element int) string {
+		return result + fmt.Sprint(element)
+	}); result != "12-1020" {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result, ok := Reduce(NewArrayList[int]().Stream(), func(result int, element int) int {
+		return result + element
+	}); ok || result != 0 {
+		t.Log("result is", result, ok)
+		t.Fail()
+	}
+}
+func TestGroupBy(t *testing.T) {
+
+	var stream *Stream[int] = NewLinkedList[int](1, 2, -10, 20, 30, -2, 12).Stream()
+
+	result := GroupBy(stream, func(index int, element int) bool {
+		return element > 0
+	}, ToLinkedList[int]())
+	if len(result) != 2 || !result[true].Equal(NewLinkedList[int](1, 2, 20, 30, 12)) || !result[false].Equal(NewLinkedList[int](-10, -2)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	accepted, rejected := Partition(stream, func(index int, element int) bool {
+		return index%2 == 0
+	}, ToArrayList[int]())
+	if !accepted.Equal(NewLinkedList[int](1, -10, 30, 12)) || !rejected.Equal(NewLinkedList[int](2, 20, -2)) {
+		t.Log("result is", accepted, rejected)
+		t.Fail()
+	}
+}
+func TestDistinct(t *testing.T) {
+
+	var stream *Stream[int] = NewArrayList[int](3, 1, 2, 3, 1, 5, 4).Stream()
+
+	if result := Distinct(stream).Collect(); !result.Equal(NewArrayList[int](3, 1, 2, 5, 4)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := Sorted(stream, cmp.Compare[int]).Collect(); !result.Equal(NewArrayList[int](1, 1, 2, 3, 3, 4, 5)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := Limit(Skip(stream, 2), 3).Collect(); !result.Equal(NewArrayList[int](2, 3, 1)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := Skip(stream, 10).Collect(); !result.IsEmpty() {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestZip(t *testing.T) {
+
+	var stream *Stream[int] = NewArrayList[int](1, 2, -10, 20).Stream()
+	var other *Stream[string] = NewArrayList[string]("a", "b", "c").Stream()
+
+	result := CollectTo(Zip(stream, other, func(i int, j string) string {
+		return fmt.Sprint(j, i)
+	}), ToArrayList[string]())
+	if !result.Equal(NewArrayList[string]("a1", "b2", "c-10")) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestChunk(t *testing.T) {
+
+	var stream *Stream[int] = NewArrayList[int](1, 2, -10, 20, 30).Stream()
+
+	result := CollectTo(Chunk(stream, 2), ToArrayList[[]int]())
+	first, _ := result.Get(0)
+	last, _ := result.Get(2)
+	if result.Len() != 3 || !slices.Equal(first, []int{1, 2}) || !slices.Equal(last, []int{30}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("size 0 does not panic")
+			t.Fail()
+		}
+	}()
+	Chunk(stream, 0)
+}
+func TestParallel(t *testing.T) {
+
+	var list *ArrayList[int] = NewArrayList[int]()
+
+	for i := 0; i != 10000; i++ {
+		list.Add(i)
+	}
+	stream := list.Stream().Parallel(4, true)
+	if !stream.IsParallel() {
+		t.Log("stream is not parallel")
+		t.Fail()
+	}
+	stream.Filter(func(index int, element int) bool {
+		return element%2 == 0
+	}).Map(func(index int, element int) int {
+		return index
+	})
+	if result := stream.Collect(); result.Len() != 5000 || !stream.All(func(index int, element int) bool {
+		return index == element
+	}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := stream.Count(func(index int, element int) bool {
+		return element < 100
+	}); result != 100 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if !stream.Any(func(index int, element int) bool {
+		return element == 4999
+	}) || stream.Any(func(index int, element int) bool {
+		return element == 5000
+	}) {
+		t.Log("any is wrong")
+		t.Fail()
+	}
+	unordered := list.Stream().Parallel(0, false).Filter(func(index int, element int) bool {
+		return element%3 == 0
+	})
+	if result := CollectTo(MapTo(unordered, func(index int, element int) int {
+		return element * 2
+	}), structures.Counting[int]()); result != 3334 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := Fold(unordered.Sequential(), 0, func(result int, element int) int {
+		return result + element
+	}); result != 16668333 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	infinite := NewStreamFromSeq(func(yield func(int) bool) {
+		for i := 0; yield(i); i++ {
+		}
+	}).Parallel(4, true)
+	if !infinite.Any(func(index int, element int) bool {
+		return element == 10000
+	}) {
+		t.Log("any is wrong")
+		t.Fail()
+	}
+	infinite.Map(func(index int, element int) int {
+		return element * 2
+	})
+	if result := CollectTo(Limit(infinite, 5), ToArrayList[int]()).ToSlice(); !reflect.DeepEqual(result, []int{0, 2, 4, 6, 8}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r != "panic" {
+			t.Log("recovered value is", r)
+			t.Fail()
+		}
+	}()
+	list.Stream().Parallel(4, false).Count(func(index int, element int) bool {
+		panic("panic")
+	})
+}
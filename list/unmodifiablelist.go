@@ -0,0 +1,250 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+)
+
+var _ structures.Structure[int] = Unmodifiable[int](NewArrayList[int]())
+var _ List[int] = Unmodifiable[int](NewArrayList[int]())
+
+// UnmodifiableList provides a read-only view of a [List].
+//
+// The view is not a copy: all the changes made on the wrapped list are visible through it.
+// The methods which modify the list return [structures.ErrUnmodifiable] or, if they can not return an error, panic with it.
+//
+// It implements the interface [List].
+type UnmodifiableList[T any] struct {
+	// contains filtered or unexported fields
+	list List[T]
+}
+
+// Unmodifiable returns a new [UnmodifiableList] associated at the list l.
+//
+// If l is already an [UnmodifiableList], it is returned unchanged.
+func Unmodifiable[T any](l List[T]) *UnmodifiableList[T] {
+	if list, ok := l.(*UnmodifiableList[T]); ok {
+		return list
+	}
+	return &UnmodifiableList[T]{list: l}
+}
+
+// Len returns the length of l.
+func (l *UnmodifiableList[T]) Len() int {
+	return l.list.Len()
+}
+
+// IsEmpty returns a bool which indicates if l is empty or not.
+func (l *UnmodifiableList[T]) IsEmpty() bool {
+	return l.list.IsEmpty()
+}
+
+// Contains returns if e is present in l.
+func (l *UnmodifiableList[T]) Contains(e T) bool {
+	return l.list.Contains(e)
+}
+
+// IndexOf returns the first position of e in l.
+// If e is not present, the result is -1.
+func (l *UnmodifiableList[T]) IndexOf(e T) int {
+	return l.list.IndexOf(e)
+}
+
+// LastIndexOf returns the last position of e in l.
+// If e is not present, the result is -1.
+func (l *UnmodifiableList[T]) LastIndexOf(e T) int {
+	return l.list.LastIndexOf(e)
+}
+
+// ToSlice returns a slice which contains all elements of l.
+func (l *UnmodifiableList[T]) ToSlice() []T {
+	return l.list.ToSlice()
+}
+
+// Get returns the elements at the specifies index.
+// It returns an error if the the index is out of bounds.
+func (l *UnmodifiableList[T]) Get(index int) (T, error) {
+	return l.list.Get(index)
+}
+
+// GetDefault returns the elements at the specifies index.
+// It returns the T zero value if the the index is out of bounds.
+func (l *UnmodifiableList[T]) GetDefault(index int) T {
+	return l.list.GetDefault(index)
+}
+
+// GetDefaultValue returns the elements at the specifies index.
+// It returns value if the the index is out of bounds.
+func (l *UnmodifiableList[T]) GetDefaultValue(index int, value T) T {
+	return l.list.GetDefaultValue(index, value)
+}
+
+// Set always returns [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) Set(index int, e T) (T, error) {
+
+	var result T
+
+	return result, structures.ErrUnmodifiable
+}
+
+// Add always panics with [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) Add(e ...T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// AddAtIndex always returns [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) AddAtIndex(index int, e ...T) error {
+	return structures.ErrUnmodifiable
+}
+
+// AddSlice always panics with [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) AddSlice(e []T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// AddSliceAtIndex always returns [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) AddSliceAtIndex(index int, e []T) error {
+	return structures.ErrUnmodifiable
+}
+
+// Remove always returns [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) Remove(index int) (T, error) {
+
+	var result T
+
+	return result, structures.ErrUnmodifiable
+}
+
+// RemoveElement always panics with [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) RemoveElement(e T) bool {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Each executes fun for all elements of l.
+func (l *UnmodifiableList[T]) Each(fun func(index int, element T)) {
+	l.list.Each(fun)
+}
+
+// Stream returns a [Stream] rapresenting l.
+func (l *UnmodifiableList[T]) Stream() *Stream[T] {
+	return l.list.Stream()
+}
+
+// Sort always panics with [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) Sort() {
+	panic(structures.ErrUnmodifiable)
+}
+
+// SortFunc always panics with [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) SortFunc(less func(i T, j T) int) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Clear always panics with [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) Clear() {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Iter returns an [Iterator] which permits to iterate l.
+// The Remove method of the iterator panics with [structures.ErrUnmodifiable].
+//
+//	for i := l.Iter(); !i.End(); i = i.Next() {
+//		element := i.Element()
+//		index := i.Index()
+//		// Code
+//	}
+func (l *UnmodifiableList[T]) Iter() Iterator[T] {
+	return NewUnmodifiableListIterator(l.list.Iter())
+}
+
+// IterReverse returns an [Iterator] which permits to iterate l in reverse order.
+// The Remove method of the iterator panics with [structures.ErrUnmodifiable].
+//
+//	for i := l.IterReverse(); !i.End(); i = i.Prev() {
+//		element := i.Element()
+//		index := i.Index()
+//		// Code
+//	}
+func (l *UnmodifiableList[T]) IterReverse() Iterator[T] {
+	return NewUnmodifiableListIterator(l.list.IterReverse())
+}
+
+// RangeIter returns a function that allows to iterate l using the range keyword.
+//
+//	for i, j := range l.RangeIter() {
+//		// Code
+//	}
+func (l *UnmodifiableList[T]) RangeIter() func(yield func(int, T) bool) {
+	return l.list.RangeIter()
+}
+
+// RangeIterReverse returns a function that allows to iterate l using the range keyword in reverse order.
+//
+//	for i, j := range l.RangeIterReverse() {
+//		// Code
+//	}
+func (l *UnmodifiableList[T]) RangeIterReverse() func(yield func(int, T) bool) {
+	return l.list.RangeIterReverse()
+}
+
+// Equal returns true if l and st are both lists and their elements are equals.
+// In any other case, it returns false.
+func (l *UnmodifiableList[T]) Equal(st any) bool {
+	return l.list.Equal(st)
+}
+
+// Compare returns 0 if l and st are equals,
+// -1 if l is shorten than st,
+// 1 if l is longer than st,
+// -2 if st is not a [List] or if one between l and st is nil.
+func (l *UnmodifiableList[T]) Compare(st any) int {
+	return l.list.Compare(st)
+}
+
+// Hash returns the hash code of l.
+func (l *UnmodifiableList[T]) Hash() uint64 {
+	return l.list.Hash()
+}
+
+// Copy returns a modifiable copy of the wrapped list.
+func (l *UnmodifiableList[T]) Copy() List[T] {
+	return l.list.Copy()
+}
+
+// String returns a rapresentation of l in the form of a string.
+func (l *UnmodifiableList[T]) String() string {
+	return fmt.Sprintf("Unmodifiable%v", l.list)
+}
+
+// MarshalJSON returns the JSON encoding of the wrapped list.
+func (l *UnmodifiableList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.list)
+}
+
+// UnmarshalJSON always returns [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) UnmarshalJSON(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// MarshalBinary returns the binary encoding of the wrapped list.
+func (l *UnmodifiableList[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(l.list.ToSlice())
+}
+
+// UnmarshalBinary always returns [structures.ErrUnmodifiable].
+func (l *UnmodifiableList[T]) UnmarshalBinary(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// GobEncode returns the binary encoding of l as [UnmodifiableList.MarshalBinary].
+func (l *UnmodifiableList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// GobDecode decodes data into l as [UnmodifiableList.UnmarshalBinary].
+func (l *UnmodifiableList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
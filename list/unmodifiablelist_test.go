@@ -0,0 +1,160 @@
+package list
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures"
+)
+
+func checkUnmodifiable(fun func()) (result bool) {
+	defer func() {
+		err, ok := recover().(error)
+		result = ok && errors.Is(err, structures.ErrUnmodifiable)
+	}()
+	fun()
+	return false
+}
+func TestNewUnmodifiableList(t *testing.T) {
+
+	var wrapped *ArrayList[int] = NewArrayList(1, 2, 3)
+	var list List[int] = Unmodifiable[int](wrapped)
+
+	if list.Len() != 3 || list.IsEmpty() {
+		t.Log("length is", list.Len())
+		t.Fail()
+	}
+	if list.String() != "UnmodifiableArrayList[int][1 2 3]" {
+		t.Log("list is", list)
+		t.Fail()
+	}
+	if Unmodifiable(list) != list {
+		t.Log("list has been wrapped twice")
+		t.Fail()
+	}
+	if !list.Equal(wrapped) || !wrapped.Equal(list) || list.Compare(wrapped) != 0 || list.Hash() != wrapped.Hash() {
+		t.Log("lists are not equals")
+		t.Fail()
+	}
+	wrapped.Add(4)
+	if list.Len() != 4 || list.GetDefault(-1) != 4 || !list.Contains(4) || list.IndexOf(4) != 3 {
+		t.Log("list objects are", list.ToSlice())
+		t.Fail()
+	}
+	result := list.Copy()
+	result.Add(5)
+	if list.Len() != 4 || result.Len() != 5 {
+		t.Log("copy is", result)
+		t.Fail()
+	}
+}
+func TestModifyUnmodifiableList(t *testing.T) {
+
+	var list *UnmodifiableList[int] = Unmodifiable[int](NewArrayList(1, 2, 3))
+
+	if _, err := list.Set(0, 5); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if _, err := list.Remove(0); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := list.AddAtIndex(0, 5); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := list.AddSliceAtIndex(0, []int{5}); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	for _, i := range []func(){
+		func() { list.Add(5) },
+		func() { list.AddSlice([]int{5}) },
+		func() { list.RemoveElement(1) },
+		func() { list.Sort() },
+		func() { list.SortFunc(func(i int, j int) int { return j - i }) },
+		func() { list.Clear() },
+		func() { list.Iter().Remove() },
+	} {
+		if !checkUnmodifiable(i) {
+			t.Log("list has been modified")
+			t.Fail()
+		}
+	}
+	if !slices.Equal(list.ToSlice(), []int{1, 2, 3}) {
+		t.Log("list objects are", list.ToSlice())
+		t.Fail()
+	}
+}
+func TestIterUnmodifiableList(t *testing.T) {
+
+	var list *UnmodifiableList[int] = Unmodifiable[int](NewLinkedList(1, 2, 3))
+
+	j := 0
+	for i := list.Iter(); !i.End(); i = i.Next() {
+		if i.Index() != j || i.Element() != j+1 {
+			t.Log("index is", i.Index(), "element is", i.Element())
+			t.Fail()
+		}
+		j++
+	}
+	for i := list.IterReverse(); !i.End(); i = i.Prev() {
+		j--
+		if i.Index() != j || i.Element() != j+1 {
+			t.Log("index is", i.Index(), "element is", i.Element())
+			t.Fail()
+		}
+	}
+	if j != 0 {
+		t.Log("j is", j)
+		t.Fail()
+	}
+	if !Unmodifiable[int](NewArrayList[int]()).Iter().End() {
+		t.Log("iterator is not ended")
+		t.Fail()
+	}
+	elements := make([]int, 0)
+	for _, i := range list.RangeIter() {
+		elements = append(elements, i)
+	}
+	if !slices.Equal(elements, []int{1, 2, 3}) || !slices.Equal(list.Stream().Collect().ToSlice(), []int{1, 2, 3}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+}
+func TestJSONUnmodifiableList(t *testing.T) {
+
+	var list *UnmodifiableList[int] = Unmodifiable[int](NewArrayList(1, 2))
+
+	data, err := json.Marshal(list)
+	if err != nil || string(data) != "[1,2]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal(data, list); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestBinaryUnmodifiableList(t *testing.T) {
+
+	var list *UnmodifiableList[int] = Unmodifiable[int](NewArrayList(1, 2))
+	var result *LinkedList[int] = NewLinkedList[int]()
+
+	data, err := list.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !result.Equal(list) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if err := list.UnmarshalBinary(data); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
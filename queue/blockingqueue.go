@@ -0,0 +1,411 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util"
+)
+
+var _ structures.Structure[int] = NewBlockingQueue[int](1)
+var _ BaseQueue[int] = NewBlockingQueue[int](1)
+
+// ErrClosed is the error returned by the blocking operations of a [BlockingQueue] which has been closed.
+var ErrClosed = errors.New("Queue is closed")
+
+// BlockingQueue provides a generic queue with a fixed capacity which can be used concurrently.
+// The elements are stored in a [Queue], or in a [PriorityQueue] if the queue is created with [NewBlockingPriorityQueue].
+//
+// PutContext and TakeContext block until there is space or an element in the queue,
+// while Offer and Poll block at most for a timeout.
+//
+// After Close, no element can be added to the queue,
+// but the remaining elements can still be removed until the queue is empty.
+//
+// It implements the interface [BaseQueue].
+type BlockingQueue[T any] struct {
+	// contains filtered or unexported fields
+	objects  BaseQueue[T]
+	capacity int
+	closed   bool
+	changed  chan struct{}
+	lock     sync.Mutex
+}
+
+// NewBlockingQueue returns a new [BlockingQueue] with the given capacity containing the elements c.
+// The head of the queue is the first element of c, while the tail is the last element.
+//
+// if no element is passed, it will be created an empty [BlockingQueue].
+//
+// This function panics if capacity is less than 1 or if c has more elements than capacity.
+func NewBlockingQueue[T any](capacity int, c ...T) *BlockingQueue[T] {
+	return NewBlockingQueueFromSlice(capacity, c)
+}
+
+// NewBlockingQueueFromSlice returns a new [BlockingQueue] with the given capacity containing the elements of slice c.
+//
+// This function panics if capacity is less than 1 or if c has more elements than capacity.
+func NewBlockingQueueFromSlice[T any](capacity int, c []T) *BlockingQueue[T] {
+	return newBlockingQueue(capacity, NewQueueFromSlice(c))
+}
+
+// NewBlockingPriorityQueue returns a new [BlockingQueue] with the given capacity containing the elements c.
+// The elements are removed from the maximum to the minimum.
+//
+// This function panics if capacity is less than 1 or if c has more elements than capacity.
+func NewBlockingPriorityQueue[T util.Comparer](capacity int, c ...T) *BlockingQueue[T] {
+	return NewBlockingPriorityQueueFromSlice(capacity, c)
+}
+
+// NewBlockingPriorityQueueFromSlice returns a new [BlockingQueue] with the given capacity containing the elements of slice c.
+// The elements are removed from the maximum to the minimum.
+//
+// This function panics if capacity is less than 1 or if c has more elements than capacity.
+func NewBlockingPriorityQueueFromSlice[T util.Comparer](capacity int, c []T) *BlockingQueue[T] {
+	return newBlockingQueue(capacity, NewPriorityQueueFromSlice(c))
+}
+
+// NewBlockingPriorityQueueFunc returns a new [BlockingQueue] with the given capacity containing the elements c.
+// The elements are removed from the maximum to the minimum according to the comparison function compare.
+//
+// This function panics if capacity is less than 1 or if c has more elements than capacity.
+func NewBlockingPriorityQueueFunc[T any](capacity int, compare func(i T, j T) int, c ...T) *BlockingQueue[T] {
+	return NewBlockingPriorityQueueFromSliceFunc(capacity, compare, c)
+}
+
+// NewBlockingPriorityQueueFromSliceFunc returns a new [BlockingQueue] with the given capacity containing the elements of slice c.
+// The elements are removed from the maximum to the minimum according to the comparison function compare.
+//
+// This function panics if capacity is less than 1 or if c has more elements than capacity.
+func NewBlockingPriorityQueueFromSliceFunc[T any](capacity int, compare func(i T, j T) int, c []T) *BlockingQueue[T] {
+	return newBlockingQueue(capacity, NewPriorityQueueFromSliceFunc(compare, c))
+}
+
+func newBlockingQueue[T any](capacity int, objects BaseQueue[T]) *BlockingQueue[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("Cannot create a queue with capacity %d", capacity))
+	}
+	if objects.Len() > capacity {
+		panic(fmt.Sprintf("Cannot create a queue with capacity %d and %d elements", capacity, objects.Len()))
+	}
+	return &BlockingQueue[T]{objects: objects, capacity: capacity, changed: make(chan struct{})}
+}
+
+// Len returns the length of q.
+func (q *BlockingQueue[T]) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.objects.Len()
+}
+
+// IsEmpty returns a bool which indicate if q is empty or not.
+func (q *BlockingQueue[T]) IsEmpty() bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.objects.IsEmpty()
+}
+
+// Capacity returns the max number of elements of q.
+func (q *BlockingQueue[T]) Capacity() int {
+	return q.capacity
+}
+
+// Remaining returns the number of elements which can be added to q without blocking.
+func (q *BlockingQueue[T]) Remaining() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.capacity - q.objects.Len()
+}
+
+// Head returns the head element of q.
+// The method returns false if q is empty.
+func (q *BlockingQueue[T]) Head() (T, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.objects.Head()
+}
+
+// Tail returns the tail element of q.
+// The method returns false if q is empty.
+func (q *BlockingQueue[T]) Tail() (T, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.objects.Tail()
+}
+
+// ToSlice returns a slice which contains all elements of q.
+func (q *BlockingQueue[T]) ToSlice() []T {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.objects.ToSlice()
+}
+
+// Push adds the elements e at the tail of q, blocking until there is space for each of them.
+//
+// This method panics if q is closed.
+func (q *BlockingQueue[T]) Push(e ...T) {
+	for _, i := range e {
+		if err := q.PutContext(context.Background(), i); err != nil {
+			panic("Cannot push an element in a closed queue")
+		}
+	}
+}
+
+// Pop removes an element from the head of q and returns the removed element without blocking.
+// The method returns false if q is empty.
+func (q *BlockingQueue[T]) Pop() (T, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.pop()
+}
+
+// Put adds the element e at the tail of q, blocking until there is space for it.
+// It returns [ErrClosed] if q is closed.
+func (q *BlockingQueue[T]) Put(e T) error {
+	return q.PutContext(context.Background(), e)
+}
+
+// PutContext adds the element e at the tail of q, blocking until there is space for it or ctx is done.
+// It returns [ErrClosed] if q is closed or the error of ctx if it is done before the element is added.
+func (q *BlockingQueue[T]) PutContext(ctx context.Context, e T) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for !q.closed && q.objects.Len() == q.capacity {
+		if err := q.wait(ctx); err != nil {
+			return err
+		}
+	}
+	if q.closed {
+		return ErrClosed
+	}
+	q.objects.Push(e)
+	q.notify()
+	return nil
+}
+
+// Take removes an element from the head of q and returns the removed element, blocking until q is not empty.
+// It returns [ErrClosed] if q is closed and empty.
+func (q *BlockingQueue[T]) Take() (T, error) {
+	return q.TakeContext(context.Background())
+}
+
+// TakeContext removes an element from the head of q and returns the removed element,
+// blocking until q is not empty or ctx is done.
+// It returns [ErrClosed] if q is closed and empty or the error of ctx if it is done before an element is removed.
+func (q *BlockingQueue[T]) TakeContext(ctx context.Context) (T, error) {
+
+	var result T
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for !q.closed && q.objects.IsEmpty() {
+		if err := q.wait(ctx); err != nil {
+			return result, err
+		}
+	}
+	result, ok := q.pop()
+	if !ok {
+		return result, ErrClosed
+	}
+	return result, nil
+}
+
+// Offer adds the element e at the tail of q, blocking at most for timeout until there is space for it.
+// It returns false if the element has not been added because the timeout is expired or because q is closed.
+//
+// If timeout is not positive, Offer does not block.
+func (q *BlockingQueue[T]) Offer(e T, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.PutContext(ctx, e) == nil
+}
+
+// Poll removes an element from the head of q and returns the removed element,
+// blocking at most for timeout until q is not empty.
+// It returns false if no element has been removed because the timeout is expired or because q is closed and empty.
+//
+// If timeout is not positive, Poll does not block.
+func (q *BlockingQueue[T]) Poll(timeout time.Duration) (T, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	result, err := q.TakeContext(ctx)
+	return result, err == nil
+}
+
+// Drain removes all elements from q and returns them, from the head to the tail, without blocking.
+func (q *BlockingQueue[T]) Drain() []T {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	result := make([]T, 0, q.objects.Len())
+	for i, ok := q.pop(); ok; i, ok = q.pop() {
+		result = append(result, i)
+	}
+	return result
+}
+
+// Close closes q and wakes up all the goroutines blocked on it.
+//
+// After Close, adding an element fails with [ErrClosed],
+// while the remaining elements can still be removed and [BlockingQueue.Take] fails only when q is empty.
+// Closing an already closed queue has no effect.
+func (q *BlockingQueue[T]) Close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if !q.closed {
+		q.closed = true
+		q.notify()
+	}
+}
+
+// IsClosed returns true if q has been closed.
+func (q *BlockingQueue[T]) IsClosed() bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.closed
+}
+
+// Clear removes all element from q.
+func (q *BlockingQueue[T]) Clear() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.objects.Clear()
+	q.notify()
+}
+
+// Equal returns true if q and st are both queues and their elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [Queue],
+// but the elements of q and the elements of st are equals, this method returns anyway true.
+func (q *BlockingQueue[T]) Equal(st any) bool {
+	queue, ok := st.(BaseQueue[T])
+	if ok && q != nil && queue != nil {
+		return list.NewArrayListFromStructure[T](q).Equal(list.NewArrayListFromStructure[T](queue))
+	}
+	return false
+}
+
+// Compare returns 0 if q and st are equals,
+// -1 if q is shorten than st,
+// 1 if q is longer than st,
+// -2 if st is not a [BaseQueue] or if one between q and st is nil.
+//
+// If q and st have the same length, the result is the comparison
+// between the first different element of the two queues if T implemets [util.Comparer],
+// otherwhise the result is 0.
+func (q *BlockingQueue[T]) Compare(st any) int {
+	queue, ok := st.(BaseQueue[T])
+	if ok && q != nil && queue != nil {
+		return list.NewArrayListFromStructure[T](q).Compare(list.NewArrayListFromStructure[T](queue))
+	}
+	return -2
+}
+
+// Hash returns the hash code of q.
+func (q *BlockingQueue[T]) Hash() uint64 {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.objects.Hash()
+}
+
+// String returns a rapresentation of q in the form of a string.
+func (q *BlockingQueue[T]) String() string {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return fmt.Sprintf("Blocking%v", q.objects)
+}
+
+// MarshalJSON returns the JSON encoding of q, which is an array containing its elements from the head to the tail.
+func (q *BlockingQueue[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(q.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of q with the ones decoded from the JSON array data,
+// keeping its capacity and waking up the goroutines blocked on it.
+//
+// The method returns an error if q is the zero value, if the elements are more than the capacity of q
+// and it returns [ErrClosed] if q is closed.
+func (q *BlockingQueue[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, q.decode)
+}
+
+// MarshalBinary returns the binary encoding of q, which contains its elements from the head to the tail.
+func (q *BlockingQueue[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(q.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of q with the ones decoded from the binary encoding data,
+// keeping its capacity and waking up the goroutines blocked on it.
+//
+// The method returns an error if q is the zero value, if the elements are more than the capacity of q
+// and it returns [ErrClosed] if q is closed.
+func (q *BlockingQueue[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, q.decode)
+}
+
+// GobEncode returns the binary encoding of q as [BlockingQueue.MarshalBinary].
+func (q *BlockingQueue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// GobDecode decodes data into q as [BlockingQueue.UnmarshalBinary].
+func (q *BlockingQueue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of q with objects.
+func (q *BlockingQueue[T]) decode(objects []T) error {
+	if q.objects == nil {
+		return errors.New("Cannot decode a BlockingQueue without a capacity")
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.closed {
+		return ErrClosed
+	}
+	if len(objects) > q.capacity {
+		return errors.New("Cannot decode " + strconv.Itoa(len(objects)) + " elements in a queue with capacity " + strconv.Itoa(q.capacity))
+	}
+	q.objects.Clear()
+	q.objects.Push(objects...)
+	q.notify()
+	return nil
+}
+
+// pop removes the head of q and wakes up the goroutines waiting for space.
+// It must be called holding the lock of q.
+func (q *BlockingQueue[T]) pop() (T, bool) {
+	result, ok := q.objects.Pop()
+	if ok {
+		q.notify()
+	}
+	return result, ok
+}
+
+// notify wakes up all the goroutines waiting for a change of q.
+// It must be called holding the lock of q.
+func (q *BlockingQueue[T]) notify() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
+
+// wait releases the lock of q until q changes or ctx is done.
+// It must be called holding the lock of q and it returns holding it again.
+func (q *BlockingQueue[T]) wait(ctx context.Context) error {
+	changed := q.changed
+	q.lock.Unlock()
+	defer q.lock.Lock()
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,226 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewBlockingQueue(t *testing.T) {
+
+	var queue structures.Structure[int] = NewBlockingQueue[int](3)
+	var queueSlice *BlockingQueue[int] = NewBlockingQueueFromSlice(3, []int{1, 2, 3})
+
+	if queue.Len() != 0 || !queue.IsEmpty() {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+	if queueSlice.Capacity() != 3 || queueSlice.Remaining() != 0 || !slices.Equal(queueSlice.ToSlice(), []int{1, 2, 3}) {
+		t.Log("queue objects are", queueSlice.ToSlice())
+		t.Fail()
+	}
+	if queueSlice.String() != "BlockingQueue[int][3, 1 3]" {
+		t.Log("queue is", queueSlice)
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("the queue has been created")
+			t.Fail()
+		}
+	}()
+	NewBlockingQueue(1, 1, 2)
+}
+func TestPutTakeBlockingQueue(t *testing.T) {
+
+	var queue *BlockingQueue[int] = NewBlockingQueue[int](2)
+
+	if err := queue.Put(1); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	queue.Push(2)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := queue.PutContext(ctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if queue.Offer(3, 0) {
+		t.Log("the element has been added")
+		t.Fail()
+	}
+	for i := 1; i != 3; i++ {
+		if e, err := queue.Take(); err != nil || e != i {
+			t.Log("e is", e, "err is", err)
+			t.Fail()
+		}
+	}
+	if _, ok := queue.Poll(10 * time.Millisecond); ok {
+		t.Log("the queue is not empty")
+		t.Fail()
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.Put(4)
+	}()
+	if e, ok := queue.Poll(time.Second); !ok || e != 4 {
+		t.Log("e is", e)
+		t.Fail()
+	}
+}
+func TestCloseBlockingQueue(t *testing.T) {
+
+	var queue *BlockingQueue[int] = NewBlockingQueue[int](3, 1, 2)
+
+	done := make(chan error)
+	go func() {
+		empty := NewBlockingQueue[int](1)
+		go empty.Close()
+		_, err := empty.Take()
+		done <- err
+	}()
+	if err := <-done; !errors.Is(err, ErrClosed) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	queue.Close()
+	if !queue.IsClosed() || !errors.Is(queue.Put(3), ErrClosed) || queue.Offer(3, time.Second) {
+		t.Log("the element has been added")
+		t.Fail()
+	}
+	if e, err := queue.Take(); err != nil || e != 1 {
+		t.Log("e is", e, "err is", err)
+		t.Fail()
+	}
+	if slice := queue.Drain(); !slices.Equal(slice, []int{2}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if _, err := queue.Take(); !errors.Is(err, ErrClosed) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestBlockingPriorityQueue(t *testing.T) {
+
+	var queue *BlockingQueue[wrapper.Int] = NewBlockingPriorityQueue[wrapper.Int](4, 2, 5, 1)
+
+	queue.Push(3)
+	for _, i := range []wrapper.Int{5, 3, 2, 1} {
+		if e, err := queue.Take(); err != nil || e != i {
+			t.Log("e is", e, "err is", err)
+			t.Fail()
+		}
+	}
+	if !NewBlockingPriorityQueueFunc(2, func(i int, j int) int { return j - i }, 2, 1).Equal(NewQueue(1, 2)) {
+		t.Log("queues are not equals")
+		t.Fail()
+	}
+}
+func TestConcurrentBlockingQueue(t *testing.T) {
+
+	var queue *BlockingQueue[int] = NewBlockingQueue[int](4)
+
+	var producers sync.WaitGroup
+	var consumers sync.WaitGroup
+	taken := make([][]int, 4)
+	for i := 0; i != 4; i++ {
+		producers.Add(1)
+		consumers.Add(1)
+		go func(i int) {
+			defer producers.Done()
+			for j := 0; j != 250; j++ {
+				if err := queue.Put(i*1000 + j); err != nil {
+					t.Log("err is", err)
+					t.Fail()
+				}
+			}
+		}(i)
+		go func(i int) {
+			defer consumers.Done()
+			for e, err := queue.Take(); err == nil; e, err = queue.Take() {
+				taken[i] = append(taken[i], e)
+			}
+		}(i)
+	}
+	producers.Wait()
+	queue.Close()
+	consumers.Wait()
+	if all := slices.Sorted(slices.Values(slices.Concat(taken...))); len(all) != 1000 || !slices.Equal(all, slices.Compact(slices.Clone(all))) {
+		t.Log("elements are lost or duplicated")
+		t.Fail()
+	}
+}
+func TestJSONBlockingQueue(t *testing.T) {
+
+	var queue *BlockingQueue[int] = NewBlockingQueue(3, 1, 2)
+
+	data, err := json.Marshal(queue)
+	if err != nil || string(data) != "[1,2]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result := NewBlockingQueue[int](2)
+	if err := json.Unmarshal(data, result); err != nil || !result.Equal(queue) || result.Capacity() != 2 {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte("[1, 2, 3]"), result); err == nil || result.Len() != 2 {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var zero BlockingQueue[int]
+	if err := json.Unmarshal(data, &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	result.Close()
+	if err := json.Unmarshal(data, result); !errors.Is(err, ErrClosed) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestBinaryBlockingQueue(t *testing.T) {
+
+	var queue *BlockingQueue[int] = NewBlockingQueue(3, 1, 2)
+
+	data, err := queue.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	result := NewBlockingQueue[int](2)
+	if err := result.UnmarshalBinary(data); err != nil || !result.Equal(queue) || result.Capacity() != 2 {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(NewBlockingQueue(3, 1, 2, 3)); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := gob.NewDecoder(&buffer).Decode(result); err == nil || result.Len() != 2 {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var zero BlockingQueue[int]
+	if err := zero.UnmarshalBinary(data); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+	result.Close()
+	if err := result.UnmarshalBinary(data); !errors.Is(err, ErrClosed) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,38 @@
+package queue
+
+import (
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util"
+)
+
+// ToQueue returns a [structures.Collector] which collects the elements into a [Queue].
+func ToQueue[T any]() structures.Collector[T, *Queue[T]] {
+	return structures.NewCollector(func() *Queue[T] {
+		return NewQueue[T]()
+	}, func(result *Queue[T], element T) *Queue[T] {
+		result.Push(element)
+		return result
+	}, func(result *Queue[T]) *Queue[T] {
+		return result
+	})
+}
+
+// ToPriorityQueue returns a [structures.Collector] which collects the elements into a [PriorityQueue].
+//
+// The elements are accumulated in a slice, which is turned into the heap only at the end in O(n) time.
+func ToPriorityQueue[T util.Comparer]() structures.Collector[T, *PriorityQueue[T]] {
+	return ToPriorityQueueFunc(util.Compare[T])
+}
+
+// ToPriorityQueueFunc returns a [structures.Collector] which collects the elements into a [PriorityQueue] ordered by the compare function.
+//
+// The elements are accumulated in a slice, which is turned into the heap only at the end in O(n) time.
+func ToPriorityQueueFunc[T any](compare func(i T, j T) int) structures.Collector[T, *PriorityQueue[T]] {
+	return structures.NewCollector(func() []T {
+		return []T{}
+	}, func(result []T, element T) []T {
+		return append(result, element)
+	}, func(result []T) *PriorityQueue[T] {
+		return NewPriorityQueueFromSliceFunc(compare, result)
+	})
+}
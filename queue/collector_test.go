@@ -0,0 +1,34 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestToQueue(t *testing.T) {
+
+	var queue *Queue[int] = ToQueue[int]().Collect(slices.Values([]int{1, 2, 3}))
+
+	if e, ok := queue.Head(); !ok || e != 1 || queue.Len() != 3 {
+		t.Log("queue is", queue)
+		t.Fail()
+	}
+}
+func TestToPriorityQueue(t *testing.T) {
+
+	var queue *PriorityQueue[wrapper.Int] = ToPriorityQueue[wrapper.Int]().Collect(slices.Values([]wrapper.Int{2, 1, 3}))
+
+	if e, ok := queue.Head(); !ok || e != 3 || queue.Len() != 3 {
+		t.Log("queue is", queue)
+		t.Fail()
+	}
+	queue = ToPriorityQueueFunc(func(i wrapper.Int, j wrapper.Int) int {
+		return j.Compare(i)
+	}).Collect(slices.Values([]wrapper.Int{2, 1, 3}))
+	if e, ok := queue.Head(); !ok || e != 1 {
+		t.Log("queue is", queue)
+		t.Fail()
+	}
+}
@@ -0,0 +1,276 @@
+package queue
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+)
+
+var _ structures.Structure[int] = NewConcurrentQueue[int]()
+var _ BaseQueue[int] = NewConcurrentQueue[int]()
+
+// ConcurrentQueue provides a generic FIFO structure which can be used concurrently without locks.
+// The queue is the Michael-Scott queue, implemented through a series of linked [structures.AtomicEntry]
+// starting from a sentinel entry.
+// The element of a popped entry, which becomes the new sentinel, is cleared, so it is not kept reachable by q.
+//
+// Push and Pop are atomic, even when more elements are pushed together.
+// Len, ToSlice, Equal, Compare, Hash and String are instead weakly consistent:
+// they read the queue while other goroutines can modify it.
+//
+// It implements the interface [BaseQueue].
+type ConcurrentQueue[T any] struct {
+	// contains filtered or unexported fields
+	head atomic.Pointer[structures.AtomicEntry[T]]
+	tail atomic.Pointer[structures.AtomicEntry[T]]
+	len  atomic.Int64
+}
+
+// NewConcurrentQueue returns a new [ConcurrentQueue] containing the elements c.
+// The head of the queue is the first element of c, while the tail is the last element.
+//
+// if no argument is passed, it will be created an empty [ConcurrentQueue].
+func NewConcurrentQueue[T any](c ...T) *ConcurrentQueue[T] {
+	return NewConcurrentQueueFromSlice(c)
+}
+
+// NewConcurrentQueueFromSlice returns a new [ConcurrentQueue] containing the elements of slice c.
+func NewConcurrentQueueFromSlice[T any](c []T) *ConcurrentQueue[T] {
+	sentinel := newSentinel[T]()
+	queue := &ConcurrentQueue[T]{}
+	queue.head.Store(sentinel)
+	queue.tail.Store(sentinel)
+	if len(c) != 0 {
+		queue.Push(c...)
+	}
+	return queue
+}
+
+// Len returns the length of q.
+func (q *ConcurrentQueue[T]) Len() int {
+	return int(q.len.Load())
+}
+
+// IsEmpty returns a bool which indicate if q is empty or not.
+func (q *ConcurrentQueue[T]) IsEmpty() bool {
+	return q.head.Load().Next() == nil
+}
+
+// Head returns the head element of q.
+// The method returns false if q is empty.
+func (q *ConcurrentQueue[T]) Head() (T, bool) {
+	for {
+		next := q.head.Load().Next()
+		if next == nil {
+
+			var result T
+
+			return result, false
+		}
+		if element, ok := next.LoadElement(); ok {
+			return element, true
+		}
+	}
+}
+
+// Tail returns the tail element of q.
+// The method returns false if q is empty.
+func (q *ConcurrentQueue[T]) Tail() (T, bool) {
+	for {
+		tail := q.tail.Load()
+		if next := tail.Next(); next != nil {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail == q.head.Load() {
+
+			var result T
+
+			return result, false
+		}
+		if element, ok := tail.LoadElement(); ok {
+			return element, true
+		}
+	}
+}
+
+// ToSlice returns a slice which contains all elements of q.
+func (q *ConcurrentQueue[T]) ToSlice() []T {
+	slice := make([]T, 0, q.Len())
+	for i := q.head.Load().Next(); i != nil; i = i.Next() {
+		element, ok := i.LoadElement()
+		if !ok {
+			// i has been popped, as all the entries before it.
+			slice = slice[:0]
+			continue
+		}
+		slice = append(slice, element)
+	}
+	return slice
+}
+
+// Push adds the elements e at the tail of q.
+func (q *ConcurrentQueue[T]) Push(e ...T) {
+	if len(e) == 0 {
+		return
+	}
+	first, last := structures.NewAtomicEntrySlice(e)
+	q.len.Add(int64(len(e)))
+	for {
+		tail := q.tail.Load()
+		next := tail.Next()
+		if next != nil {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.CompareAndSwapNext(nil, first) {
+			q.tail.CompareAndSwap(tail, last)
+			return
+		}
+	}
+}
+
+// Pop removes an element from the head of q and returns the removed element.
+// The method returns false if q is empty.
+func (q *ConcurrentQueue[T]) Pop() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.Next()
+		if next == nil {
+
+			var result T
+
+			return result, false
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if element, ok := next.LoadElement(); ok && q.head.CompareAndSwap(head, next) {
+			next.ClearElement()
+			q.len.Add(-1)
+			return element, true
+		}
+	}
+}
+
+// Clear removes all element from q.
+//
+// The elements are popped one by one, so the elements pushed by other goroutines during the call could be removed too.
+func (q *ConcurrentQueue[T]) Clear() {
+	for _, ok := q.Pop(); ok; _, ok = q.Pop() {
+	}
+}
+
+// Equal returns true if q and st are both queues and their elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [Queue],
+// but the elements of q and the elements of st are equals, this method returns anyway true.
+func (q *ConcurrentQueue[T]) Equal(st any) bool {
+	queue, ok := st.(BaseQueue[T])
+	if ok && q != nil && queue != nil {
+		return list.NewArrayListFromStructure[T](q).Equal(list.NewArrayListFromStructure[T](queue))
+	}
+	return false
+}
+
+// Compare returns 0 if q and st are equals,
+// -1 if q is shorten than st,
+// 1 if q is longer than st,
+// -2 if st is not a [BaseQueue] or if one between q and st is nil.
+//
+// If q and st have the same length, the result is the comparison
+// between the first different element of the two queues if T implemets [util.Comparer],
+// otherwhise the result is 0.
+func (q *ConcurrentQueue[T]) Compare(st any) int {
+	queue, ok := st.(BaseQueue[T])
+	if ok && q != nil && queue != nil {
+		return list.NewArrayListFromStructure[T](q).Compare(list.NewArrayListFromStructure[T](queue))
+	}
+	return -2
+}
+
+// Hash returns the hash code of q.
+func (q *ConcurrentQueue[T]) Hash() uint64 {
+	h := fnv.New64()
+	for _, i := range q.ToSlice() {
+		h.Write([]byte(fmt.Sprintf("%v", structures.NewEntrySingle(i, nil).Hash())))
+	}
+	return h.Sum64()
+}
+
+// String returns a rapresentation of q in the form of a string.
+func (q *ConcurrentQueue[T]) String() string {
+	check := reflect.TypeOf(new(T)).String()
+	head, ok := q.Head()
+	if !ok {
+		return fmt.Sprintf("ConcurrentQueue[%v][%d, ]", check[1:], q.Len())
+	}
+	tail, _ := q.Tail()
+	return fmt.Sprintf("ConcurrentQueue[%v][%d, %v %v]", check[1:], q.Len(), head, tail)
+}
+
+// MarshalJSON returns the JSON encoding of q, which is an array containing its elements from the head to the tail.
+func (q *ConcurrentQueue[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(q.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of q with the ones decoded from the JSON array data.
+// The first element of the array becomes the head of the queue.
+//
+// The replacement is not atomic, so it must not be done while other goroutines are using q.
+func (q *ConcurrentQueue[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, q.decode)
+}
+
+// MarshalBinary returns the binary encoding of q, which contains its elements from the head to the tail.
+func (q *ConcurrentQueue[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(q.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of q with the ones decoded from the binary encoding data.
+// The first decoded element becomes the head of the queue.
+//
+// The replacement is not atomic, so it must not be done while other goroutines are using q.
+func (q *ConcurrentQueue[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, q.decode)
+}
+
+// GobEncode returns the binary encoding of q as [ConcurrentQueue.MarshalBinary].
+func (q *ConcurrentQueue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// GobDecode decodes data into q as [ConcurrentQueue.UnmarshalBinary].
+func (q *ConcurrentQueue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of q with objects.
+func (q *ConcurrentQueue[T]) decode(objects []T) error {
+	if q.head.Load() == nil {
+		sentinel := newSentinel[T]()
+		q.head.Store(sentinel)
+		q.tail.Store(sentinel)
+	}
+	q.Clear()
+	q.Push(objects...)
+	return nil
+}
+
+// newSentinel returns a new sentinel entry, whose element is cleared.
+func newSentinel[T any]() *structures.AtomicEntry[T] {
+
+	var element T
+
+	sentinel := structures.NewAtomicEntry(element, nil)
+	sentinel.ClearElement()
+	return sentinel
+}
@@ -0,0 +1,162 @@
+package queue
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/potex02/structures"
+)
+
+func TestNewConcurrentQueue(t *testing.T) {
+
+	var queue structures.Structure[int] = NewConcurrentQueue[int]()
+	var queueSlice *ConcurrentQueue[int] = NewConcurrentQueueFromSlice([]int{1, 2, 3})
+
+	if queue.Len() != 0 || !queue.IsEmpty() {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+	if queueSlice.Len() != 3 || !slices.Equal(queueSlice.ToSlice(), []int{1, 2, 3}) {
+		t.Log("queue objects are", queueSlice.ToSlice())
+		t.Fail()
+	}
+	if queueSlice.String() != "ConcurrentQueue[int][3, 1 3]" || queue.String() != "ConcurrentQueue[int][0, ]" {
+		t.Log("queues are", queueSlice, queue)
+		t.Fail()
+	}
+}
+func TestHeadTailConcurrentQueue(t *testing.T) {
+
+	var queue *ConcurrentQueue[int] = NewConcurrentQueue[int]()
+
+	if _, ok := queue.Head(); ok {
+		t.Log("the queue is not empty")
+		t.Fail()
+	}
+	if _, ok := queue.Tail(); ok {
+		t.Log("the queue is not empty")
+		t.Fail()
+	}
+	queue.Push(1, 2, 3)
+	if e, ok := queue.Head(); !ok || e != 1 {
+		t.Log("head is", e)
+		t.Fail()
+	}
+	if e, ok := queue.Tail(); !ok || e != 3 {
+		t.Log("tail is", e)
+		t.Fail()
+	}
+}
+func TestPushPopConcurrentQueue(t *testing.T) {
+
+	var queue *ConcurrentQueue[int] = NewConcurrentQueue[int](1)
+
+	queue.Push(2, 3)
+	for i := 1; i != 4; i++ {
+		if e, ok := queue.Pop(); !ok || e != i {
+			t.Log("e is", e)
+			t.Fail()
+		}
+	}
+	if _, ok := queue.Pop(); ok || !queue.IsEmpty() {
+		t.Log("the queue is not empty")
+		t.Fail()
+	}
+	queue.Push(4, 5)
+	queue.Pop()
+	if _, ok := queue.head.Load().LoadElement(); ok {
+		t.Log("the popped element is still referenced")
+		t.Fail()
+	}
+	if slice := queue.ToSlice(); !slices.Equal(slice, []int{5}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	queue.Clear()
+	if queue.Len() != 0 || !queue.IsEmpty() {
+		t.Log("the queue is not empty")
+		t.Fail()
+	}
+}
+func TestEqualConcurrentQueue(t *testing.T) {
+
+	var queue *ConcurrentQueue[int] = NewConcurrentQueue[int](1, 2, 3)
+
+	if !queue.Equal(NewQueue(1, 2, 3)) || !queue.Equal(NewConcurrentQueue(1, 2, 3)) {
+		t.Log("queues are not equals")
+		t.Fail()
+	}
+	if queue.Equal(NewConcurrentQueue(1, 2)) || queue.Compare(NewConcurrentQueue(1, 2)) != 1 {
+		t.Log("queues are equals")
+		t.Fail()
+	}
+	if queue.Hash() != NewQueue(1, 2, 3).Hash() {
+		t.Log("hashes are different")
+		t.Fail()
+	}
+}
+func TestConcurrentQueue(t *testing.T) {
+
+	var queue *ConcurrentQueue[int] = NewConcurrentQueue[int]()
+
+	var wg sync.WaitGroup
+	popped := make([][]int, 4)
+	for i := 0; i != 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j != 500; j++ {
+				queue.Push(i*1000+j*2, i*1000+j*2+1)
+			}
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			for len(popped[i]) != 1000 {
+				if e, ok := queue.Pop(); ok {
+					popped[i] = append(popped[i], e)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if !queue.IsEmpty() || queue.Len() != 0 {
+		t.Log("queue is", queue)
+		t.Fail()
+	}
+	for i := range popped {
+		last := make(map[int]int)
+		for _, j := range popped[i] {
+			if previous, ok := last[j/1000]; ok && previous > j {
+				t.Log("elements are not in order", previous, j)
+				t.Fail()
+			}
+			last[j/1000] = j
+		}
+	}
+	if all := slices.Sorted(slices.Values(slices.Concat(popped...))); len(all) != 4000 || !slices.Equal(all, slices.Compact(slices.Clone(all))) {
+		t.Log("elements are lost or duplicated")
+		t.Fail()
+	}
+}
+func TestJSONConcurrentQueue(t *testing.T) {
+
+	var queue *ConcurrentQueue[int] = NewConcurrentQueue(1, 2, 3)
+
+	data, err := json.Marshal(queue)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result ConcurrentQueue[int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(queue) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	result.Push(4)
+	if err := json.Unmarshal([]byte("[5]"), &result); err != nil || !slices.Equal(result.ToSlice(), []int{5}) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
@@ -1,11 +1,13 @@
 package queue
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/list"
 	"github.com/potex02/structures/tree"
 	"github.com/potex02/structures/util"
@@ -16,12 +18,15 @@ var _ structures.Structure[wrapper.Int] = NewDoublePriorityQueue[wrapper.Int]()
 var _ BaseDoubleQueue[wrapper.Int] = NewDoublePriorityQueue[wrapper.Int]()
 
 // DoublePriorityQueue provides a generic double queue which mantains the order of the elements.
-// It is implemented through an [tree.BinaryTree].
+// It is implemented through a [tree.RedBlackTree].
+//
+// The order of the elements is determined by the Compare method if the queue is created with [NewDoublePriorityQueue],
+// otherwise it is determined by the comparison function passed to [NewDoublePriorityQueueFunc].
 //
 // It implements the interface [BaseDoublePriorityQueue].
-type DoublePriorityQueue[T util.Comparer] struct {
+type DoublePriorityQueue[T any] struct {
 	// contains filtered or unexported fields
-	objects *tree.BinaryTree[T]
+	objects *tree.RedBlackTree[T]
 }
 
 // NewDoublePriorityQueue returns a new [DoublePriorityQueue] containing the elements c.
@@ -34,7 +39,24 @@ func NewDoublePriorityQueue[T util.Comparer](c ...T) *DoublePriorityQueue[T] {
 
 // NewDoublePriorityQueueFromSlice returns a new [DoublePriorityQueue] containing the elements of slice c.
 func NewDoublePriorityQueueFromSlice[T util.Comparer](c []T) *DoublePriorityQueue[T] {
-	return &DoublePriorityQueue[T]{objects: tree.NewBinaryTreeFromSlice(c)}
+	return NewDoublePriorityQueueFromSliceFunc(util.Compare[T], c)
+}
+
+// NewDoublePriorityQueueFunc returns a new [DoublePriorityQueue] containing the elements c,
+// whose order is determined by the compare function.
+//
+// compare must return a negative number if i has a lower priority than j, a positive number if i has a higher priority than j
+// and zero if they have the same priority.
+//
+// if no extra argument is passed, it will be created an empty [DoublePriorityQueue].
+func NewDoublePriorityQueueFunc[T any](compare func(i T, j T) int, c ...T) *DoublePriorityQueue[T] {
+	return NewDoublePriorityQueueFromSliceFunc(compare, c)
+}
+
+// NewDoublePriorityQueueFromSliceFunc returns a new [DoublePriorityQueue] containing the elements of slice c,
+// whose order is determined by the compare function.
+func NewDoublePriorityQueueFromSliceFunc[T any](compare func(i T, j T) int, c []T) *DoublePriorityQueue[T] {
+	return &DoublePriorityQueue[T]{objects: tree.NewRedBlackTreeFromSliceFunc(compare, c)}
 }
 
 // Len returns the length of q.
@@ -176,3 +198,53 @@ func (q *DoublePriorityQueue[T]) String() string {
 	tail, _ := q.Tail()
 	return fmt.Sprintf("DoublePriorityQueue[%v][%d, %v %v]", check[1:], q.Len(), head, tail)
 }
+
+// MarshalJSON returns the JSON encoding of q, which is an array containing its elements from the head to the tail.
+func (q *DoublePriorityQueue[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(q.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of q with the ones decoded from the JSON array data.
+//
+// If q is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (q *DoublePriorityQueue[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, q.decode)
+}
+
+// MarshalBinary returns the binary encoding of q, which contains its elements from the head to the tail.
+func (q *DoublePriorityQueue[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(q.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of q with the ones decoded from the binary encoding data.
+//
+// If q is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (q *DoublePriorityQueue[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, q.decode)
+}
+
+// GobEncode returns the binary encoding of q as [DoublePriorityQueue.MarshalBinary].
+func (q *DoublePriorityQueue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// GobDecode decodes data into q as [DoublePriorityQueue.UnmarshalBinary].
+func (q *DoublePriorityQueue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of q with objects.
+func (q *DoublePriorityQueue[T]) decode(objects []T) error {
+	if q.objects == nil {
+		compare := codec.Compare[T]()
+		if compare == nil {
+			return errors.New("Cannot decode a DoublePriorityQueue whose elements do not implement util.Comparer")
+		}
+		*q = *NewDoublePriorityQueueFunc(compare)
+	}
+	q.Clear()
+	q.Push(objects...)
+	return nil
+}
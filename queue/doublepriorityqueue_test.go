@@ -1,7 +1,9 @@
 package queue
 
 import (
+	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/potex02/structures"
@@ -38,6 +40,23 @@ func TestNewDoublePriorityQueueFromSlice(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestNewDoublePriorityQueueFunc(t *testing.T) {
+
+	var queue *DoublePriorityQueue[string] = NewDoublePriorityQueueFunc(strings.Compare, "b", "d", "a", "c")
+
+	if head, _ := queue.PopHead(); head != "d" {
+		t.Log("head is", head)
+		t.Fail()
+	}
+	if tail, _ := queue.PopTail(); tail != "a" {
+		t.Log("tail is", tail)
+		t.Fail()
+	}
+	if queue.Len() != 2 {
+		t.Log("length is not 2")
+		t.Fail()
+	}
+}
 func TestHeadTailDoublePriorityQueue(t *testing.T) {
 
 	var queue *DoublePriorityQueue[wrapper.Float32] = NewDoublePriorityQueue[wrapper.Float32]()
@@ -156,3 +175,18 @@ func TestEqualDoublePriorityQueue(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONDoublePriorityQueue(t *testing.T) {
+
+	var queue *DoublePriorityQueue[wrapper.Int] = NewDoublePriorityQueue[wrapper.Int](2, 3, 1)
+
+	data, err := json.Marshal(queue)
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	var result DoublePriorityQueue[wrapper.Int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(queue) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
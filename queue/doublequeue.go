@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/list"
 )
 
@@ -150,3 +151,41 @@ func (q *DoubleQueue[T]) String() string {
 	tail, _ := q.Tail()
 	return fmt.Sprintf("DoubleQueue[%v][%d, %v %v]", check[1:], q.Len(), head, tail)
 }
+
+// MarshalJSON returns the JSON encoding of q, which is an array containing its elements from the head to the tail.
+func (q *DoubleQueue[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(q.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of q with the ones decoded from the JSON array data.
+// The first element of the array becomes the head of the queue.
+func (q *DoubleQueue[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, q.decode)
+}
+
+// MarshalBinary returns the binary encoding of q, which contains its elements from the head to the tail.
+func (q *DoubleQueue[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(q.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of q with the ones decoded from the binary encoding data.
+// The first decoded element becomes the head of the queue.
+func (q *DoubleQueue[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, q.decode)
+}
+
+// GobEncode returns the binary encoding of q as [DoubleQueue.MarshalBinary].
+func (q *DoubleQueue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// GobDecode decodes data into q as [DoubleQueue.UnmarshalBinary].
+func (q *DoubleQueue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of q with objects.
+func (q *DoubleQueue[T]) decode(objects []T) error {
+	q.objects = list.NewLinkedListFromSlice(objects)
+	return nil
+}
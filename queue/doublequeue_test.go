@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -160,3 +161,18 @@ func TestEqualDoubleQueue(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONDoubleQueue(t *testing.T) {
+
+	var queue *DoubleQueue[int] = NewDoubleQueue(1, 2, 3)
+
+	data, err := json.Marshal(queue)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result DoubleQueue[int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(queue) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
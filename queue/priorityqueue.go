@@ -1,13 +1,14 @@
 package queue
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
-	"sort"
+	"slices"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/list"
-	"github.com/potex02/structures/tree"
 	"github.com/potex02/structures/util"
 	"github.com/potex02/structures/util/wrapper"
 )
@@ -15,36 +16,114 @@ import (
 var _ structures.Structure[wrapper.Int] = NewPriorityQueue[wrapper.Int]()
 var _ BaseQueue[wrapper.Int] = NewPriorityQueue[wrapper.Int]()
 
+// DefaultArity is the number of children of each node of the heap used by [NewPriorityQueue].
+const DefaultArity int = 4
+
 // PriorityQueue provides a generic priority queue which mantains the order of the elements.
-// It is implemented through an [tree.BinaryTree].
+// It is implemented through a d-ary max heap stored in a slice.
+//
+// Push and Pop run in O(log n) time, while Head runs in O(1) time.
+//
+// The order of the elements is determined by the Compare method if the queue is created with [NewPriorityQueue],
+// otherwise it is determined by the comparison function passed to [NewPriorityQueueFunc].
 //
 // It implements the interface [BaseQueue].
-type PriorityQueue[T util.Comparer] struct {
+type PriorityQueue[T any] struct {
 	// contains filtered or unexported fields
-	objects *tree.BinaryTree[T]
+	objects []T
+	d       int
+	compare func(i T, j T) int
 }
 
 // NewPriorityQueue returns a new [PriorityQueue] containing the elements c.
 // The head of the queue is the maximum element of c, while the tail is the minimum element.
 //
+// The arity of the heap is [DefaultArity].
+//
 // if no argument is passed, it will be created an empty [PriorityQueue].
 func NewPriorityQueue[T util.Comparer](c ...T) *PriorityQueue[T] {
 	return NewPriorityQueueFromSlice(c)
 }
 
 // NewPriorityQueueFromSlice returns a new [PriorityQueue] containing the elements of slice c.
+//
+// The heap is built in O(n) time.
 func NewPriorityQueueFromSlice[T util.Comparer](c []T) *PriorityQueue[T] {
-	return &PriorityQueue[T]{objects: tree.NewBinaryTreeFromSlice(c)}
+	return NewDAryPriorityQueueFromSlice(DefaultArity, c)
+}
+
+// NewDAryPriorityQueue returns a new [PriorityQueue] containing the elements c.
+//
+// d is the max number of children for a node of the heap.
+//
+// This function panics if d is less than 2.
+func NewDAryPriorityQueue[T util.Comparer](d int, c ...T) *PriorityQueue[T] {
+	return NewDAryPriorityQueueFromSlice(d, c)
+}
+
+// NewDAryPriorityQueueFromSlice returns a new [PriorityQueue] containing the elements of slice c.
+//
+// This function panics if d is less than 2.
+func NewDAryPriorityQueueFromSlice[T util.Comparer](d int, c []T) *PriorityQueue[T] {
+	return NewDAryPriorityQueueFromSliceFunc(d, util.Compare[T], c)
+}
+
+// NewPriorityQueueFunc returns a new [PriorityQueue] containing the elements c,
+// whose order is determined by the compare function.
+//
+// compare must return a negative number if i has a lower priority than j, a positive number if i has a higher priority than j
+// and zero if they have the same priority.
+//
+// if no extra argument is passed, it will be created an empty [PriorityQueue].
+func NewPriorityQueueFunc[T any](compare func(i T, j T) int, c ...T) *PriorityQueue[T] {
+	return NewPriorityQueueFromSliceFunc(compare, c)
+}
+
+// NewPriorityQueueFromSliceFunc returns a new [PriorityQueue] containing the elements of slice c,
+// whose order is determined by the compare function.
+func NewPriorityQueueFromSliceFunc[T any](compare func(i T, j T) int, c []T) *PriorityQueue[T] {
+	return NewDAryPriorityQueueFromSliceFunc(DefaultArity, compare, c)
+}
+
+// NewDAryPriorityQueueFunc returns a new [PriorityQueue] containing the elements c,
+// whose order is determined by the compare function.
+//
+// This function panics if d is less than 2.
+func NewDAryPriorityQueueFunc[T any](d int, compare func(i T, j T) int, c ...T) *PriorityQueue[T] {
+	return NewDAryPriorityQueueFromSliceFunc(d, compare, c)
+}
+
+// NewDAryPriorityQueueFromSliceFunc returns a new [PriorityQueue] containing the elements of slice c,
+// whose order is determined by the compare function.
+//
+// This function panics if d is less than 2.
+func NewDAryPriorityQueueFromSliceFunc[T any](d int, compare func(i T, j T) int, c []T) *PriorityQueue[T] {
+	if d < 2 {
+		panic(fmt.Sprintf("Cannot create a %v-ary heap", d))
+	}
+	queue := &PriorityQueue[T]{objects: slices.Clone(c), d: d, compare: compare}
+	if queue.objects == nil {
+		queue.objects = make([]T, 0)
+	}
+	for i := queue.parent(len(queue.objects) - 1); i >= 0; i-- {
+		queue.down(i)
+	}
+	return queue
 }
 
 // Len returns the length of q.
 func (q *PriorityQueue[T]) Len() int {
-	return q.objects.Len()
+	return len(q.objects)
 }
 
 // IsEmpty returns a bool which indicates if q is empty or not.
 func (q *PriorityQueue[T]) IsEmpty() bool {
-	return q.objects.IsEmpty()
+	return len(q.objects) == 0
+}
+
+// D returns the max number of children for a node of the heap.
+func (q *PriorityQueue[T]) D() int {
+	return q.d
 }
 
 // Head returns the maximum element of q.
@@ -56,11 +135,13 @@ func (q *PriorityQueue[T]) Head() (T, bool) {
 
 		return result, false
 	}
-	return q.objects.Root().Max().Element(), true
+	return q.objects[0], true
 }
 
 // Tail returns the minimum element element of q.
 // The method returns false if q is empty.
+//
+// Since the minimum is one of the leaves of the heap, this method runs in O(n) time.
 func (q *PriorityQueue[T]) Tail() (T, bool) {
 	if q.IsEmpty() {
 
@@ -68,40 +149,59 @@ func (q *PriorityQueue[T]) Tail() (T, bool) {
 
 		return result, false
 	}
-	return q.objects.Root().Min().Element(), true
+	result := q.objects[len(q.objects)-1]
+	for i := q.parent(len(q.objects)-1) + 1; i < len(q.objects); i++ {
+		if q.compare(q.objects[i], result) < 0 {
+			result = q.objects[i]
+		}
+	}
+	return result, true
 }
 
 // ToSlice returns a slice which contains all elements of q.
+// The elements are sorted from the head to the tail.
 func (q *PriorityQueue[T]) ToSlice() []T {
-	slice := q.objects.ToSlice()
-	sort.SliceStable(slice, func(i, j int) bool {
-		return i > j
+	slice := slices.Clone(q.objects)
+	if slice == nil {
+		slice = make([]T, 0)
+	}
+	slices.SortStableFunc(slice, func(i T, j T) int {
+		return q.compare(j, i)
 	})
 	return slice
 }
 
 // Push adds the elements e at q.
 func (q *PriorityQueue[T]) Push(e ...T) {
-	q.objects.Add(e...)
+	for _, i := range e {
+		q.objects = append(q.objects, i)
+		q.up(len(q.objects) - 1)
+	}
 }
 
 // Pop removes the maximun element from q and returns the removed element.
 // The method returns false if q is empty.
 func (q *PriorityQueue[T]) Pop() (T, bool) {
-	if q.IsEmpty() {
 
-		var result T
+	var result T
 
+	if q.IsEmpty() {
 		return result, false
 	}
-	result := q.objects.Root().Max().Element()
-	q.objects.Remove(result)
+	last := len(q.objects) - 1
+	result = q.objects[0]
+	q.objects[0] = q.objects[last]
+	q.objects[last] = *new(T)
+	q.objects = q.objects[:last]
+	if last > 0 {
+		q.down(0)
+	}
 	return result, true
 }
 
 // Clear removes all element from q.
 func (q *PriorityQueue[T]) Clear() {
-	q.objects.Clear()
+	q.objects = make([]T, 0)
 }
 
 // Equal returns true if q and st are both queues and their elements are equals.
@@ -135,16 +235,109 @@ func (q *PriorityQueue[T]) Compare(st any) int {
 
 // Hash returns the hash code of q.
 func (q *PriorityQueue[T]) Hash() uint64 {
-	return q.objects.Hash()
+	return list.NewArrayListFromStructure[T](q).Hash()
 }
 
 // String returns a rapresentation of q in the form of a string.
 func (q *PriorityQueue[T]) String() string {
 	check := reflect.TypeOf(new(T)).String()
 	if q.IsEmpty() {
-		return fmt.Sprintf("PriorityQueue[%v][%d, ]", check[1:], q.objects.Len())
+		return fmt.Sprintf("PriorityQueue[%v][%d, ]", check[1:], q.Len())
 	}
 	head, _ := q.Head()
 	tail, _ := q.Tail()
-	return fmt.Sprintf("PriorityQueue[%v][%d, %v %v]", check[1:], q.objects.Len(), head, tail)
+	return fmt.Sprintf("PriorityQueue[%v][%d, %v %v]", check[1:], q.Len(), head, tail)
+}
+
+// MarshalJSON returns the JSON encoding of q, which is an array containing its elements from the head to the tail.
+func (q *PriorityQueue[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(q.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of q with the ones decoded from the JSON array data.
+// The arity and the comparison function of q are kept.
+//
+// If q is the zero value, the elements are ordered by their Compare method and the arity of the heap is [DefaultArity].
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (q *PriorityQueue[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, q.decode)
+}
+
+// MarshalBinary returns the binary encoding of q, which contains its elements from the head to the tail.
+func (q *PriorityQueue[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(q.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of q with the ones decoded from the binary encoding data.
+// The arity and the comparison function of q are kept.
+//
+// If q is the zero value, the elements are ordered by their Compare method and the arity of the heap is [DefaultArity].
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (q *PriorityQueue[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, q.decode)
+}
+
+// GobEncode returns the binary encoding of q as [PriorityQueue.MarshalBinary].
+func (q *PriorityQueue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// GobDecode decodes data into q as [PriorityQueue.UnmarshalBinary].
+func (q *PriorityQueue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of q with objects.
+func (q *PriorityQueue[T]) decode(objects []T) error {
+	d, compare := q.d, q.compare
+	if compare == nil {
+		if compare = codec.Compare[T](); compare == nil {
+			return errors.New("Cannot decode a PriorityQueue whose elements do not implement util.Comparer")
+		}
+		d = DefaultArity
+	}
+	*q = *NewDAryPriorityQueueFromSliceFunc(d, compare, objects)
+	return nil
+}
+
+func (q *PriorityQueue[T]) parent(index int) int {
+	if index <= 0 {
+		return -1
+	}
+	return (index - 1) / q.d
+}
+
+func (q *PriorityQueue[T]) up(index int) {
+	element := q.objects[index]
+	for index > 0 {
+		parent := q.parent(index)
+		if q.compare(element, q.objects[parent]) <= 0 {
+			break
+		}
+		q.objects[index] = q.objects[parent]
+		index = parent
+	}
+	q.objects[index] = element
+}
+
+func (q *PriorityQueue[T]) down(index int) {
+	element := q.objects[index]
+	for {
+		first := index*q.d + 1
+		if first >= len(q.objects) {
+			break
+		}
+		largest := first
+		for i := first + 1; i < first+q.d && i < len(q.objects); i++ {
+			if q.compare(q.objects[i], q.objects[largest]) > 0 {
+				largest = i
+			}
+		}
+		if q.compare(q.objects[largest], element) <= 0 {
+			break
+		}
+		q.objects[index] = q.objects[largest]
+		index = largest
+	}
+	q.objects[index] = element
 }
@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -38,6 +39,23 @@ func TestPriorityQueueFromSlice(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestPriorityQueueFunc(t *testing.T) {
+
+	var queue *PriorityQueue[int] = NewPriorityQueueFunc(func(i int, j int) int { return j - i }, 4, -1, 7, 2)
+
+	if head, _ := queue.Head(); head != -1 {
+		t.Log("head is", head)
+		t.Fail()
+	}
+	if tail, _ := queue.Tail(); tail != 7 {
+		t.Log("tail is", tail)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(queue.ToSlice(), []int{-1, 2, 4, 7}) {
+		t.Log("queue objects are", queue.ToSlice())
+		t.Fail()
+	}
+}
 func TestHeadTailPriorityQueue(t *testing.T) {
 
 	var queue *PriorityQueue[wrapper.Float32] = NewPriorityQueue[wrapper.Float32]()
@@ -133,3 +151,72 @@ func TestEqualPriorityQueue(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestDAryPriorityQueue(t *testing.T) {
+
+	var queue *PriorityQueue[wrapper.Int] = NewDAryPriorityQueue[wrapper.Int](2, 5, -1, 8, 3)
+
+	if queue.D() != 2 {
+		t.Log("d is", queue.D())
+		t.Fail()
+	}
+	if !reflect.DeepEqual(queue.ToSlice(), []wrapper.Int{8, 5, 3, -1}) {
+		t.Log("queue objects are", queue.ToSlice())
+		t.Fail()
+	}
+	defer func() {
+		if recover() == nil {
+			t.Log("1-ary heap created")
+			t.Fail()
+		}
+	}()
+	NewDAryPriorityQueue[wrapper.Int](1)
+}
+func TestSortedPriorityQueue(t *testing.T) {
+
+	var queue *PriorityQueue[wrapper.Int] = NewPriorityQueue[wrapper.Int]()
+
+	for i := 0; i != 10000; i++ {
+		queue.Push(wrapper.Int(i))
+	}
+	if tail, _ := queue.Tail(); tail != 0 {
+		t.Log("tail is", tail)
+		t.Fail()
+	}
+	for i := 9999; i >= 0; i-- {
+		if e, ok := queue.Pop(); !ok || e != wrapper.Int(i) {
+			t.Log("e is", e)
+			t.FailNow()
+		}
+	}
+	if !queue.IsEmpty() {
+		t.Log("the queue is not empty")
+		t.Fail()
+	}
+}
+func TestJSONPriorityQueue(t *testing.T) {
+
+	var queue *PriorityQueue[wrapper.Int] = NewPriorityQueue[wrapper.Int](2, 3, 1)
+
+	data, err := json.Marshal(queue)
+	if err != nil || string(data) != "[3,2,1]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result PriorityQueue[wrapper.Int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(queue) || result.d != DefaultArity {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	min := NewDAryPriorityQueueFunc(4, func(i wrapper.Int, j wrapper.Int) int {
+		return j.Compare(i)
+	})
+	if err := json.Unmarshal(data, min); err != nil || !reflect.DeepEqual(min.ToSlice(), []wrapper.Int{1, 2, 3}) || min.d != 4 {
+		t.Log("result is", min, "err is", err)
+		t.Fail()
+	}
+	var zero PriorityQueue[[]int]
+	if err := json.Unmarshal([]byte("[[1]]"), &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
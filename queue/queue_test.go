@@ -1,10 +1,16 @@
 package queue
 
 import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
 	"reflect"
 	"testing"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
 )
 
 func TestNewQueue(t *testing.T) {
@@ -132,3 +138,67 @@ func TestEqualQueue(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONQueue(t *testing.T) {
+
+	var queue *Queue[int] = NewQueue(1, 2, 3)
+
+	data, err := json.Marshal(queue)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result Queue[int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(queue) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	if head, ok := result.Head(); !ok || head != 1 {
+		t.Log("head is", head)
+		t.Fail()
+	}
+}
+func TestBinary(t *testing.T) {
+
+	var tests []binaryTest = []binaryTest{
+		{"Queue", NewQueue[wrapper.Int](1, 2, 3), NewQueue[wrapper.Int](4), &Queue[wrapper.Int]{}},
+		{"DoubleQueue", NewDoubleQueue[wrapper.Int](1, 2, 3), NewDoubleQueue[wrapper.Int](4), &DoubleQueue[wrapper.Int]{}},
+		{"ConcurrentQueue", NewConcurrentQueue[wrapper.Int](1, 2, 3), NewConcurrentQueue[wrapper.Int](4), &ConcurrentQueue[wrapper.Int]{}},
+		{"PriorityQueue", NewPriorityQueue[wrapper.Int](2, 3, 1), NewPriorityQueue[wrapper.Int](4), &PriorityQueue[wrapper.Int]{}},
+		{"DoublePriorityQueue", NewDoublePriorityQueue[wrapper.Int](2, 3, 1), NewDoublePriorityQueue[wrapper.Int](4), &DoublePriorityQueue[wrapper.Int]{}},
+		{"BlockingQueue", NewBlockingQueue[wrapper.Int](3, 1, 2, 3), NewBlockingQueue[wrapper.Int](3, 4), NewBlockingQueue[wrapper.Int](3)},
+	}
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
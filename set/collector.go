@@ -0,0 +1,47 @@
+package set
+
+import (
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util"
+)
+
+// ToTreeSet returns a [structures.Collector] which collects the elements into a [TreeSet].
+func ToTreeSet[T util.Comparer]() structures.Collector[T, *TreeSet[T]] {
+	return ToTreeSetFunc(util.Compare[T])
+}
+
+// ToTreeSetFunc returns a [structures.Collector] which collects the elements into a [TreeSet] ordered by the compare function.
+func ToTreeSetFunc[T any](compare func(i T, j T) int) structures.Collector[T, *TreeSet[T]] {
+	return structures.NewCollector(func() *TreeSet[T] {
+		return NewTreeSetFunc(compare)
+	}, func(result *TreeSet[T], element T) *TreeSet[T] {
+		result.Add(element)
+		return result
+	}, func(result *TreeSet[T]) *TreeSet[T] {
+		return result
+	})
+}
+
+// ToHashSet returns a [structures.Collector] which collects the elements into a [HashSet].
+func ToHashSet[T util.Hasher]() structures.Collector[T, *HashSet[T]] {
+	return structures.NewCollector(func() *HashSet[T] {
+		return NewHashSet[T]()
+	}, func(result *HashSet[T], element T) *HashSet[T] {
+		result.Add(element)
+		return result
+	}, func(result *HashSet[T]) *HashSet[T] {
+		return result
+	})
+}
+
+// ToMultiHashSet returns a [structures.Collector] which collects the elements into a [MultiHashSet].
+func ToMultiHashSet[T util.Hasher]() structures.Collector[T, *MultiHashSet[T]] {
+	return structures.NewCollector(func() *MultiHashSet[T] {
+		return NewMultiHashSet[T]()
+	}, func(result *MultiHashSet[T], element T) *MultiHashSet[T] {
+		result.Add(element)
+		return result
+	}, func(result *MultiHashSet[T]) *MultiHashSet[T] {
+		return result
+	})
+}
@@ -0,0 +1,43 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestToTreeSet(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewHashSet[wrapper.Int](3, 1, 2).Stream()
+
+	result := CollectTo(stream, ToTreeSet[wrapper.Int]())
+	if first, _ := result.First(); first != 1 || !result.Equal(NewTreeSet[wrapper.Int](1, 2, 3)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	result = CollectTo(stream, ToTreeSetFunc(func(i wrapper.Int, j wrapper.Int) int {
+		return j.Compare(i)
+	}))
+	if first, _ := result.First(); first != 3 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestToHashSet(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewMultiTreeSet[wrapper.Int](3, 1, 2, 1).Stream()
+
+	if result := CollectTo(stream, ToHashSet[wrapper.Int]()); !result.Equal(NewHashSet[wrapper.Int](1, 2, 3)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestToMultiHashSet(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewMultiTreeSet[wrapper.Int](3, 1, 2, 1).Stream()
+
+	if result := CollectTo(stream, ToMultiHashSet[wrapper.Int]()); result.Count(1) != 2 || !result.Equal(NewMultiHashSet[wrapper.Int](1, 1, 2, 3)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
@@ -0,0 +1,378 @@
+package set
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[wrapper.Int] = NewDisjointSet[wrapper.Int]()
+
+// DisjointSet provides a generic union-find structure, which partitions its elements in disjoint sets.
+// Every set is identified by one of its elements, called representative.
+//
+// The elements are found through a [table.HashTable] and the sets are stored in a forest,
+// where Find uses path compression and Union uses union by rank.
+// This way Find, Union and Connected run in almost O(1) amortized time.
+//
+// The elements are iterated in the order in which they are added.
+//
+// It implements the interface [structures.Structure].
+type DisjointSet[T util.Hasher] struct {
+	// contains filtered or unexported fields
+	indexes  *table.HashTable[T, int]
+	elements []T
+	parent   []int
+	rank     []int
+	count    int
+}
+
+// NewDisjointSet returns a new [DisjointSet] containing the elements c, each one in its own set.
+//
+// if no argument is passed, it will be created an empty [DisjointSet].
+func NewDisjointSet[T util.Hasher](c ...T) *DisjointSet[T] {
+	return NewDisjointSetFromSlice(c)
+}
+
+// NewDisjointSetFromSlice returns a new [DisjointSet] containing the elements of slice c, each one in its own set.
+func NewDisjointSetFromSlice[T util.Hasher](c []T) *DisjointSet[T] {
+	set := &DisjointSet[T]{indexes: table.NewHashTable[T, int]()}
+	set.MakeSet(c...)
+	return set
+}
+
+// Len returns the number of elements of s.
+func (s *DisjointSet[T]) Len() int {
+	return len(s.elements)
+}
+
+// IsEmpty returns a bool which indicates if s is empty or not.
+func (s *DisjointSet[T]) IsEmpty() bool {
+	return len(s.elements) == 0
+}
+
+// SetCount returns the number of disjoint sets of s.
+func (s *DisjointSet[T]) SetCount() int {
+	return s.count
+}
+
+// Contains returns if e is present in s.
+func (s *DisjointSet[T]) Contains(e T) bool {
+	return s.indexes != nil && s.indexes.ContainsKey(e)
+}
+
+// ToSlice returns a slice which contains all elements of s, in the order in which they are added.
+func (s *DisjointSet[T]) ToSlice() []T {
+	slice := make([]T, len(s.elements))
+	copy(slice, s.elements)
+	return slice
+}
+
+// MakeSet adds the elements e at s, each one in a new set.
+// The elements which are already present are ignored.
+func (s *DisjointSet[T]) MakeSet(e ...T) {
+	if s.indexes == nil {
+		s.Clear()
+	}
+	for _, i := range e {
+		if s.indexes.ContainsKey(i) {
+			continue
+		}
+		s.indexes.Put(i, len(s.elements))
+		s.parent = append(s.parent, len(s.elements))
+		s.elements = append(s.elements, i)
+		s.rank = append(s.rank, 0)
+		s.count++
+	}
+}
+
+// Find returns the representative of the set containing e.
+// The method returns false if e is not present.
+//
+// The elements visited while searching the representative are linked directly to it,
+// so the following searches are faster.
+func (s *DisjointSet[T]) Find(e T) (T, bool) {
+
+	var result T
+
+	index, ok := s.index(e)
+	if !ok {
+		return result, false
+	}
+	return s.elements[s.find(index)], true
+}
+
+// Union merges the sets containing i and j, adding i and j at s if they are not present.
+// The representative of the resulting set is the one of the set with the highest rank.
+//
+// It returns true if i and j were in different sets.
+func (s *DisjointSet[T]) Union(i T, j T) bool {
+	s.MakeSet(i, j)
+	first, _ := s.indexes.Get(i)
+	second, _ := s.indexes.Get(j)
+	return s.union(first, second)
+}
+
+// Connected returns true if i and j are present and in the same set.
+func (s *DisjointSet[T]) Connected(i T, j T) bool {
+	first, ok := s.index(i)
+	if !ok {
+		return false
+	}
+	second, ok := s.index(j)
+	if !ok {
+		return false
+	}
+	return s.find(first) == s.find(second)
+}
+
+// Groups returns a [list.List] which contains the disjoint sets of s, each one as a [HashSet].
+// The sets are ordered by the first of their elements which has been added.
+func (s *DisjointSet[T]) Groups() list.List[Set[T]] {
+	groups := list.NewArrayList[Set[T]]()
+	for _, i := range s.groups() {
+		groups.Add(NewHashSetFromSlice(i))
+	}
+	return groups
+}
+
+// Each executes fun for all elements of s, in the order in which they are added.
+func (s *DisjointSet[T]) Each(fun func(element T)) {
+	for i := range s.RangeIter() {
+		fun(i)
+	}
+}
+
+// Clear removes all element from s.
+func (s *DisjointSet[T]) Clear() {
+	s.indexes = table.NewHashTable[T, int]()
+	s.elements = nil
+	s.parent = nil
+	s.rank = nil
+	s.count = 0
+}
+
+// RangeIter returns a function that allows to iterate a [DisjointSet] using the range keyword.
+//
+//	for i := range s.RangeIter() {
+//		// Code
+//	}
+//
+// It doesn't allow to remove elements during the iteration.
+func (s *DisjointSet[T]) RangeIter() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for _, i := range s.elements {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns true if s and st are both [DisjointSet], have the same elements and partition them in the same sets.
+// In any other case, it returns false.
+func (s *DisjointSet[T]) Equal(st any) bool {
+	set, ok := st.(*DisjointSet[T])
+	if !ok || s == nil || set == nil || s.Len() != set.Len() || s.count != set.count {
+		return false
+	}
+	for i, j := range s.elements {
+		if !set.Contains(j) || !set.Connected(j, s.elements[s.find(i)]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare returns 0 if s and st have the same length,
+// -1 if s is shorten than st,
+// 1 if s is longer than st,
+// -2 if st is not a [DisjointSet] or if one between s and st is nil.
+func (s *DisjointSet[T]) Compare(st any) int {
+	set, ok := st.(*DisjointSet[T])
+	if ok && s != nil && set != nil {
+		if s.Len() < set.Len() {
+			return -1
+		}
+		if s.Len() > set.Len() {
+			return 1
+		}
+		return 0
+	}
+	return -2
+}
+
+// Hash returns the hash code of s.
+// It depends only on the sets, so it does not change with the order of the elements and of the unions.
+func (s *DisjointSet[T]) Hash() uint64 {
+	var result uint64
+	for _, i := range s.groups() {
+		var hash uint64
+		for _, j := range i {
+			hash += j.Hash()
+		}
+		result += hash * hash * util.Prime
+	}
+	return result
+}
+
+// Copy returns a [DisjointSet] containing a copy of the elements of s, partitioned in the same sets.
+//
+// This method uses [util.Copy] to make copies of the elements.
+func (s *DisjointSet[T]) Copy() *DisjointSet[T] {
+	result := NewDisjointSet[T]()
+	for _, i := range s.elements {
+		result.MakeSet(util.Copy(i))
+	}
+	for i := range s.elements {
+		result.union(i, s.find(i))
+	}
+	return result
+}
+
+// String returns a rapresentation of s in the form of a string.
+func (s *DisjointSet[T]) String() string {
+	check := reflect.TypeOf(new(T)).String()
+	return fmt.Sprintf("DisjointSet[%v]%v", check[1:], s.groups())
+}
+
+// MarshalJSON returns the JSON encoding of s, which is an array containing its sets as arrays.
+func (s *DisjointSet[T]) MarshalJSON() ([]byte, error) {
+	groups := s.groups()
+	if groups == nil {
+		groups = make([][]T, 0)
+	}
+	return json.Marshal(groups)
+}
+
+// UnmarshalJSON replaces the elements of s with the ones decoded from the JSON array data,
+// which contains the sets as arrays.
+//
+// The method returns an error if an element is contained in more sets.
+func (s *DisjointSet[T]) UnmarshalJSON(data []byte) error {
+	var objects [][]T
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return err
+	}
+	result := NewDisjointSet[T]()
+	for _, i := range objects {
+		for _, j := range i {
+			if result.Contains(j) {
+				return errors.New("Cannot decode a DisjointSet containing " + fmt.Sprint(j) + " in more sets")
+			}
+			result.Union(i[0], j)
+		}
+	}
+	*s = *result
+	return nil
+}
+
+// MarshalBinary returns the binary encoding of s, which contains its elements,
+// each one followed by the position of its set.
+func (s *DisjointSet[T]) MarshalBinary() ([]byte, error) {
+	positions := make(map[int]int, s.count)
+	return codec.MarshalBinaryEntries(s.Len(), func(yield func(T, int) bool) {
+		for i, j := range s.elements {
+			root := s.find(i)
+			if _, ok := positions[root]; !ok {
+				positions[root] = len(positions)
+			}
+			if !yield(j, positions[root]) {
+				return
+			}
+		}
+	})
+}
+
+// UnmarshalBinary replaces the elements of s with the ones decoded from the binary encoding data.
+func (s *DisjointSet[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, s.decode)
+}
+
+// GobEncode returns the binary encoding of s as [DisjointSet.MarshalBinary].
+func (s *DisjointSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [DisjointSet.UnmarshalBinary].
+func (s *DisjointSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// decode replaces the sets of s with the elements which have the same position.
+func (s *DisjointSet[T]) decode(elements []T, positions []int) error {
+	result := NewDisjointSet[T]()
+	first := make(map[int]T)
+	for i, j := range elements {
+		if result.Contains(j) {
+			return errors.New("Cannot decode a DisjointSet containing " + fmt.Sprint(j) + " in more sets")
+		}
+		if _, ok := first[positions[i]]; !ok {
+			first[positions[i]] = j
+		}
+		result.Union(first[positions[i]], j)
+	}
+	*s = *result
+	return nil
+}
+
+func (s *DisjointSet[T]) index(e T) (int, bool) {
+	if s.indexes == nil {
+		return 0, false
+	}
+	return s.indexes.Get(e)
+}
+
+// find returns the position of the representative of the element at index, compressing its path.
+func (s *DisjointSet[T]) find(index int) int {
+	root := index
+	for s.parent[root] != root {
+		root = s.parent[root]
+	}
+	for s.parent[index] != root {
+		s.parent[index], index = root, s.parent[index]
+	}
+	return root
+}
+
+// union merges the sets of the elements at i and j and returns true if they were different.
+func (s *DisjointSet[T]) union(i int, j int) bool {
+	first, second := s.find(i), s.find(j)
+	if first == second {
+		return false
+	}
+	if s.rank[first] < s.rank[second] {
+		first, second = second, first
+	}
+	s.parent[second] = first
+	if s.rank[first] == s.rank[second] {
+		s.rank[first]++
+	}
+	s.count--
+	return true
+}
+
+// groups returns the sets of s, ordered by their first element.
+func (s *DisjointSet[T]) groups() [][]T {
+	var groups [][]T
+	positions := make(map[int]int, s.count)
+	for i, j := range s.elements {
+		root := s.find(i)
+		position, ok := positions[root]
+		if !ok {
+			position = len(groups)
+			positions[root] = position
+			groups = append(groups, nil)
+		}
+		groups[position] = append(groups[position], j)
+	}
+	return groups
+}
@@ -0,0 +1,202 @@
+package set
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewDisjointSet(t *testing.T) {
+
+	var set structures.Structure[wrapper.Int] = NewDisjointSet[wrapper.Int]()
+	var other *DisjointSet[wrapper.Int] = NewDisjointSetFromSlice([]wrapper.Int{1, 2, 1, 3})
+
+	if set == nil {
+		t.Log("set is nil")
+		t.Fail()
+	}
+	if set.Len() != 0 {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+	if other.Len() != 3 || other.SetCount() != 3 {
+		t.Log("length is", other.Len(), "count is", other.SetCount())
+		t.Fail()
+	}
+	if !reflect.DeepEqual(other.ToSlice(), []wrapper.Int{1, 2, 3}) {
+		t.Log("elements are", other.ToSlice())
+		t.Fail()
+	}
+}
+func TestMakeSetDisjointSet(t *testing.T) {
+
+	var set *DisjointSet[wrapper.String] = NewDisjointSet[wrapper.String]("a")
+
+	set.MakeSet("b", "a", "c")
+	if set.Len() != 3 || set.SetCount() != 3 {
+		t.Log("length is", set.Len(), "count is", set.SetCount())
+		t.Fail()
+	}
+	if !set.Contains("c") || set.Contains("d") {
+		t.Log("contains is wrong")
+		t.Fail()
+	}
+	if e, ok := set.Find("b"); !ok || e != "b" {
+		t.Log("representative is", e)
+		t.Fail()
+	}
+	if e, ok := set.Find("d"); ok || e != "" {
+		t.Log("representative is", e)
+		t.Fail()
+	}
+}
+func TestUnionDisjointSet(t *testing.T) {
+
+	var set *DisjointSet[wrapper.Int] = NewDisjointSet[wrapper.Int](1, 2, 3, 4, 5)
+
+	if !set.Union(1, 2) || !set.Union(3, 4) || !set.Union(2, 4) {
+		t.Log("union returns false")
+		t.Fail()
+	}
+	if set.Union(1, 3) {
+		t.Log("union returns true")
+		t.Fail()
+	}
+	if set.SetCount() != 2 {
+		t.Log("count is", set.SetCount())
+		t.Fail()
+	}
+	if !set.Connected(1, 4) || set.Connected(1, 5) || set.Connected(1, 6) {
+		t.Log("connected is wrong")
+		t.Fail()
+	}
+	first, _ := set.Find(1)
+	for _, i := range []wrapper.Int{2, 3, 4} {
+		if e, _ := set.Find(i); e != first {
+			t.Log("representative of", i, "is", e)
+			t.Fail()
+		}
+	}
+	if !set.Union(6, 5) || set.Len() != 6 || set.SetCount() != 2 {
+		t.Log("length is", set.Len(), "count is", set.SetCount())
+		t.Fail()
+	}
+}
+func TestFindDisjointSet(t *testing.T) {
+
+	var set *DisjointSet[wrapper.Int] = NewDisjointSet[wrapper.Int]()
+
+	for i := wrapper.Int(1); i != 100; i++ {
+		set.Union(i-1, i)
+	}
+	root, _ := set.Find(99)
+	for i := range set.parent {
+		if set.elements[set.parent[i]] != root {
+			t.Log("parent of", set.elements[i], "is", set.elements[set.parent[i]])
+			t.Fail()
+		}
+	}
+	if set.SetCount() != 1 {
+		t.Log("count is", set.SetCount())
+		t.Fail()
+	}
+}
+func TestGroupsDisjointSet(t *testing.T) {
+
+	var set *DisjointSet[wrapper.Int] = NewDisjointSet[wrapper.Int](1, 2, 3, 4, 5)
+
+	set.Union(4, 2)
+	set.Union(5, 1)
+	groups := set.Groups()
+	if groups.Len() != 3 {
+		t.Log("groups are", groups)
+		t.Fail()
+	}
+	for i, j := range []Set[wrapper.Int]{NewHashSet[wrapper.Int](1, 5), NewHashSet[wrapper.Int](2, 4), NewHashSet[wrapper.Int](3)} {
+		if group, _ := groups.Get(i); !group.Equal(j) {
+			t.Log("group is", group)
+			t.Fail()
+		}
+	}
+	if set.String() != "DisjointSet[wrapper.Int][[1 5] [2 4] [3]]" {
+		t.Log("string is", set)
+		t.Fail()
+	}
+}
+func TestEqualDisjointSet(t *testing.T) {
+
+	var set *DisjointSet[wrapper.Int] = NewDisjointSet[wrapper.Int](1, 2, 3, 4)
+	var other *DisjointSet[wrapper.Int] = NewDisjointSet[wrapper.Int](4, 3, 2, 1)
+
+	set.Union(1, 2)
+	set.Union(2, 3)
+	other.Union(3, 1)
+	other.Union(2, 1)
+	if !set.Equal(other) || set.Hash() != other.Hash() {
+		t.Log("sets are not equal")
+		t.Fail()
+	}
+	if !set.Equal(set.Copy()) {
+		t.Log("copy is not equal")
+		t.Fail()
+	}
+	other = NewDisjointSet[wrapper.Int](1, 2, 3, 4)
+	other.Union(1, 2)
+	other.Union(3, 4)
+	if set.Equal(other) {
+		t.Log("sets are equal")
+		t.Fail()
+	}
+	if set.Equal(NewHashSet[wrapper.Int](1, 2, 3, 4)) {
+		t.Log("sets are equal")
+		t.Fail()
+	}
+}
+func TestCompareDisjointSet(t *testing.T) {
+
+	var set *DisjointSet[wrapper.Int] = NewDisjointSet[wrapper.Int](1, 2)
+
+	if set.Compare(NewDisjointSet[wrapper.Int](3, 4)) != 0 {
+		t.Log("compare is not 0")
+		t.Fail()
+	}
+	if set.Compare(NewDisjointSet[wrapper.Int](1)) != 1 {
+		t.Log("compare is not 1")
+		t.Fail()
+	}
+	if set.Compare(NewDisjointSet[wrapper.Int](1, 2, 3)) != -1 {
+		t.Log("compare is not -1")
+		t.Fail()
+	}
+	if set.Compare(NewHashSet[wrapper.Int](1, 2)) != -2 {
+		t.Log("compare is not -2")
+		t.Fail()
+	}
+}
+func TestJSONDisjointSet(t *testing.T) {
+
+	var set *DisjointSet[wrapper.Int] = NewDisjointSet[wrapper.Int](1, 2, 3)
+
+	set.Union(3, 1)
+	data, err := json.Marshal(set)
+	if err != nil || string(data) != `[[1,3],[2]]` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result DisjointSet[wrapper.Int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(set) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	if data, err := json.Marshal(NewDisjointSet[wrapper.Int]()); err != nil || string(data) != `[]` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`[[1,2],[2]]`), &result); err == nil || !result.Equal(set) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/table"
 	"github.com/potex02/structures/util"
 	"github.com/potex02/structures/util/wrapper"
@@ -14,7 +15,8 @@ var _ structures.Structure[wrapper.Int] = NewHashSet[wrapper.Int]()
 var _ BaseSet[wrapper.Int] = NewHashSet[wrapper.Int]()
 var _ Set[wrapper.Int] = NewHashSet[wrapper.Int]()
 
-// HashSet provides a generic set implemented through a [table.HashTable].
+// HashSet provides a generic set implemented through a [table.HashTable],
+// or through a [table.OpenHashTable] if it is created with [NewOpenHashSet].
 //
 // It implements the interface [Set].
 type HashSet[T util.Hasher] struct {
@@ -38,6 +40,30 @@ func NewHashSetFromSlice[T util.Hasher](c []T) *HashSet[T] {
 	return set
 }
 
+// NewOpenHashSet returns a new [HashSet] implemented through a [table.OpenHashTable] containing the elements c.
+//
+// if no argument is passed, it will be created an empty [HashSet].
+func NewOpenHashSet[T util.Hasher](c ...T) *HashSet[T] {
+	return NewOpenHashSetFromSlice(c)
+}
+
+// NewOpenHashSetFromSlice returns a new [HashSet] implemented through a [table.OpenHashTable] containing the elements of slice c.
+func NewOpenHashSetFromSlice[T util.Hasher](c []T) *HashSet[T] {
+	return NewOpenHashSetLoadFactor(table.DefaultLoadFactor, c...)
+}
+
+// NewOpenHashSetLoadFactor returns a new [HashSet] implemented through a [table.OpenHashTable]
+// with the load factor loadFactor containing the elements c.
+//
+// This function panics if loadFactor is not greater than 0 and less than 1.
+func NewOpenHashSetLoadFactor[T util.Hasher](loadFactor float64, c ...T) *HashSet[T] {
+	set := &HashSet[T]{objects: table.NewOpenHashTableLoadFactor[T, uint8](loadFactor)}
+	if len(c) != 0 {
+		set.AddSlice(c)
+	}
+	return set
+}
+
 // Len returns the length of s.
 func (s *HashSet[T]) Len() int {
 	return s.objects.Len()
@@ -88,7 +114,7 @@ func (s *HashSet[T]) Each(fun func(element T)) {
 
 // Stream returns a [Stream] rapresenting s.
 func (s *HashSet[T]) Stream() *Stream[T] {
-	return NewStream[T](s, reflect.ValueOf(NewHashSet[T]))
+	return NewStream[T](s, reflect.ValueOf(s.empty))
 }
 
 // Clear removes all element from s.
@@ -168,11 +194,12 @@ func (s *HashSet[T]) Hash() uint64 {
 }
 
 // Copy returns a set containing a copy of the elements of s.
-// The result of this method is of type [Set], but the effective table which is created is a [HashSet].
+// The result of this method is of type [Set], but the effective table which is created is a [HashSet]
+// implemented through the same type of table of s.
 //
 // This method uses [util.Copy] to make copies of the elements.
 func (s *HashSet[T]) Copy() Set[T] {
-	result := NewHashSet[T]()
+	result := s.empty()
 	s.Each(func(element T) {
 		result.Add(util.Copy(element))
 	})
@@ -184,3 +211,50 @@ func (s *HashSet[T]) String() string {
 	check := reflect.TypeOf(new(T)).String()
 	return fmt.Sprintf("HashSet[%v]%v", check[1:], s.ToSlice())
 }
+
+// MarshalJSON returns the JSON encoding of s, which is an array containing its elements.
+func (s *HashSet[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of s with the ones decoded from the JSON array data.
+func (s *HashSet[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, s.decode)
+}
+
+// MarshalBinary returns the binary encoding of s, which contains its elements.
+func (s *HashSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(s.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of s with the ones decoded from the binary encoding data.
+func (s *HashSet[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, s.decode)
+}
+
+// GobEncode returns the binary encoding of s as [HashSet.MarshalBinary].
+func (s *HashSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [HashSet.UnmarshalBinary].
+func (s *HashSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of s with objects.
+func (s *HashSet[T]) decode(objects []T) error {
+	if s.objects == nil {
+		s.objects = table.NewHashTable[T, uint8]()
+	}
+	s.Clear()
+	s.AddSlice(objects)
+	return nil
+}
+
+func (s *HashSet[T]) empty() *HashSet[T] {
+	if objects, ok := s.objects.(*table.OpenHashTable[T, uint8]); ok {
+		return NewOpenHashSetLoadFactor[T](objects.LoadFactor())
+	}
+	return NewHashSet[T]()
+}
@@ -1,9 +1,11 @@
 package set
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/table"
 	"github.com/potex02/structures/util/wrapper"
 )
 
@@ -33,6 +35,34 @@ func TestNewHashSetFromSlice(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestNewOpenHashSet(t *testing.T) {
+
+	var set *HashSet[wrapper.Int] = NewOpenHashSet[wrapper.Int](1, 4, -5, 2, 1)
+
+	if set.Len() != 4 {
+		t.Log("length is not 4")
+		t.Fail()
+	}
+	if !set.Contains(-5) || set.Contains(3) {
+		t.Log("set is", set)
+		t.Fail()
+	}
+	for i := set.Iter(); !i.End(); {
+		if i.Element() < 0 {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+		}
+	}
+	if !set.Equal(NewHashSet[wrapper.Int](1, 2, 4)) {
+		t.Log("set is", set)
+		t.Fail()
+	}
+	if _, ok := set.Copy().(*HashSet[wrapper.Int]).objects.(*table.OpenHashTable[wrapper.Int, uint8]); !ok {
+		t.Log("copy is not implemented through an OpenHashTable")
+		t.Fail()
+	}
+}
 func TestContainsHashSet(t *testing.T) {
 
 	var set Set[wrapper.Int] = NewHashSet[wrapper.Int](4, -5, 2, 1)
@@ -108,6 +138,35 @@ func TestEqualHashSet(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONHashSet(t *testing.T) {
+
+	var set *HashSet[wrapper.String] = NewHashSet[wrapper.String]("a", "b", "c")
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	var objects []string
+	if err := json.Unmarshal(data, &objects); err != nil || len(objects) != 3 {
+		t.Log("data is", string(data))
+		t.Fail()
+	}
+	var result HashSet[wrapper.String]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(set) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	open := NewOpenHashSet[wrapper.String]("d")
+	if err := json.Unmarshal(data, open); err != nil || !open.Equal(set) {
+		t.Log("result is", open, "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`{"a": 1}`), open); err == nil || !open.Equal(set) {
+		t.Log("result is", open, "err is", err)
+		t.Fail()
+	}
+}
 
 type test struct {
 	n1, n2 int
@@ -1,6 +1,7 @@
 package set
 
 import (
+	"github.com/potex02/structures"
 	"github.com/potex02/structures/table"
 	"github.com/potex02/structures/tree"
 	"github.com/potex02/structures/util"
@@ -9,10 +10,14 @@ import (
 
 var _ Iterator[wrapper.Int] = NewHashSetIterator[wrapper.Int](NewHashSet[wrapper.Int]())
 var _ Iterator[wrapper.Int] = NewTreeSetIterator[wrapper.Int](NewTreeSet[wrapper.Int]())
+var _ Iterator[wrapper.Int] = NewTreeSubSetIterator[wrapper.Int](NewTreeSet[wrapper.Int]().TailSet(0, true))
+var _ Iterator[wrapper.Int] = NewUnmodifiableSetIterator[wrapper.Int](NewHashSet[wrapper.Int]().Iter())
 var _ Iterator[wrapper.Int] = &endIterator[wrapper.Int]{}
+var _ IndexedIterator[wrapper.Int] = &TreeSetIterator[wrapper.Int]{}
+var _ IndexedIterator[wrapper.Int] = &TreeSubSetIterator[wrapper.Int]{}
 
 // Iterator provides the methods to iterate over a [Set] or a [MultiSet].
-type Iterator[T util.Comparer] interface {
+type Iterator[T any] interface {
 	// Elements returns the element of the iterator.
 	Element() T
 	// Remove removes the element from the set and returns the iterator of the next element.
@@ -48,6 +53,13 @@ type Iterator[T util.Comparer] interface {
 	End() bool
 }
 
+// IndexedIterator is an [Iterator] of an ordered set which knows the position of its element.
+type IndexedIterator[T any] interface {
+	Iterator[T]
+	// Index returns the position of the element of the iterator in the order of the set.
+	Index() int
+}
+
 // HashSetIterator is an iterator of a [HashSet] or [MultiHashSet].
 type HashSetIterator[T util.Hasher] struct {
 	// contains filtered or unexported fields
@@ -59,7 +71,7 @@ func NewHashSetIterator[T util.Hasher](set *HashSet[T]) Iterator[T] {
 	if set.IsEmpty() {
 		return &endIterator[T]{}
 	}
-	return &HashSetIterator[T]{iterator: table.NewHashTableIterator(set.objects.(*table.HashTable[T, uint8]))}
+	return &HashSetIterator[T]{iterator: set.objects.Iter()}
 }
 
 // NewMultiHashSetIterator returns a new [HashSetIterator] for a [MultiHashSet] associated at the set parameter.
@@ -123,30 +135,39 @@ func (i *HashSetIterator[T]) End() bool {
 }
 
 // TreeSetIterator is an iterator of a [TreeSet] or a [MultiTreeSet].
-type TreeSetIterator[T util.Comparer] struct {
+type TreeSetIterator[T any] struct {
 	// contains filtered or unexported fields
-	iterator tree.Iterator[T]
+	objects *tree.RedBlackTree[T]
+	node    *tree.Node[T]
 }
 
 // NewTreeSetIterator returns a new [TreeSetIterator] for a [TreeSet] associated at the set parameter.
-func NewTreeSetIterator[T util.Comparer](set *TreeSet[T]) Iterator[T] {
+func NewTreeSetIterator[T any](set *TreeSet[T]) Iterator[T] {
 	if set.IsEmpty() {
 		return &endIterator[T]{}
 	}
-	return &TreeSetIterator[T]{iterator: tree.NewTreeIterator[T](set.objects)}
+	return &TreeSetIterator[T]{objects: set.objects, node: set.objects.Root().Min()}
 }
 
-// NewTreeSetIterator returns a new [TreeSetIterator] for a [MultiTreeSet] associated at the set parameter.
-func NewMultiTreeSetIterator[T util.Comparer](set *MultiTreeSet[T]) Iterator[T] {
+// NewMultiTreeSetIterator returns a new [TreeSetIterator] for a [MultiTreeSet] associated at the set parameter.
+func NewMultiTreeSetIterator[T any](set *MultiTreeSet[T]) Iterator[T] {
 	if set.IsEmpty() {
 		return &endIterator[T]{}
 	}
-	return &TreeSetIterator[T]{iterator: tree.NewTreeIterator[T](set.objects)}
+	return &TreeSetIterator[T]{objects: set.objects, node: set.objects.Root().Min()}
 }
 
 // Elements returns the element of the iterator.
 func (i *TreeSetIterator[T]) Element() T {
-	return i.iterator.Element()
+	return i.node.Element()
+}
+
+// Index returns the position of the element of the iterator in the order of the set.
+//
+// The position is computed from the tree, so it takes into account the removals done during the iteration.
+// This method runs in O(log n) time.
+func (i *TreeSetIterator[T]) Index() int {
+	return i.node.Index()
 }
 
 // Remove removes the element from the set and returns the iterator of the next element.
@@ -175,8 +196,8 @@ func (i *TreeSetIterator[T]) Element() T {
 //		// Code
 //	}
 func (i *TreeSetIterator[T]) Remove() Iterator[T] {
-	i.iterator = i.iterator.Remove()
-	if i.iterator.End() {
+	i.node = i.objects.RemoveNode(i.node)
+	if i.node == nil {
 		return &endIterator[T]{}
 	}
 	return i
@@ -184,6 +205,120 @@ func (i *TreeSetIterator[T]) Remove() Iterator[T] {
 
 // Next returns the iterator of the next element.
 func (i *TreeSetIterator[T]) Next() Iterator[T] {
+	i.node = i.node.Successor()
+	if i.node == nil {
+		return &endIterator[T]{}
+	}
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *TreeSetIterator[T]) End() bool {
+	return false
+}
+
+// TreeSubSetIterator is an iterator of a [TreeSubSet].
+type TreeSubSetIterator[T any] struct {
+	// contains filtered or unexported fields
+	set  *TreeSubSet[T]
+	node *tree.Node[T]
+}
+
+// NewTreeSubSetIterator returns a new [TreeSubSetIterator] associated at the set parameter.
+func NewTreeSubSetIterator[T any](set *TreeSubSet[T]) Iterator[T] {
+	node := set.bounds.First()
+	if node == nil {
+		return &endIterator[T]{}
+	}
+	return &TreeSubSetIterator[T]{set: set, node: node}
+}
+
+// Elements returns the element of the iterator.
+func (i *TreeSubSetIterator[T]) Element() T {
+	return i.node.Element()
+}
+
+// Index returns the position of the element of the iterator in the order of the [TreeSubSet].
+//
+// The position is computed from the tree, so it takes into account the removals done during the iteration.
+// This method runs in O(log n) time.
+func (i *TreeSubSetIterator[T]) Index() int {
+	return i.set.bounds.Index(i.node)
+}
+
+// Remove removes the element from the set and returns the iterator of the next element.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+//
+// An example of the use of the method is the following:
+//
+//	for i := set.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i = i.Remove()
+//		}
+//		// Code
+//	}
+//
+// The following code, instead, can lead to undefined program behavior:
+//
+//	for i := set.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i.Remove()
+//		}
+//		// Code
+//	}
+func (i *TreeSubSetIterator[T]) Remove() Iterator[T] {
+	i.node = i.set.bounds.Remove(i.node)
+	if i.node == nil {
+		return &endIterator[T]{}
+	}
+	return i
+}
+
+// Next returns the iterator of the next element.
+func (i *TreeSubSetIterator[T]) Next() Iterator[T] {
+	i.node = i.set.bounds.Next(i.node)
+	if i.node == nil {
+		return &endIterator[T]{}
+	}
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *TreeSubSetIterator[T]) End() bool {
+	return false
+}
+
+// UnmodifiableSetIterator is an iterator of an [UnmodifiableSet] or [UnmodifiableMultiSet].
+type UnmodifiableSetIterator[T any] struct {
+	// contains filtered or unexported fields
+	iterator Iterator[T]
+}
+
+// NewUnmodifiableSetIterator returns a new [UnmodifiableSetIterator] associated at the iterator parameter.
+func NewUnmodifiableSetIterator[T any](iterator Iterator[T]) Iterator[T] {
+	if iterator.End() {
+		return &endIterator[T]{}
+	}
+	return &UnmodifiableSetIterator[T]{iterator: iterator}
+}
+
+// Elements returns the element of i.
+func (i *UnmodifiableSetIterator[T]) Element() T {
+	return i.iterator.Element()
+}
+
+// Remove always panics with [structures.ErrUnmodifiable].
+func (i *UnmodifiableSetIterator[T]) Remove() Iterator[T] {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Next returns the iterator of the next element.
+func (i *UnmodifiableSetIterator[T]) Next() Iterator[T] {
 	i.iterator = i.iterator.Next()
 	if i.iterator.End() {
 		return &endIterator[T]{}
@@ -192,11 +327,11 @@ func (i *TreeSetIterator[T]) Next() Iterator[T] {
 }
 
 // End checks if the iteration is finished.
-func (i *TreeSetIterator[T]) End() bool {
+func (i *UnmodifiableSetIterator[T]) End() bool {
 	return false
 }
 
-type endIterator[T util.Comparer] struct{}
+type endIterator[T any] struct{}
 
 func (i *endIterator[T]) Element() T {
 	return *new(T)
@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/table"
 	"github.com/potex02/structures/util"
 	"github.com/potex02/structures/util/wrapper"
@@ -208,3 +209,43 @@ func (s *MultiHashSet[T]) String() string {
 	check := reflect.TypeOf(new(T)).String()
 	return fmt.Sprintf("MultiHashSet[%v]%v", check[1:], s.ToSlice())
 }
+
+// MarshalJSON returns the JSON encoding of s, which is an array containing its elements.
+func (s *MultiHashSet[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of s with the ones decoded from the JSON array data.
+func (s *MultiHashSet[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, s.decode)
+}
+
+// MarshalBinary returns the binary encoding of s, which contains its elements.
+func (s *MultiHashSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(s.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of s with the ones decoded from the binary encoding data.
+func (s *MultiHashSet[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, s.decode)
+}
+
+// GobEncode returns the binary encoding of s as [MultiHashSet.MarshalBinary].
+func (s *MultiHashSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [MultiHashSet.UnmarshalBinary].
+func (s *MultiHashSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of s with objects.
+func (s *MultiHashSet[T]) decode(objects []T) error {
+	if s.objects == nil {
+		s.objects = table.NewMultiHashTable[T, uint8]()
+	}
+	s.Clear()
+	s.AddSlice(objects)
+	return nil
+}
@@ -1,6 +1,7 @@
 package set
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/potex02/structures"
@@ -156,3 +157,18 @@ func TestEqualMultiHashSet(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONMultiHashSet(t *testing.T) {
+
+	var set *MultiHashSet[wrapper.Int] = NewMultiHashSet[wrapper.Int](1, 2, 1)
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	var result MultiHashSet[wrapper.Int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(set) || result.Count(1) != 2 {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
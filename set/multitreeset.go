@@ -1,11 +1,13 @@
 package set
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/tree"
 	"github.com/potex02/structures/util"
 	"github.com/potex02/structures/util/wrapper"
@@ -15,13 +17,17 @@ var _ structures.Structure[wrapper.Int] = NewMultiTreeSet[wrapper.Int]()
 var _ BaseSet[wrapper.Int] = NewMultiTreeSet[wrapper.Int]()
 var _ MultiSet[wrapper.Int] = NewMultiTreeSet[wrapper.Int]()
 
-// MultiTreeSet provides a generic set ith duplicate elements implemented through a [tree.BinaryTree].
+// MultiTreeSet provides a generic set ith duplicate elements implemented through a [tree.RedBlackTree].
 // It maintains the order of the elements.
 //
+// The order is determined by the Compare method if the set is created with [NewMultiTreeSet],
+// otherwise it is determined by the comparison function passed to [NewMultiTreeSetFunc].
+//
 // It implements the interface [MultiSet].
-type MultiTreeSet[T util.Comparer] struct {
+type MultiTreeSet[T any] struct {
 	// contains filtered or unexported fields
-	objects *tree.BinaryTree[T]
+	objects *tree.RedBlackTree[T]
+	compare func(i T, j T) int
 }
 
 // NewMultiTreeSet returns a new [MultiTreeSet] containing the elements c.
@@ -33,7 +39,24 @@ func NewMultiTreeSet[T util.Comparer](c ...T) *MultiTreeSet[T] {
 
 // NewMultiTreeSetFromSlice returns a new [MultiTreeSet] containing the elements of slice c
 func NewMultiTreeSetFromSlice[T util.Comparer](c []T) *MultiTreeSet[T] {
-	set := &MultiTreeSet[T]{objects: tree.NewBinaryTree[T]()}
+	return NewMultiTreeSetFromSliceFunc(util.Compare[T], c)
+}
+
+// NewMultiTreeSetFunc returns a new [MultiTreeSet] containing the elements c,
+// whose order is determined by the compare function.
+//
+// compare must return a negative number if i is placed before j, a positive number if i is placed after j
+// and zero if they are equals.
+//
+// if no extra argument is passed, it will be created an empty [MultiTreeSet].
+func NewMultiTreeSetFunc[T any](compare func(i T, j T) int, c ...T) *MultiTreeSet[T] {
+	return NewMultiTreeSetFromSliceFunc(compare, c)
+}
+
+// NewMultiTreeSetFromSliceFunc returns a new [MultiTreeSet] containing the elements of slice c,
+// whose order is determined by the compare function.
+func NewMultiTreeSetFromSliceFunc[T any](compare func(i T, j T) int, c []T) *MultiTreeSet[T] {
+	set := &MultiTreeSet[T]{objects: tree.NewRedBlackTreeFunc(compare), compare: compare}
 	if len(c) != 0 {
 		set.AddSlice(c)
 	}
@@ -81,17 +104,32 @@ func (s *MultiTreeSet[T]) Remove(e T) bool {
 // RemoveAll removes all occurrences of e from s.
 func (s *MultiTreeSet[T]) RemoveAll(e T) {
 	for i := s.objects.Iter(); !i.End(); i = i.Next() {
-		check := e.Compare(i.Element())
+		check := s.compare(e, i.Element())
 		for !i.End() && check == 0 {
 			i = i.Remove()
-			check = e.Compare(i.Element())
+			check = s.compare(e, i.Element())
 		}
-		if check == -1 {
+		if check < 0 {
 			return
 		}
 	}
 }
 
+// Rank returns the number of elements of s strictly less than e.
+//
+// This method runs in O(log n) time.
+func (s *MultiTreeSet[T]) Rank(e T) int {
+	return s.objects.Rank(e)
+}
+
+// Select returns the k-th smallest element of s, starting from 0.
+// The method returns false if k is out of bounds.
+//
+// This method runs in O(log n) time.
+func (s *MultiTreeSet[T]) Select(k int) (T, bool) {
+	return nodeElement(s.objects.Select(k))
+}
+
 // Each executes fun for all elements of s.
 //
 // This method should be used to remove elements. Use Iter insted.
@@ -105,7 +143,7 @@ func (s *MultiTreeSet[T]) Each(fun func(element T)) {
 func (s *MultiTreeSet[T]) Count(e T) int {
 	result := 0
 	s.objects.All(s.objects.Root(), func(i *tree.Node[T]) bool {
-		check := e.Compare(i.Element())
+		check := s.compare(e, i.Element())
 		if check == 0 {
 			result++
 		}
@@ -118,12 +156,14 @@ func (s *MultiTreeSet[T]) Count(e T) int {
 func (s *MultiTreeSet[T]) ToSet() Set[T] {
 	slice := s.ToSlice()
 	rand.Shuffle(len(slice), func(i, j int) { slice[i], slice[j] = slice[j], slice[i] })
-	return NewTreeSetFromSlice(slice)
+	return NewTreeSetFromSliceFunc(s.compare, slice)
 }
 
 // Stream returns a [Stream] rapresenting s.
 func (s *MultiTreeSet[T]) Stream() *Stream[T] {
-	return NewStream[T](s, reflect.ValueOf(NewMultiTreeSet[T]))
+	return NewStream[T](s, reflect.ValueOf(func() *MultiTreeSet[T] {
+		return NewMultiTreeSetFunc(s.compare)
+	}))
 }
 
 // Clear removes all element from s.
@@ -209,7 +249,7 @@ func (s *MultiTreeSet[T]) Hash() uint64 {
 func (s *MultiTreeSet[T]) Copy() MultiSet[T] {
 	slice := s.ToSlice()
 	rand.Shuffle(len(slice), func(i, j int) { slice[i], slice[j] = slice[j], slice[i] })
-	result := NewMultiTreeSet[T]()
+	result := NewMultiTreeSetFunc(s.compare)
 	for _, i := range slice {
 		result.Add(util.Copy(i))
 	}
@@ -221,3 +261,53 @@ func (s *MultiTreeSet[T]) String() string {
 	check := reflect.TypeOf(new(T)).String()
 	return fmt.Sprintf("MultiTreeSet[%v]%v", check[1:], s.ToSlice())
 }
+
+// MarshalJSON returns the JSON encoding of s, which is an array containing its elements in ascending order.
+func (s *MultiTreeSet[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of s with the ones decoded from the JSON array data.
+//
+// If s is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (s *MultiTreeSet[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, s.decode)
+}
+
+// MarshalBinary returns the binary encoding of s, which contains its elements in ascending order.
+func (s *MultiTreeSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(s.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of s with the ones decoded from the binary encoding data.
+//
+// If s is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (s *MultiTreeSet[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, s.decode)
+}
+
+// GobEncode returns the binary encoding of s as [MultiTreeSet.MarshalBinary].
+func (s *MultiTreeSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [MultiTreeSet.UnmarshalBinary].
+func (s *MultiTreeSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of s with objects.
+func (s *MultiTreeSet[T]) decode(objects []T) error {
+	if s.objects == nil {
+		compare := codec.Compare[T]()
+		if compare == nil {
+			return errors.New("Cannot decode a MultiTreeSet whose elements do not implement util.Comparer")
+		}
+		*s = *NewMultiTreeSetFunc(compare)
+	}
+	s.Clear()
+	s.AddSlice(objects)
+	return nil
+}
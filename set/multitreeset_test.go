@@ -1,6 +1,8 @@
 package set
 
 import (
+	"encoding/json"
+	"slices"
 	"testing"
 
 	"github.com/potex02/structures"
@@ -152,3 +154,52 @@ func TestEqualMultiTreeSet(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestRankMultiTreeSet(t *testing.T) {
+
+	var set *MultiTreeSet[wrapper.Int] = NewMultiTreeSet[wrapper.Int](3, 1, 3, 2, 3)
+
+	if rank := set.Rank(3); rank != 2 {
+		t.Log("rank is", rank)
+		t.Fail()
+	}
+	if e, ok := set.Select(3); !ok || e != 3 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := set.Select(0); !ok || e != 1 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	j := 0
+	for i := set.Iter(); !i.End(); {
+		if index := i.(IndexedIterator[wrapper.Int]).Index(); index != j {
+			t.Log("index of", i.Element(), "is", index)
+			t.Fail()
+		}
+		if i.Element() == 3 && j == 3 && set.Len() == 5 {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+			j++
+		}
+	}
+	if slice := set.ToSlice(); !slices.Equal(slice, []wrapper.Int{1, 2, 3, 3}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+}
+func TestJSONMultiTreeSet(t *testing.T) {
+
+	var set *MultiTreeSet[wrapper.Int] = NewMultiTreeSet[wrapper.Int](2, 1, 2)
+
+	data, err := json.Marshal(set)
+	if err != nil || string(data) != "[1,2,2]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result MultiTreeSet[wrapper.Int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(set) || result.Count(2) != 2 {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
@@ -2,18 +2,49 @@
 package set
 
 import (
+	"fmt"
+
 	"github.com/potex02/structures"
 	"github.com/potex02/structures/util"
 )
 
+// ReadOnlySet provides the methods to read a generic set without modifying it.
+// It is satisfied by every [BaseSet] and by the views returned by [Unmodifiable].
+type ReadOnlySet[T any] interface {
+	fmt.Stringer
+	util.Equaler
+	util.Hasher
+	// Len returns the numbers of elements in the set.
+	Len() int
+	// IsEmpty returns a bool which indicates if the set is empty or not.
+	IsEmpty() bool
+	// ToSlice returns a slice which contains all elements of the set.
+	ToSlice() []T
+	// Contains returns if e is present in the set.
+	Contains(e T) bool
+	// Each executes fun for all elements of the set.
+	//
+	// This method should be used to remove elements. Use Iter insted.
+	Each(fun func(element T))
+	// Stream returns a [Stream] rapresenting the set.
+	Stream() *Stream[T]
+	// RangeIter returns a function that allows to iterate the set using the range keyword.
+	//
+	//	for i := range set.RangeIter() {
+	//		// Code
+	//	}
+	//
+	// Unlike [BaseSet.Iter], it doesn't allow to remove elements during the iteration.
+	RangeIter() func(yield func(T) bool)
+}
+
 // BaseSet is the base interface for both [Set] and [MultiSet].
-// A baseset contains all the methods of [structures.Structure].
+// A baseset contains all the methods of [structures.Structure] and of [ReadOnlySet].
 //
 // It provides methods for a generic dynamic table can have unique or duplicate keys.
-type BaseSet[T util.Comparer] interface {
+type BaseSet[T any] interface {
 	structures.Structure[T]
-	// Contains returns if e is present in the set.
-	Contains(e T) bool
+	ReadOnlySet[T]
 	// Add adds the elements e at the set.
 	Add(e ...T)
 	// AddSlice adds the elements of e at the set.
@@ -21,12 +52,6 @@ type BaseSet[T util.Comparer] interface {
 	// Remove removes the element e from the set if it is present.
 	// In that case, the method returns true, otherwhise it returns false.
 	Remove(e T) bool
-	// Each executes fun for all elements of the set.
-	//
-	// This method should be used to remove elements. Use Iter insted.
-	Each(fun func(element T))
-	// Stream returns a [Stream] rapresenting the set.
-	Stream() *Stream[T]
 	// Iter returns an [Iterator] which permits to iterate a [Set].
 	//
 	//	for i := set.Iter(); !i.End(); i = i.Next() {
@@ -34,36 +59,35 @@ type BaseSet[T util.Comparer] interface {
 	//		// Code
 	//	}
 	Iter() Iterator[T]
-	// RangeIter returns a function that allows to iterate a [Set] using the range keyword.
-	//
-	//	for i := range set.RangeIter() {
-	//		// Code
-	//	}
-	//
-	// Unlike [Set.Iter], it doesn't allow to remove elements during the iteration.
-	RangeIter() func(yield func(T) bool)
 }
 
 // Set provides all methods to use a generic dynamic set.
 // A set contains all the methods of [BaseSet].
 //
-// The check on the equality of the elements is done with the Compare method.
-type Set[T util.Comparer] interface {
+// The check on the equality of the elements is done with the Compare method or, for the sets created with a comparison function, with that function.
+type Set[T any] interface {
 	util.Copier[Set[T]]
 	BaseSet[T]
 }
 
+// ReadOnlyMultiSet provides the methods to read a generic set with duplicate elements without modifying it.
+// It is satisfied by every [MultiSet] and by the views returned by [UnmodifiableMulti].
+type ReadOnlyMultiSet[T any] interface {
+	ReadOnlySet[T]
+	// Count returns the number of occurrences of e in the set.
+	Count(e T) int
+}
+
 // MultiSet provides all methods to use a generic dynamic set with duplicate elements.
-// A multiset contains all the methods of [BaseSet].
+// A multiset contains all the methods of [BaseSet] and of [ReadOnlyMultiSet].
 //
-// The check on the equality of the elements is done with the Compare method.
-type MultiSet[T util.Comparer] interface {
+// The check on the equality of the elements is done with the Compare method or, for the sets created with a comparison function, with that function.
+type MultiSet[T any] interface {
 	util.Copier[MultiSet[T]]
 	BaseSet[T]
+	ReadOnlyMultiSet[T]
 	// RemoveAll removes all occurrences of e from the set.
 	RemoveAll(e T)
-	// Count returns the number of occurrences of e in the set.
-	Count(e T) int
 	// ToSet returns a [Set] containing the elements of the multiset.
 	ToSet() Set[T]
 }
@@ -0,0 +1,60 @@
+package set
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestBinary(t *testing.T) {
+
+	var disjoint *DisjointSet[wrapper.Int] = NewDisjointSet[wrapper.Int](1, 2, 3, 4)
+	var tests []binaryTest = []binaryTest{
+		{"HashSet", NewHashSet[wrapper.Int](1, 2, 3), NewHashSet[wrapper.Int](4), &HashSet[wrapper.Int]{}},
+		{"MultiHashSet", NewMultiHashSet[wrapper.Int](1, 2, 1), NewMultiHashSet[wrapper.Int](4), &MultiHashSet[wrapper.Int]{}},
+		{"TreeSet", NewTreeSet[wrapper.Int](3, 1, 2), NewTreeSet[wrapper.Int](4), &TreeSet[wrapper.Int]{}},
+		{"MultiTreeSet", NewMultiTreeSet[wrapper.Int](2, 1, 2), NewMultiTreeSet[wrapper.Int](4), &MultiTreeSet[wrapper.Int]{}},
+		{"TreeSubSet", NewTreeSet[wrapper.Int](10, 20, 30).HeadSet(20, true), NewTreeSet[wrapper.Int](5, 30).HeadSet(20, true), &TreeSet[wrapper.Int]{}},
+		{"DisjointSet", disjoint, NewDisjointSet[wrapper.Int](5), &DisjointSet[wrapper.Int]{}},
+	}
+
+	disjoint.Union(4, 2)
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
@@ -1,28 +1,80 @@
 package set
 
 import (
+	"iter"
 	"reflect"
+	"slices"
 
-	"github.com/potex02/structures/util"
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/seq"
 )
 
 // Stream provides aggregate operations for a [BaseSet].
-type Stream[T util.Comparer] struct {
+//
+// A stream is lazy: Map, Filter, FilterMap, Union, Intersection and Difference only add a stage to the pipeline,
+// which is executed element by element when a terminal operation (Any, All, None, Count, a Collect method or RangeIter) is called.
+// The terminal operations stop pulling elements as soon as the result is known.
+//
+// Since the sources are read only by the terminal operations, the modifications of the sources done before them are visible in the result,
+// and every terminal operation runs the whole pipeline again.
+//
+// A stream can be set in parallel mode by Parallel.
+// In this mode, the stages added by Map, Filter, FilterMap, Intersection, Difference, [MapTo] and [FlatMap]
+// and the operations Any, All, None and Count split the elements produced by the previous stage between a pool of workers,
+// so the functions passed to them must be safe for concurrent use.
+// The other stages are executed sequentially.
+type Stream[T any] struct {
 	// contains filtered or unexported fields
-	objects     []T
+	objects     iter.Seq[T]
 	constructor reflect.Value
+	workers     int
+	ordered     bool
 }
 
 // NewStream returns a new [Stream] for a [BaseSet] associated at the set parameter.
 //
 // Constructor a [reflect.Value] rapresenting the function that create the resulting set from the stream.
 // This function must have no parameters or must be a variadic function and must returns a BaseSet[T].
-func NewStream[T util.Comparer](set BaseSet[T], constructor reflect.Value) *Stream[T] {
-	objects := make([]T, 0)
-	set.Each(func(element T) {
-		objects = append(objects, element)
-	})
-	return &Stream[T]{objects: objects, constructor: constructor}
+// It is used only by the Collect methods, so it can be the zero [reflect.Value] if s is collected through [CollectTo].
+//
+// Deprecated: the constructor is checked only when a Collect method is called, which panics if it is wrong.
+// Use [NewStreamFromSeq] and collect the stream through [CollectTo].
+func NewStream[T any](set BaseSet[T], constructor reflect.Value) *Stream[T] {
+	return &Stream[T]{objects: iter.Seq[T](set.RangeIter()), constructor: constructor}
+}
+
+// NewStreamFromSeq returns a new [Stream] whose elements are produced by seq.
+// seq can be infinite, as long as the stream is consumed by a short-circuiting operation.
+//
+// The stream has no constructor, so it must be collected through [CollectTo].
+func NewStreamFromSeq[T any](seq iter.Seq[T]) *Stream[T] {
+	return &Stream[T]{objects: seq}
+}
+
+// Parallel sets s in parallel mode, using at most workers goroutines for each parallel stage.
+// If workers is less than 1, the number of workers is [runtime.GOMAXPROCS].
+//
+// If ordered is true, the parallel stages produce the elements in the same order of the sequential mode,
+// otherwise they produce them as soon as they are computed.
+//
+// The mode is applied to the stages added after the call of this method.
+func (s *Stream[T]) Parallel(workers int, ordered bool) *Stream[T] {
+	s.workers = seq.Workers(workers)
+	s.ordered = ordered
+	return s
+}
+
+// Sequential sets s in sequential mode, which is the default.
+//
+// The mode is applied to the stages added after the call of this method.
+func (s *Stream[T]) Sequential() *Stream[T] {
+	s.workers = 0
+	return s
+}
+
+// IsParallel returns true if s is in parallel mode.
+func (s *Stream[T]) IsParallel() bool {
+	return s.workers != 0
 }
 
 // Map executes fun for all elements of s and returns a [Stream] containing the resulting elements.
@@ -30,12 +82,9 @@ func NewStream[T util.Comparer](set BaseSet[T], constructor reflect.Value) *Stre
 // This method modifies the state of s, so it is not necessary to assign to resulting stream, but it
 // can be useful for concatenate operations in a single instruction.
 func (s *Stream[T]) Map(fun func(element T) T) *Stream[T] {
-	result := make([]T, 0)
-	for _, i := range s.objects {
-		result = append(result, fun(i))
-	}
-	s.objects = result
-	return s
+	return s.FilterMap(func(element T) (T, bool) {
+		return fun(element), true
+	})
 }
 
 // Filter returns a [Stream] containing the elements that satisfy fun.
@@ -43,14 +92,9 @@ func (s *Stream[T]) Map(fun func(element T) T) *Stream[T] {
 // This method modifies the state of s, so it is not necessary to assign to resulting stream, but it
 // can be useful for concatenate operations in a single instruction.
 func (s *Stream[T]) Filter(fun func(element T) bool) *Stream[T] {
-	result := make([]T, 0)
-	for _, i := range s.objects {
-		if fun(i) {
-			result = append(result, i)
-		}
-	}
-	s.objects = result
-	return s
+	return s.FilterMap(func(element T) (T, bool) {
+		return element, fun(element)
+	})
 }
 
 // FilterMap executes fun for all elements of s and returns a [Stream] containing the resulting elements that satisfy fun.
@@ -58,13 +102,20 @@ func (s *Stream[T]) Filter(fun func(element T) bool) *Stream[T] {
 // This method modifies the state of s, so it is not necessary to assign to resulting stream, but it
 // can be useful for concatenate operations in a single instruction.
 func (s *Stream[T]) FilterMap(fun func(element T) (T, bool)) *Stream[T] {
-	result := make([]T, 0)
-	for _, i := range s.objects {
-		if element, ok := fun(i); ok {
-			result = append(result, element)
+	objects := s.objects
+	if s.IsParallel() {
+		s.objects = seq.ParallelFilterMap(objects, s.workers, s.ordered, func(_ int, element T) (T, bool) {
+			return fun(element)
+		})
+		return s
+	}
+	s.objects = func(yield func(T) bool) {
+		for i := range objects {
+			if element, ok := fun(i); ok && !yield(element) {
+				return
+			}
 		}
 	}
-	s.objects = result
 	return s
 }
 
@@ -72,7 +123,19 @@ func (s *Stream[T]) FilterMap(fun func(element T) (T, bool)) *Stream[T] {
 //
 // The result of a union of two sets is a set which contains all elements of both sets.
 func (s *Stream[T]) Union(set BaseSet[T]) *Stream[T] {
-	s.objects = append(s.objects, set.ToSlice()...)
+	objects := s.objects
+	s.objects = func(yield func(T) bool) {
+		for i := range objects {
+			if !yield(i) {
+				return
+			}
+		}
+		for i := range set.RangeIter() {
+			if !yield(i) {
+				return
+			}
+		}
+	}
 	return s
 }
 
@@ -80,14 +143,7 @@ func (s *Stream[T]) Union(set BaseSet[T]) *Stream[T] {
 //
 // The result of a union of two sets is a set which contains only the elements present in both sets.
 func (s *Stream[T]) Intersection(set BaseSet[T]) *Stream[T] {
-	result := make([]T, 0)
-	for _, i := range s.objects {
-		if set.Contains(i) {
-			result = append(result, i)
-		}
-	}
-	s.objects = result
-	return s
+	return s.Filter(set.Contains)
 }
 
 // Difference returns a [Stream] that is the result of the difference between s and set.
@@ -95,19 +151,19 @@ func (s *Stream[T]) Intersection(set BaseSet[T]) *Stream[T] {
 // The result of a union of two sets is a set which contains only the elements present the first set
 // that are not present in the second set.
 func (s *Stream[T]) Difference(set BaseSet[T]) *Stream[T] {
-	result := make([]T, 0)
-	for _, i := range s.objects {
-		if !set.Contains(i) {
-			result = append(result, i)
-		}
-	}
-	s.objects = result
-	return s
+	return s.Filter(func(element T) bool {
+		return !set.Contains(element)
+	})
 }
 
 // Any returns true if at least one element of s satisfies fun.
 func (s *Stream[T]) Any(fun func(element T) bool) bool {
-	for _, i := range s.objects {
+	if s.IsParallel() {
+		return seq.ParallelAny(s.objects, s.workers, func(_ int, element T) bool {
+			return fun(element)
+		})
+	}
+	for i := range s.objects {
 		if fun(i) {
 			return true
 		}
@@ -117,7 +173,12 @@ func (s *Stream[T]) Any(fun func(element T) bool) bool {
 
 // All returns true if all elements of s satisfy fun.
 func (s *Stream[T]) All(fun func(element T) bool) bool {
-	for _, i := range s.objects {
+	if s.IsParallel() {
+		return !seq.ParallelAny(s.objects, s.workers, func(_ int, element T) bool {
+			return !fun(element)
+		})
+	}
+	for i := range s.objects {
 		if !fun(i) {
 			return false
 		}
@@ -127,18 +188,18 @@ func (s *Stream[T]) All(fun func(element T) bool) bool {
 
 // None returns true if none of the elements of s satisfies fun.
 func (s *Stream[T]) None(fun func(element T) bool) bool {
-	for _, i := range s.objects {
-		if fun(i) {
-			return false
-		}
-	}
-	return true
+	return !s.Any(fun)
 }
 
 // Count returns the number of elements that satisfy fun.
 func (s *Stream[T]) Count(fun func(element T) bool) int {
+	if s.IsParallel() {
+		return seq.ParallelCount(s.objects, s.workers, func(_ int, element T) bool {
+			return fun(element)
+		})
+	}
 	result := 0
-	for _, i := range s.objects {
+	for i := range s.objects {
 		if fun(i) {
 			result++
 		}
@@ -146,14 +207,25 @@ func (s *Stream[T]) Count(fun func(element T) bool) int {
 	return result
 }
 
+// RangeIter returns a function that allows to iterate the elements of s using the range keyword.
+//
+//	for i := range s.RangeIter() {
+//		// Code
+//	}
+func (s *Stream[T]) RangeIter() func(yield func(T) bool) {
+	return s.objects
+}
+
 // Collect returns a [BaseSet] from s.
 //
 // the effective type of the result is the same the constructor.
 //
 // This method panics if constructor have wrong parameters or not returns a BaseSet[T].
+//
+// Deprecated: use [CollectTo] with a typed collector, like [ToHashSet].
 func (s *Stream[T]) CollectBase() BaseSet[T] {
 	result := s.constructor.Call([]reflect.Value{})[0].Interface().(BaseSet[T])
-	for _, i := range s.objects {
+	for i := range s.objects {
 		result.Add(i)
 	}
 	return result
@@ -164,9 +236,11 @@ func (s *Stream[T]) CollectBase() BaseSet[T] {
 // the effective type of the result is the same the constructor.
 //
 // This method panics if constructor have wrong parameters or not returns a Set[T].
+//
+// Deprecated: use [CollectTo] with a typed collector, like [ToHashSet].
 func (s *Stream[T]) Collect() Set[T] {
 	result := s.constructor.Call([]reflect.Value{})[0].Interface().(Set[T])
-	for _, i := range s.objects {
+	for i := range s.objects {
 		result.Add(i)
 	}
 	return result
@@ -177,10 +251,155 @@ func (s *Stream[T]) Collect() Set[T] {
 // the effective type of the result is the same the constructor.
 //
 // This method panics if constructor have wrong parameters or not returns a MultiSet[T].
+//
+// Deprecated: use [CollectTo] with a typed collector.
 func (s *Stream[T]) CollectMulti() MultiSet[T] {
 	result := s.constructor.Call([]reflect.Value{})[0].Interface().(MultiSet[T])
-	for _, i := range s.objects {
+	for i := range s.objects {
 		result.Add(i)
 	}
 	return result
 }
+
+// CollectTo returns the result of collector applied at the elements of s.
+func CollectTo[T any, R any](s *Stream[T], collector structures.Collector[T, R]) R {
+	return collector.Collect(s.objects)
+}
+
+// derive returns a new [Stream] producing objects with the same mode of s.
+func derive[T any, R any](s *Stream[T], objects iter.Seq[R], constructor reflect.Value) *Stream[R] {
+	return &Stream[R]{objects: objects, constructor: constructor, workers: s.workers, ordered: s.ordered}
+}
+
+// MapTo executes fun for all elements of s and returns a new [Stream] containing the resulting elements,
+// which can be of a different type than the elements of s.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+func MapTo[T any, R any](s *Stream[T], fun func(element T) R) *Stream[R] {
+	if s.IsParallel() {
+		return derive(s, seq.ParallelFilterMap(s.objects, s.workers, s.ordered, func(_ int, element T) (R, bool) {
+			return fun(element), true
+		}), reflect.Value{})
+	}
+	return derive(s, seq.Map(s.objects, func(_ int, element T) R {
+		return fun(element)
+	}), reflect.Value{})
+}
+
+// FlatMap executes fun for all elements of s and returns a new [Stream] containing the concatenation of the resulting slices.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+func FlatMap[T any, R any](s *Stream[T], fun func(element T) []R) *Stream[R] {
+	if s.IsParallel() {
+		return derive(s, seq.FlatMap(seq.ParallelFilterMap(s.objects, s.workers, s.ordered, func(_ int, element T) ([]R, bool) {
+			return fun(element), true
+		}), func(_ int, element []R) []R {
+			return element
+		}), reflect.Value{})
+	}
+	return derive(s, seq.FlatMap(s.objects, func(_ int, element T) []R {
+		return fun(element)
+	}), reflect.Value{})
+}
+
+// Reduce combines the elements of s through fun, using the first element as initial result.
+// The function returns false if s is empty.
+func Reduce[T any](s *Stream[T], fun func(result T, element T) T) (T, bool) {
+
+	var result T
+
+	found := false
+	for i := range s.objects {
+		if found {
+			result = fun(result, i)
+		} else {
+			result = i
+			found = true
+		}
+	}
+	return result, found
+}
+
+// Fold combines the elements of s through fun, starting from initial.
+func Fold[T any, R any](s *Stream[T], initial R, fun func(result R, element T) R) R {
+	result := initial
+	for i := range s.objects {
+		result = fun(result, i)
+	}
+	return result
+}
+
+// GroupBy returns a map which associates every key returned by fun to the result of collector
+// applied at the elements of s with that key.
+func GroupBy[T any, K comparable, R any](s *Stream[T], fun func(element T) K, collector structures.Collector[T, R]) map[K]R {
+	groups := map[K][]T{}
+	for i := range s.objects {
+		key := fun(i)
+		groups[key] = append(groups[key], i)
+	}
+	result := make(map[K]R, len(groups))
+	for i, j := range groups {
+		result[i] = collector.Collect(slices.Values(j))
+	}
+	return result
+}
+
+// Partition returns the result of collector applied at the elements of s that satisfy fun
+// and the result of collector applied at the other elements.
+func Partition[T any, R any](s *Stream[T], fun func(element T) bool, collector structures.Collector[T, R]) (R, R) {
+
+	var accepted, rejected []T
+
+	for i := range s.objects {
+		if fun(i) {
+			accepted = append(accepted, i)
+		} else {
+			rejected = append(rejected, i)
+		}
+	}
+	return collector.Collect(slices.Values(accepted)), collector.Collect(slices.Values(rejected))
+}
+
+// Distinct returns a new [Stream] containing the elements of s without duplicates.
+// The first occurrence of every element is kept.
+func Distinct[T comparable](s *Stream[T]) *Stream[T] {
+	return derive(s, seq.DistinctFunc(s.objects, func(element T) T {
+		return element
+	}), s.constructor)
+}
+
+// Sorted returns a new [Stream] containing the elements of s ordered by compare.
+//
+// The sort is stable, but all elements of s must be read before producing the first one.
+func Sorted[T any](s *Stream[T], compare func(i T, j T) int) *Stream[T] {
+	return derive(s, seq.Sorted(s.objects, compare), s.constructor)
+}
+
+// Limit returns a new [Stream] containing at most the first n elements of s.
+func Limit[T any](s *Stream[T], n int) *Stream[T] {
+	return derive(s, seq.Limit(s.objects, n), s.constructor)
+}
+
+// Skip returns a new [Stream] containing the elements of s except the first n.
+func Skip[T any](s *Stream[T], n int) *Stream[T] {
+	return derive(s, seq.Skip(s.objects, n), s.constructor)
+}
+
+// Zip returns a new [Stream] containing the results of fun for the elements of s and other in the same position.
+// The resulting stream ends when the shortest between s and other ends.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+func Zip[T any, U any, R any](s *Stream[T], other *Stream[U], fun func(i T, j U) R) *Stream[R] {
+	return derive(s, seq.Zip(s.objects, other.objects, fun), reflect.Value{})
+}
+
+// Chunk returns a new [Stream] containing slices of size consecutive elements of s.
+// The last slice can have less than size elements.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+// Since slices do not implement util.Comparer or util.Hasher, the collector is usually [ToTreeSetFunc].
+//
+// This function panics if size is less than 1.
+func Chunk[T any](s *Stream[T], size int) *Stream[[]T] {
+	return derive(s, seq.Chunk(s.objects, size), reflect.Value{})
+}
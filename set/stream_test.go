@@ -1,7 +1,9 @@
 package set
 
 import (
+	"fmt"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/potex02/structures/util/wrapper"
@@ -317,3 +319,158 @@ func TestCountMulti(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestLazyStream(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewStreamFromSeq(func(yield func(wrapper.Int) bool) {
+		for i := wrapper.Int(0); ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	stream.Difference(NewHashSet[wrapper.Int](0, 1, 2)).Map(func(element wrapper.Int) wrapper.Int {
+		return element * 10
+	})
+	if stream.All(func(element wrapper.Int) bool {
+		return element < 50
+	}) {
+		t.Log("result is true")
+		t.Fail()
+	}
+	stream = NewTreeSet[wrapper.Int](1, 2).Stream().Union(NewTreeSet[wrapper.Int](2, 3))
+	if !stream.Collect().Equal(NewHashSet[wrapper.Int](1, 2, 3)) {
+		t.Log("result is", stream.Collect())
+		t.Fail()
+	}
+}
+func TestMapTo(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewTreeSet[wrapper.Int](1, 2, -10, 20).Stream()
+
+	result := CollectTo(MapTo(stream, func(element wrapper.Int) wrapper.String {
+		return wrapper.String(fmt.Sprint(element % 2))
+	}), ToHashSet[wrapper.String]())
+	if !result.Equal(NewHashSet[wrapper.String]("0", "1")) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	other := CollectTo(FlatMap(stream, func(element wrapper.Int) []wrapper.String {
+		return []wrapper.String{wrapper.String(fmt.Sprint(element)), "x"}
+	}), ToTreeSet[wrapper.String]())
+	if other.Len() != 5 || !other.Contains("-10") || !other.Contains("x") {
+		t.Log("result is", other)
+		t.Fail()
+	}
+}
+func TestReduce(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewTreeSet[wrapper.Int](1, 2, -10, 20).Stream()
+
+	if result, ok := Reduce(stream, func(result wrapper.Int, element wrapper.Int) wrapper.Int {
+		return result + element
+	}); !ok || result != 13 {
+		t.Log("result is", result, ok)
+		t.Fail()
+	}
+	if result := Fold(stream, "", func(result string, element wrapper.Int) string {
+		return result + fmt.Sprint(element)
+	}); result != "-101220" {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestGroupBy(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewMultiHashSet[wrapper.Int](1, 2, -10, 20, 2).Stream()
+
+	result := GroupBy(stream, func(element wrapper.Int) bool {
+		return element > 0
+	}, ToMultiHashSet[wrapper.Int]())
+	if len(result) != 2 || !result[true].Equal(NewMultiHashSet[wrapper.Int](1, 2, 2, 20)) || !result[false].Equal(NewMultiHashSet[wrapper.Int](-10)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	accepted, rejected := Partition(stream, func(element wrapper.Int) bool {
+		return element%2 == 0
+	}, ToMultiHashSet[wrapper.Int]())
+	if !accepted.Equal(NewMultiHashSet[wrapper.Int](2, 2, -10, 20)) || !rejected.Equal(NewMultiHashSet[wrapper.Int](1)) {
+		t.Log("result is", accepted, rejected)
+		t.Fail()
+	}
+}
+func TestDistinct(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewMultiTreeSet[wrapper.Int](3, 1, 2, 3, 1, 5, 4).Stream()
+
+	if result := Distinct(stream).CollectMulti(); !result.Equal(NewMultiTreeSet[wrapper.Int](1, 2, 3, 4, 5)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := Limit(Skip(Sorted(stream, func(i wrapper.Int, j wrapper.Int) int {
+		return j.Compare(i)
+	}), 1), 3).CollectMulti(); !result.Equal(NewMultiTreeSet[wrapper.Int](4, 3, 3)) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestZip(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewTreeSet[wrapper.Int](1, 2, 3).Stream()
+	var other *Stream[wrapper.String] = NewTreeSet[wrapper.String]("a", "b").Stream()
+
+	result := CollectTo(Zip(stream, other, func(i wrapper.Int, j wrapper.String) wrapper.String {
+		return wrapper.String(fmt.Sprint(j, i))
+	}), ToHashSet[wrapper.String]())
+	if !result.Equal(NewHashSet[wrapper.String]("a1", "b2")) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestChunk(t *testing.T) {
+
+	var stream *Stream[wrapper.Int] = NewTreeSet[wrapper.Int](1, 2, 3, 4, 5).Stream()
+
+	result := CollectTo(Chunk(stream, 2), ToTreeSetFunc(func(i []wrapper.Int, j []wrapper.Int) int {
+		return slices.CompareFunc(i, j, func(k wrapper.Int, l wrapper.Int) int {
+			return k.Compare(l)
+		})
+	}))
+	if first, ok := result.First(); result.Len() != 3 || !ok || !slices.Equal(first, []wrapper.Int{1, 2}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestParallel(t *testing.T) {
+
+	var set *HashSet[wrapper.Int] = NewHashSet[wrapper.Int]()
+
+	for i := wrapper.Int(0); i != 10000; i++ {
+		set.Add(i)
+	}
+	stream := set.Stream().Parallel(4, false).Difference(NewHashSet[wrapper.Int](0, 1, 2)).Map(func(element wrapper.Int) wrapper.Int {
+		return element / 2
+	})
+	if result := stream.Collect(); result.Len() != 4999 || result.Contains(0) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := stream.Count(func(element wrapper.Int) bool {
+		return element < 10
+	}); result != 17 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if !stream.All(func(element wrapper.Int) bool {
+		return element > 0
+	}) || stream.None(func(element wrapper.Int) bool {
+		return element == 4999
+	}) {
+		t.Log("all or none is wrong")
+		t.Fail()
+	}
+	if result := Limit(stream, 10).Collect(); result.Len() > 10 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
@@ -1,11 +1,14 @@
 package set
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/bound"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/tree"
 	"github.com/potex02/structures/util"
 	"github.com/potex02/structures/util/wrapper"
@@ -15,13 +18,17 @@ var _ structures.Structure[wrapper.Int] = NewHashSet[wrapper.Int]()
 var _ BaseSet[wrapper.Int] = NewTreeSet[wrapper.Int]()
 var _ Set[wrapper.Int] = NewTreeSet[wrapper.Int]()
 
-// TreeSet provides a generic set implemented through a [tree.BinaryTree].
+// TreeSet provides a generic set implemented through a [tree.RedBlackTree].
 // It maintains the order of the elements.
 //
+// The order is determined by the Compare method if the set is created with [NewTreeSet],
+// otherwise it is determined by the comparison function passed to [NewTreeSetFunc].
+//
 // It implements the interface [Set].
-type TreeSet[T util.Comparer] struct {
+type TreeSet[T any] struct {
 	// contains filtered or unexported fields
-	objects *tree.BinaryTree[T]
+	objects *tree.RedBlackTree[T]
+	compare func(i T, j T) int
 }
 
 // NewTreeSet returns a new [TreeSet] containing the elements c.
@@ -33,7 +40,24 @@ func NewTreeSet[T util.Comparer](c ...T) *TreeSet[T] {
 
 // NewTreeSetFromSlice returns a new [TreeSet] containing the elements of slice c
 func NewTreeSetFromSlice[T util.Comparer](c []T) *TreeSet[T] {
-	set := &TreeSet[T]{objects: tree.NewBinaryTree[T]()}
+	return NewTreeSetFromSliceFunc(util.Compare[T], c)
+}
+
+// NewTreeSetFunc returns a new [TreeSet] containing the elements c,
+// whose order is determined by the compare function.
+//
+// compare must return a negative number if i is placed before j, a positive number if i is placed after j
+// and zero if they are equals.
+//
+// if no extra argument is passed, it will be created an empty [TreeSet].
+func NewTreeSetFunc[T any](compare func(i T, j T) int, c ...T) *TreeSet[T] {
+	return NewTreeSetFromSliceFunc(compare, c)
+}
+
+// NewTreeSetFromSliceFunc returns a new [TreeSet] containing the elements of slice c,
+// whose order is determined by the compare function.
+func NewTreeSetFromSliceFunc[T any](compare func(i T, j T) int, c []T) *TreeSet[T] {
+	set := &TreeSet[T]{objects: tree.NewRedBlackTreeFunc(compare), compare: compare}
 	if len(c) != 0 {
 		set.AddSlice(c)
 	}
@@ -81,6 +105,115 @@ func (s *TreeSet[T]) Remove(e T) bool {
 	return ok
 }
 
+// First returns the min element of s.
+// The method returns false if s is empty.
+func (s *TreeSet[T]) First() (T, bool) {
+	if s.IsEmpty() {
+
+		var result T
+
+		return result, false
+	}
+	return s.objects.Root().Min().Element(), true
+}
+
+// Last returns the max element of s.
+// The method returns false if s is empty.
+func (s *TreeSet[T]) Last() (T, bool) {
+	if s.IsEmpty() {
+
+		var result T
+
+		return result, false
+	}
+	return s.objects.Root().Max().Element(), true
+}
+
+// PollFirst removes the min element from s and returns the removed element.
+// The method returns false if s is empty.
+func (s *TreeSet[T]) PollFirst() (T, bool) {
+	result, ok := s.First()
+	if ok {
+		s.objects.Remove(result)
+	}
+	return result, ok
+}
+
+// PollLast removes the max element from s and returns the removed element.
+// The method returns false if s is empty.
+func (s *TreeSet[T]) PollLast() (T, bool) {
+	result, ok := s.Last()
+	if ok {
+		s.objects.Remove(result)
+	}
+	return result, ok
+}
+
+// Floor returns the greatest element of s less than or equal to e.
+// The method returns false if there is no such element.
+func (s *TreeSet[T]) Floor(e T) (T, bool) {
+	return nodeElement(s.objects.Floor(e))
+}
+
+// Ceiling returns the least element of s greater than or equal to e.
+// The method returns false if there is no such element.
+func (s *TreeSet[T]) Ceiling(e T) (T, bool) {
+	return nodeElement(s.objects.Ceiling(e))
+}
+
+// Lower returns the greatest element of s strictly less than e.
+// The method returns false if there is no such element.
+func (s *TreeSet[T]) Lower(e T) (T, bool) {
+	return nodeElement(s.objects.Lower(e))
+}
+
+// Higher returns the least element of s strictly greater than e.
+// The method returns false if there is no such element.
+func (s *TreeSet[T]) Higher(e T) (T, bool) {
+	return nodeElement(s.objects.Higher(e))
+}
+
+// SubSet returns a [TreeSubSet] containing the elements of s between from and to.
+// fromInclusive and toInclusive indicate if from and to are part of the range.
+//
+// The result is a view of s, so the modifications of one set are reflected on the other,
+// also for the elements added at s after the creation of the view.
+// The view does not copy the elements, so this method runs in O(1) time.
+func (s *TreeSet[T]) SubSet(from T, fromInclusive bool, to T, toInclusive bool) *TreeSubSet[T] {
+	return &TreeSubSet[T]{set: s, bounds: s.bounds().From(from, fromInclusive).To(to, toInclusive)}
+}
+
+// HeadSet returns a [TreeSubSet] containing the elements of s less than to,
+// or equal to it if inclusive is true.
+//
+// The result is a view of s, so the modifications of one set are reflected on the other.
+func (s *TreeSet[T]) HeadSet(to T, inclusive bool) *TreeSubSet[T] {
+	return &TreeSubSet[T]{set: s, bounds: s.bounds().To(to, inclusive)}
+}
+
+// TailSet returns a [TreeSubSet] containing the elements of s greater than from,
+// or equal to it if inclusive is true.
+//
+// The result is a view of s, so the modifications of one set are reflected on the other.
+func (s *TreeSet[T]) TailSet(from T, inclusive bool) *TreeSubSet[T] {
+	return &TreeSubSet[T]{set: s, bounds: s.bounds().From(from, inclusive)}
+}
+
+// Rank returns the number of elements of s strictly less than e.
+//
+// This method runs in O(log n) time.
+func (s *TreeSet[T]) Rank(e T) int {
+	return s.objects.Rank(e)
+}
+
+// Select returns the k-th smallest element of s, starting from 0.
+// The method returns false if k is out of bounds.
+//
+// This method runs in O(log n) time.
+func (s *TreeSet[T]) Select(k int) (T, bool) {
+	return nodeElement(s.objects.Select(k))
+}
+
 // Each executes fun for all elements of s.
 //
 // This method should be used to remove elements. Use Iter insted.
@@ -92,7 +225,9 @@ func (s *TreeSet[T]) Each(fun func(element T)) {
 
 // Stream returns a [Stream] rapresenting s.
 func (s *TreeSet[T]) Stream() *Stream[T] {
-	return NewStream[T](s, reflect.ValueOf(NewTreeSet[T]))
+	return NewStream[T](s, reflect.ValueOf(func() *TreeSet[T] {
+		return NewTreeSetFunc(s.compare)
+	}))
 }
 
 // Clear removes all element from s.
@@ -178,7 +313,7 @@ func (s *TreeSet[T]) Hash() uint64 {
 func (s *TreeSet[T]) Copy() Set[T] {
 	slice := s.ToSlice()
 	rand.Shuffle(len(slice), func(i, j int) { slice[i], slice[j] = slice[j], slice[i] })
-	result := NewTreeSet[T]()
+	result := NewTreeSetFunc(s.compare)
 	for _, i := range slice {
 		result.Add(util.Copy(i))
 	}
@@ -190,3 +325,71 @@ func (s *TreeSet[T]) String() string {
 	check := reflect.TypeOf(new(T)).String()
 	return fmt.Sprintf("TreeSet[%v]%v", check[1:], s.ToSlice())
 }
+
+// MarshalJSON returns the JSON encoding of s, which is an array containing its elements in ascending order.
+func (s *TreeSet[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of s with the ones decoded from the JSON array data.
+//
+// If s is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (s *TreeSet[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, s.decode)
+}
+
+// MarshalBinary returns the binary encoding of s, which contains its elements in ascending order.
+func (s *TreeSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(s.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of s with the ones decoded from the binary encoding data.
+//
+// If s is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (s *TreeSet[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, s.decode)
+}
+
+// GobEncode returns the binary encoding of s as [TreeSet.MarshalBinary].
+func (s *TreeSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [TreeSet.UnmarshalBinary].
+func (s *TreeSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of s with objects.
+func (s *TreeSet[T]) decode(objects []T) error {
+	if s.objects == nil {
+		compare := codec.Compare[T]()
+		if compare == nil {
+			return errors.New("Cannot decode a TreeSet whose elements do not implement util.Comparer")
+		}
+		*s = *NewTreeSetFunc(compare)
+	}
+	s.Clear()
+	s.AddSlice(objects)
+	return nil
+}
+
+func (s *TreeSet[T]) bounds() bound.Range[T, T] {
+	return bound.New(s.objects, s.compare, identity[T], identity[T])
+}
+
+func identity[T any](e T) T {
+	return e
+}
+
+func nodeElement[T any](node *tree.Node[T]) (T, bool) {
+	if node == nil {
+
+		var result T
+
+		return result, false
+	}
+	return node.Element(), true
+}
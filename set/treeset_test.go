@@ -1,6 +1,8 @@
 package set
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/potex02/structures"
@@ -33,6 +35,27 @@ func TestNewTreeSetFromSlice(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestNewTreeSetFunc(t *testing.T) {
+
+	var set Set[int] = NewTreeSetFunc(func(i int, j int) int { return j - i }, 4, -5, 2, 1, 4)
+
+	if set.Len() != 4 {
+		t.Log("length is not 4")
+		t.Fail()
+	}
+	if !reflect.DeepEqual(set.ToSlice(), []int{4, 2, 1, -5}) {
+		t.Log("set is", set)
+		t.Fail()
+	}
+	if !set.Remove(2) || set.Contains(2) {
+		t.Log("found 2 in set")
+		t.Fail()
+	}
+	if copy := set.Copy(); !reflect.DeepEqual(copy.ToSlice(), []int{4, 1, -5}) {
+		t.Log("copy is", copy)
+		t.Fail()
+	}
+}
 func TestContainsTreeSet(t *testing.T) {
 
 	var set Set[wrapper.Int] = NewTreeSet[wrapper.Int](4, -5, 2, 1)
@@ -108,3 +131,146 @@ func TestEqualTreeSet(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestSortedTreeSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int]()
+
+	for i := 0; i != 100000; i++ {
+		set.Add(wrapper.Int(i))
+	}
+	if set.Len() != 100000 {
+		t.Log("length is", set.Len())
+		t.Fail()
+	}
+	if !set.Contains(99999) {
+		t.Log("not found 99999 in set")
+		t.Fail()
+	}
+}
+func TestNavigationTreeSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](10, 20, 30, 40)
+
+	if e, ok := set.Floor(25); !ok || e != 20 {
+		t.Log("floor is", e)
+		t.Fail()
+	}
+	if e, ok := set.Ceiling(25); !ok || e != 30 {
+		t.Log("ceiling is", e)
+		t.Fail()
+	}
+	if e, ok := set.Lower(10); ok {
+		t.Log("lower is", e)
+		t.Fail()
+	}
+	if e, ok := set.Higher(30); !ok || e != 40 {
+		t.Log("higher is", e)
+		t.Fail()
+	}
+	if e, ok := set.PollFirst(); !ok || e != 10 {
+		t.Log("first is", e)
+		t.Fail()
+	}
+	if e, ok := set.PollLast(); !ok || e != 40 {
+		t.Log("last is", e)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(set.ToSlice(), []wrapper.Int{20, 30}) {
+		t.Log("set is", set)
+		t.Fail()
+	}
+	set.Clear()
+	if e, ok := set.First(); ok {
+		t.Log("first is", e)
+		t.Fail()
+	}
+}
+func TestSubSetTreeSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](10, 20, 30, 40, 50)
+
+	if slice := set.SubSet(20, true, 40, false).ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{20, 30}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if slice := set.SubSet(15, false, 40, true).ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{20, 30, 40}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if slice := set.HeadSet(30, true).ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{10, 20, 30}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if slice := set.TailSet(30, false).ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{40, 50}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if !set.SubSet(40, true, 20, true).IsEmpty() {
+		t.Log("subset is not empty")
+		t.Fail()
+	}
+	head := set.HeadSet(30, false)
+	set.Add(25, 35)
+	if slice := head.ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{10, 20, 25}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+}
+func TestRankTreeSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](50, 10, 40, 20, 30)
+
+	if rank := set.Rank(35); rank != 3 {
+		t.Log("rank is", rank)
+		t.Fail()
+	}
+	if e, ok := set.Select(1); !ok || e != 20 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if _, ok := set.Select(5); ok {
+		t.Log("found element 5")
+		t.Fail()
+	}
+	j := 0
+	for i := set.Iter(); !i.End(); {
+		index := i.(IndexedIterator[wrapper.Int]).Index()
+		if e, _ := set.Select(index); e != i.Element() || index != j {
+			t.Log("index of", i.Element(), "is", index)
+			t.Fail()
+		}
+		if i.Element() == 20 {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+			j++
+		}
+	}
+}
+func TestJSONTreeSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](3, 1, 2)
+
+	data, err := json.Marshal(set)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result TreeSet[wrapper.Int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(set) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	reverse := NewTreeSetFunc(func(i wrapper.Int, j wrapper.Int) int {
+		return j.Compare(i)
+	})
+	if err := json.Unmarshal(data, reverse); err != nil || !reflect.DeepEqual(reverse.ToSlice(), []wrapper.Int{3, 2, 1}) {
+		t.Log("result is", reverse, "err is", err)
+		t.Fail()
+	}
+	var zero TreeSet[[]int]
+	if err := json.Unmarshal([]byte("[[1]]"), &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
@@ -0,0 +1,318 @@
+package set
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/bound"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[wrapper.Int] = NewTreeSet[wrapper.Int]().TailSet(0, true)
+var _ Set[wrapper.Int] = NewTreeSet[wrapper.Int]().TailSet(0, true)
+
+// TreeSubSet provides a view of the elements of a [TreeSet] between two bounds.
+// It is returned by [TreeSet.SubSet], [TreeSet.HeadSet] and [TreeSet.TailSet].
+//
+// The view is not a copy: it shares its elements with the [TreeSet],
+// so the changes made on one of them, also after the creation of the view, are visible through the other.
+// The lookups are done directly on the tree of the [TreeSet] and run in O(log n) time.
+//
+// The elements out of the range of the view are ignored by the methods which add elements.
+//
+// It implements the interface [Set].
+type TreeSubSet[T any] struct {
+	// contains filtered or unexported fields
+	set    *TreeSet[T]
+	bounds bound.Range[T, T]
+}
+
+// Len returns the length of s.
+//
+// This method runs in O(log n) time.
+func (s *TreeSubSet[T]) Len() int {
+	return s.bounds.Len()
+}
+
+// IsEmpty returns a bool which indicates if s is empty or not.
+func (s *TreeSubSet[T]) IsEmpty() bool {
+	return s.bounds.First() == nil
+}
+
+// Contains returns if e is present in s.
+func (s *TreeSubSet[T]) Contains(e T) bool {
+	return s.bounds.Find(e) != nil
+}
+
+// ToSlice returns a slice which contains all elements of s.
+func (s *TreeSubSet[T]) ToSlice() []T {
+	result := make([]T, 0)
+	for i := range s.bounds.RangeIter() {
+		result = append(result, i)
+	}
+	return result
+}
+
+// Add adds the elements e at s.
+// The elements out of the range of s are ignored.
+func (s *TreeSubSet[T]) Add(e ...T) {
+	s.AddSlice(e)
+}
+
+// AddSlice adds the elements of e at s.
+// The elements out of the range of s are ignored.
+func (s *TreeSubSet[T]) AddSlice(e []T) {
+	for _, i := range e {
+		if s.bounds.InRange(i) && !s.set.Contains(i) {
+			s.set.objects.Add(i)
+		}
+	}
+}
+
+// Remove removes the element e from s if it is present.
+// In that case, the method returns true, otherwhise it returns false.
+func (s *TreeSubSet[T]) Remove(e T) bool {
+	if !s.bounds.InRange(e) {
+		return false
+	}
+	return s.set.Remove(e)
+}
+
+// First returns the min element of s.
+// The method returns false if s is empty.
+func (s *TreeSubSet[T]) First() (T, bool) {
+	return nodeElement(s.bounds.First())
+}
+
+// Last returns the max element of s.
+// The method returns false if s is empty.
+func (s *TreeSubSet[T]) Last() (T, bool) {
+	return nodeElement(s.bounds.Last())
+}
+
+// PollFirst removes the min element from s and returns the removed element.
+// The method returns false if s is empty.
+func (s *TreeSubSet[T]) PollFirst() (T, bool) {
+	result, ok := s.First()
+	if ok {
+		s.set.Remove(result)
+	}
+	return result, ok
+}
+
+// PollLast removes the max element from s and returns the removed element.
+// The method returns false if s is empty.
+func (s *TreeSubSet[T]) PollLast() (T, bool) {
+	result, ok := s.Last()
+	if ok {
+		s.set.Remove(result)
+	}
+	return result, ok
+}
+
+// Floor returns the greatest element of s less than or equal to e.
+// The method returns false if there is no such element.
+func (s *TreeSubSet[T]) Floor(e T) (T, bool) {
+	return nodeElement(s.bounds.Floor(e))
+}
+
+// Ceiling returns the least element of s greater than or equal to e.
+// The method returns false if there is no such element.
+func (s *TreeSubSet[T]) Ceiling(e T) (T, bool) {
+	return nodeElement(s.bounds.Ceiling(e))
+}
+
+// Lower returns the greatest element of s strictly less than e.
+// The method returns false if there is no such element.
+func (s *TreeSubSet[T]) Lower(e T) (T, bool) {
+	return nodeElement(s.bounds.Lower(e))
+}
+
+// Higher returns the least element of s strictly greater than e.
+// The method returns false if there is no such element.
+func (s *TreeSubSet[T]) Higher(e T) (T, bool) {
+	return nodeElement(s.bounds.Higher(e))
+}
+
+// SubSet returns a [TreeSubSet] containing the elements of s between from and to.
+// fromInclusive and toInclusive indicate if from and to are part of the range.
+//
+// The range of the result is the intersection between the range of s and the passed one.
+func (s *TreeSubSet[T]) SubSet(from T, fromInclusive bool, to T, toInclusive bool) *TreeSubSet[T] {
+	return &TreeSubSet[T]{set: s.set, bounds: s.bounds.From(from, fromInclusive).To(to, toInclusive)}
+}
+
+// HeadSet returns a [TreeSubSet] containing the elements of s less than to,
+// or equal to it if inclusive is true.
+func (s *TreeSubSet[T]) HeadSet(to T, inclusive bool) *TreeSubSet[T] {
+	return &TreeSubSet[T]{set: s.set, bounds: s.bounds.To(to, inclusive)}
+}
+
+// TailSet returns a [TreeSubSet] containing the elements of s greater than from,
+// or equal to it if inclusive is true.
+func (s *TreeSubSet[T]) TailSet(from T, inclusive bool) *TreeSubSet[T] {
+	return &TreeSubSet[T]{set: s.set, bounds: s.bounds.From(from, inclusive)}
+}
+
+// Each executes fun for all elements of s.
+//
+// This method should be used to remove elements. Use Iter insted.
+func (s *TreeSubSet[T]) Each(fun func(element T)) {
+	for i := range s.bounds.RangeIter() {
+		fun(i)
+	}
+}
+
+// Stream returns a [Stream] rapresenting s.
+//
+// The Collect methods of the stream return a new [TreeSet] with the same order of s.
+func (s *TreeSubSet[T]) Stream() *Stream[T] {
+	return NewStream[T](s, reflect.ValueOf(func() *TreeSet[T] {
+		return NewTreeSetFunc(s.set.compare)
+	}))
+}
+
+// Clear removes all element of s from the [TreeSet].
+// The elements out of the range of s are kept.
+func (s *TreeSubSet[T]) Clear() {
+	for node := s.bounds.First(); node != nil; node = s.bounds.First() {
+		s.set.objects.Remove(node.Element())
+	}
+}
+
+// Iter returns an [Iterator] which permits to iterate a [TreeSubSet].
+//
+//	for i := s.Iter(); !i.End(); i = i.Next() {
+//		element := i.Element()
+//		// Code
+//	}
+func (s *TreeSubSet[T]) Iter() Iterator[T] {
+	return NewTreeSubSetIterator(s)
+}
+
+// RangeIter returns a function that allows to iterate a [TreeSubSet] using the range keyword.
+//
+//	for i := range s.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [TreeSubSet.Iter], it doesn't allow to remove elements during the iteration.
+func (s *TreeSubSet[T]) RangeIter() func(yield func(T) bool) {
+	return s.bounds.RangeIter()
+}
+
+// Equal returns true if s and st are both sets and have the same length and contains the same elements.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [HashSet],
+// but the elements of s and the elements of st are equals, this method returns anyway true.
+func (s *TreeSubSet[T]) Equal(st any) bool {
+	set, ok := st.(Set[T])
+	if ok && s != nil && set != nil {
+		if s.Len() != set.Len() {
+			return false
+		}
+		for i := range s.bounds.RangeIter() {
+			if !set.Contains(i) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Compare returns 0 if s and st have the same length,
+// -1 if s is shorten than st,
+// 1 if s is longer than st,
+// -2 if st is not a [Set] or if one between s and st is nil.
+func (s *TreeSubSet[T]) Compare(st any) int {
+	set, ok := st.(Set[T])
+	if ok && s != nil && set != nil {
+		if s.Len() < set.Len() {
+			return -1
+		}
+		if s.Len() > set.Len() {
+			return 1
+		}
+		return 0
+	}
+	return -2
+}
+
+// Hash returns the hash code of s, which is the same of a [TreeSet] containing the elements of s.
+func (s *TreeSubSet[T]) Hash() uint64 {
+	return s.bounds.Hash()
+}
+
+// Copy returns a set containing a copy of the elements of s.
+// The result of this method is of type [Set], but the effective table which is created is a [TreeSet]
+// with the same order of s, which does not share its elements with s.
+//
+// This method uses [util.Copy] to make copies of the elements.
+func (s *TreeSubSet[T]) Copy() Set[T] {
+	result := NewTreeSetFunc(s.set.compare)
+	for i := range s.bounds.RangeIter() {
+		result.Add(util.Copy(i))
+	}
+	return result
+}
+
+// String returns a rapresentation of s in the form of a string.
+func (s *TreeSubSet[T]) String() string {
+	check := reflect.TypeOf(new(T)).String()
+	return fmt.Sprintf("TreeSubSet[%v]%v", check[1:], s.ToSlice())
+}
+
+// MarshalJSON returns the JSON encoding of s, which is an array containing its elements in ascending order.
+func (s *TreeSubSet[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of s with the ones decoded from the JSON array data.
+//
+// The method returns an error if s is the zero value or if one of the elements is out of the range of s.
+func (s *TreeSubSet[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, s.decode)
+}
+
+// MarshalBinary returns the binary encoding of s, which contains its elements in ascending order.
+func (s *TreeSubSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(s.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of s with the ones decoded from the binary encoding data.
+//
+// The method returns an error if s is the zero value or if one of the elements is out of the range of s.
+func (s *TreeSubSet[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, s.decode)
+}
+
+// GobEncode returns the binary encoding of s as [TreeSubSet.MarshalBinary].
+func (s *TreeSubSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [TreeSubSet.UnmarshalBinary].
+func (s *TreeSubSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of s with objects.
+func (s *TreeSubSet[T]) decode(objects []T) error {
+	if s.set == nil {
+		return errors.New("Cannot decode a TreeSubSet which is not associated at a TreeSet")
+	}
+	for _, i := range objects {
+		if !s.bounds.InRange(i) {
+			return errors.New("Cannot decode an element out of the range of the TreeSubSet")
+		}
+	}
+	s.Clear()
+	s.set.AddSlice(objects)
+	return nil
+}
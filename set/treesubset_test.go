@@ -0,0 +1,179 @@
+package set
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestTreeSubSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](10, 20, 30, 40, 50)
+	var sub Set[wrapper.Int] = set.SubSet(20, true, 40, false)
+
+	if sub.Len() != 2 {
+		t.Log("length is", sub.Len())
+		t.Fail()
+	}
+	set.Add(25, 40, 45)
+	if sub.Len() != 3 || !sub.Contains(25) || sub.Contains(40) {
+		t.Log("sub is", sub)
+		t.Fail()
+	}
+	if !sub.Equal(NewHashSet[wrapper.Int](20, 25, 30)) || sub.Hash() != NewTreeSet[wrapper.Int](20, 25, 30).Hash() {
+		t.Log("sub is", sub)
+		t.Fail()
+	}
+	if copy := sub.Copy(); !reflect.DeepEqual(copy.ToSlice(), []wrapper.Int{20, 25, 30}) {
+		t.Log("copy is", copy)
+		t.Fail()
+	}
+	if sub.String() != "TreeSubSet[wrapper.Int][20 25 30]" {
+		t.Log("string is", sub.String())
+		t.Fail()
+	}
+	var collected Set[wrapper.Int] = set.SubSet(20, true, 40, false).Stream().Collect()
+	if _, ok := collected.(*TreeSet[wrapper.Int]); !ok || !collected.Equal(sub) {
+		t.Log("result is", collected)
+		t.Fail()
+	}
+}
+func TestAddRemoveTreeSubSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](10, 20, 30, 40, 50)
+	var sub *TreeSubSet[wrapper.Int] = set.TailSet(30, false)
+
+	sub.Add(45)
+	if !set.Contains(45) {
+		t.Log("not found 45 in set")
+		t.Fail()
+	}
+	if sub.Remove(20) || !set.Contains(20) {
+		t.Log("removed 20 from set")
+		t.Fail()
+	}
+	if !sub.Remove(40) || set.Contains(40) {
+		t.Log("found 40 in set")
+		t.Fail()
+	}
+	sub.Add(60, 30)
+	if !set.Contains(60) || !set.Contains(30) || set.Len() != 6 {
+		t.Log("set is", set)
+		t.Fail()
+	}
+	sub.Add(5)
+	if set.Contains(5) {
+		t.Log("found 5 in set")
+		t.Fail()
+	}
+}
+func TestNavigationTreeSubSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](10, 20, 30, 40, 50)
+	var sub *TreeSubSet[wrapper.Int] = set.SubSet(15, true, 45, true)
+
+	if e, ok := sub.First(); !ok || e != 20 {
+		t.Log("first is", e)
+		t.Fail()
+	}
+	if e, ok := sub.Last(); !ok || e != 40 {
+		t.Log("last is", e)
+		t.Fail()
+	}
+	if e, ok := sub.Floor(100); !ok || e != 40 {
+		t.Log("floor is", e)
+		t.Fail()
+	}
+	if e, ok := sub.Ceiling(0); !ok || e != 20 {
+		t.Log("ceiling is", e)
+		t.Fail()
+	}
+	if e, ok := sub.Lower(20); ok {
+		t.Log("lower is", e)
+		t.Fail()
+	}
+	if e, ok := sub.Higher(40); ok {
+		t.Log("higher is", e)
+		t.Fail()
+	}
+	if slice := sub.HeadSet(50, true).TailSet(30, false).ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{40}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if e, ok := sub.PollFirst(); !ok || e != 20 || set.Contains(20) {
+		t.Log("first is", e)
+		t.Fail()
+	}
+	sub.Clear()
+	if !reflect.DeepEqual(set.ToSlice(), []wrapper.Int{10, 50}) {
+		t.Log("set is", set)
+		t.Fail()
+	}
+}
+func TestIterTreeSubSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](10, 20, 30, 40, 50)
+	var sub *TreeSubSet[wrapper.Int] = set.HeadSet(40, true)
+
+	j := 0
+	for i := sub.Iter(); !i.End(); {
+		if index := i.(IndexedIterator[wrapper.Int]).Index(); index != j {
+			t.Log("index of", i.Element(), "is", index)
+			t.Fail()
+		}
+		if i.Element()%20 == 0 {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+			j++
+		}
+	}
+	if !reflect.DeepEqual(set.ToSlice(), []wrapper.Int{10, 30, 50}) {
+		t.Log("set is", set)
+		t.Fail()
+	}
+	if !set.SubSet(35, true, 45, true).Iter().End() {
+		t.Log("iterator is not ended")
+		t.Fail()
+	}
+}
+func TestJSONTreeSubSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](10, 20, 30)
+	var sub *TreeSubSet[wrapper.Int] = set.TailSet(20, true)
+
+	data, err := json.Marshal(sub)
+	if err != nil || string(data) != "[20,30]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte("[25]"), sub); err != nil || !reflect.DeepEqual(set.ToSlice(), []wrapper.Int{10, 25}) {
+		t.Log("set is", set, "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte("[5]"), sub); err == nil || !reflect.DeepEqual(set.ToSlice(), []wrapper.Int{10, 25}) {
+		t.Log("set is", set, "err is", err)
+		t.Fail()
+	}
+	var zero TreeSubSet[wrapper.Int]
+	if err := json.Unmarshal(data, &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestBinaryTreeSubSet(t *testing.T) {
+
+	var set *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](10, 20, 30)
+
+	data, err := set.HeadSet(20, true).MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := set.TailSet(25, true).UnmarshalBinary(data); err == nil || set.Len() != 3 {
+		t.Log("set is", set, "err is", err)
+		t.Fail()
+	}
+}
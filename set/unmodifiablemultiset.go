@@ -0,0 +1,175 @@
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[wrapper.Int] = UnmodifiableMulti[wrapper.Int](NewMultiHashSet[wrapper.Int]())
+var _ MultiSet[wrapper.Int] = UnmodifiableMulti[wrapper.Int](NewMultiHashSet[wrapper.Int]())
+
+// UnmodifiableMultiSet provides a read-only view of a [MultiSet].
+//
+// The view is not a copy: all the changes made on the wrapped set are visible through it.
+// The methods which modify the set panic with [structures.ErrUnmodifiable].
+//
+// It implements the interface [MultiSet].
+type UnmodifiableMultiSet[T any] struct {
+	// contains filtered or unexported fields
+	set MultiSet[T]
+}
+
+// UnmodifiableMulti returns a new [UnmodifiableMultiSet] associated at the set s.
+//
+// If s is already an [UnmodifiableMultiSet], it is returned unchanged.
+func UnmodifiableMulti[T any](s MultiSet[T]) *UnmodifiableMultiSet[T] {
+	if set, ok := s.(*UnmodifiableMultiSet[T]); ok {
+		return set
+	}
+	return &UnmodifiableMultiSet[T]{set: s}
+}
+
+// Len returns the length of s.
+func (s *UnmodifiableMultiSet[T]) Len() int {
+	return s.set.Len()
+}
+
+// IsEmpty returns a bool which indicates if s is empty or not.
+func (s *UnmodifiableMultiSet[T]) IsEmpty() bool {
+	return s.set.IsEmpty()
+}
+
+// Contains returns if e is present in s.
+func (s *UnmodifiableMultiSet[T]) Contains(e T) bool {
+	return s.set.Contains(e)
+}
+
+// Count returns the number of occurrences of e in s.
+func (s *UnmodifiableMultiSet[T]) Count(e T) int {
+	return s.set.Count(e)
+}
+
+// ToSlice returns a slice which contains all elements of s.
+func (s *UnmodifiableMultiSet[T]) ToSlice() []T {
+	return s.set.ToSlice()
+}
+
+// ToSet returns a new modifiable [Set] containing the elements of s.
+func (s *UnmodifiableMultiSet[T]) ToSet() Set[T] {
+	return s.set.ToSet()
+}
+
+// Add always panics with [structures.ErrUnmodifiable].
+func (s *UnmodifiableMultiSet[T]) Add(e ...T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// AddSlice always panics with [structures.ErrUnmodifiable].
+func (s *UnmodifiableMultiSet[T]) AddSlice(e []T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Remove always panics with [structures.ErrUnmodifiable].
+func (s *UnmodifiableMultiSet[T]) Remove(e T) bool {
+	panic(structures.ErrUnmodifiable)
+}
+
+// RemoveAll always panics with [structures.ErrUnmodifiable].
+func (s *UnmodifiableMultiSet[T]) RemoveAll(e T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Each executes fun for all elements of s.
+func (s *UnmodifiableMultiSet[T]) Each(fun func(element T)) {
+	s.set.Each(fun)
+}
+
+// Stream returns a [Stream] rapresenting s.
+func (s *UnmodifiableMultiSet[T]) Stream() *Stream[T] {
+	return s.set.Stream()
+}
+
+// Clear always panics with [structures.ErrUnmodifiable].
+func (s *UnmodifiableMultiSet[T]) Clear() {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Iter returns an [Iterator] which permits to iterate s.
+// The Remove method of the iterator panics with [structures.ErrUnmodifiable].
+//
+//	for i := s.Iter(); !i.End(); i = i.Next() {
+//		element := i.Element()
+//		// Code
+//	}
+func (s *UnmodifiableMultiSet[T]) Iter() Iterator[T] {
+	return NewUnmodifiableSetIterator(s.set.Iter())
+}
+
+// RangeIter returns a function that allows to iterate s using the range keyword.
+//
+//	for i := range s.RangeIter() {
+//		// Code
+//	}
+func (s *UnmodifiableMultiSet[T]) RangeIter() func(yield func(T) bool) {
+	return s.set.RangeIter()
+}
+
+// Equal returns true if the wrapped set is equal to st.
+// In any other case, it returns false.
+func (s *UnmodifiableMultiSet[T]) Equal(st any) bool {
+	return s.set.Equal(st)
+}
+
+// Compare returns the comparison between the wrapped set and st.
+func (s *UnmodifiableMultiSet[T]) Compare(st any) int {
+	return s.set.Compare(st)
+}
+
+// Hash returns the hash code of s.
+func (s *UnmodifiableMultiSet[T]) Hash() uint64 {
+	return s.set.Hash()
+}
+
+// Copy returns a modifiable copy of the wrapped set.
+func (s *UnmodifiableMultiSet[T]) Copy() MultiSet[T] {
+	return s.set.Copy()
+}
+
+// String returns a rapresentation of s in the form of a string.
+func (s *UnmodifiableMultiSet[T]) String() string {
+	return fmt.Sprintf("Unmodifiable%v", s.set)
+}
+
+// MarshalJSON returns the JSON encoding of the wrapped set.
+func (s *UnmodifiableMultiSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.set)
+}
+
+// UnmarshalJSON always returns [structures.ErrUnmodifiable].
+func (s *UnmodifiableMultiSet[T]) UnmarshalJSON(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// MarshalBinary returns the binary encoding of the wrapped set.
+func (s *UnmodifiableMultiSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(s.set.ToSlice())
+}
+
+// UnmarshalBinary always returns [structures.ErrUnmodifiable].
+func (s *UnmodifiableMultiSet[T]) UnmarshalBinary(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// GobEncode returns the binary encoding of s as [UnmodifiableMultiSet.MarshalBinary].
+func (s *UnmodifiableMultiSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [UnmodifiableMultiSet.UnmarshalBinary].
+func (s *UnmodifiableMultiSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
@@ -0,0 +1,89 @@
+package set
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewUnmodifiableMultiSet(t *testing.T) {
+
+	var wrapped *MultiTreeSet[wrapper.Int] = NewMultiTreeSet[wrapper.Int](3, 1, 1)
+	var set ReadOnlyMultiSet[wrapper.Int] = UnmodifiableMulti[wrapper.Int](wrapped)
+
+	if set.Len() != 3 || set.IsEmpty() || set.Count(1) != 2 {
+		t.Log("length is", set.Len())
+		t.Fail()
+	}
+	if set.String() != "UnmodifiableMultiTreeSet[wrapper.Int][1 1 3]" {
+		t.Log("set is", set)
+		t.Fail()
+	}
+	if view := UnmodifiableMulti[wrapper.Int](wrapped); UnmodifiableMulti[wrapper.Int](view) != view {
+		t.Log("set has been wrapped twice")
+		t.Fail()
+	}
+	if !set.Equal(wrapped) || !wrapped.Equal(set) || set.Hash() != wrapped.Hash() {
+		t.Log("sets are not equals")
+		t.Fail()
+	}
+	wrapped.Add(3)
+	if set.Len() != 4 || set.Count(3) != 2 {
+		t.Log("set objects are", set.ToSlice())
+		t.Fail()
+	}
+	result := UnmodifiableMulti[wrapper.Int](wrapped).Copy()
+	result.Add(5)
+	if set.Len() != 4 || result.Len() != 5 {
+		t.Log("copy is", result)
+		t.Fail()
+	}
+}
+func TestModifyUnmodifiableMultiSet(t *testing.T) {
+
+	var set *UnmodifiableMultiSet[wrapper.Int] = UnmodifiableMulti[wrapper.Int](NewMultiHashSet[wrapper.Int](1, 1, 2))
+
+	for _, i := range []func(){
+		func() { set.Add(5) },
+		func() { set.AddSlice([]wrapper.Int{5}) },
+		func() { set.Remove(1) },
+		func() { set.RemoveAll(1) },
+		func() { set.Clear() },
+		func() { set.Iter().Remove() },
+	} {
+		if !checkUnmodifiable(i) {
+			t.Log("set has been modified")
+			t.Fail()
+		}
+	}
+	if set.Len() != 3 || set.ToSet().Len() != 2 {
+		t.Log("set objects are", set.ToSlice())
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte("[1]"), set); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestIterUnmodifiableMultiSet(t *testing.T) {
+
+	var set *UnmodifiableMultiSet[wrapper.Int] = UnmodifiableMulti[wrapper.Int](NewMultiTreeSet[wrapper.Int](2, 1, 2))
+
+	elements := make([]wrapper.Int, 0)
+	for i := set.Iter(); !i.End(); i = i.Next() {
+		elements = append(elements, i.Element())
+	}
+	if !slices.Equal(elements, []wrapper.Int{1, 2, 2}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+	data, err := json.Marshal(set)
+	if err != nil || string(data) != "[1,2,2]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+}
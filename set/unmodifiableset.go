@@ -0,0 +1,163 @@
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[wrapper.Int] = Unmodifiable[wrapper.Int](NewHashSet[wrapper.Int]())
+var _ Set[wrapper.Int] = Unmodifiable[wrapper.Int](NewHashSet[wrapper.Int]())
+
+// UnmodifiableSet provides a read-only view of a [Set].
+//
+// The view is not a copy: all the changes made on the wrapped set are visible through it.
+// The methods which modify the set panic with [structures.ErrUnmodifiable].
+//
+// It implements the interface [Set].
+type UnmodifiableSet[T any] struct {
+	// contains filtered or unexported fields
+	set Set[T]
+}
+
+// Unmodifiable returns a new [UnmodifiableSet] associated at the set s.
+//
+// If s is already an [UnmodifiableSet], it is returned unchanged.
+func Unmodifiable[T any](s Set[T]) *UnmodifiableSet[T] {
+	if set, ok := s.(*UnmodifiableSet[T]); ok {
+		return set
+	}
+	return &UnmodifiableSet[T]{set: s}
+}
+
+// Len returns the length of s.
+func (s *UnmodifiableSet[T]) Len() int {
+	return s.set.Len()
+}
+
+// IsEmpty returns a bool which indicates if s is empty or not.
+func (s *UnmodifiableSet[T]) IsEmpty() bool {
+	return s.set.IsEmpty()
+}
+
+// Contains returns if e is present in s.
+func (s *UnmodifiableSet[T]) Contains(e T) bool {
+	return s.set.Contains(e)
+}
+
+// ToSlice returns a slice which contains all elements of s.
+func (s *UnmodifiableSet[T]) ToSlice() []T {
+	return s.set.ToSlice()
+}
+
+// Add always panics with [structures.ErrUnmodifiable].
+func (s *UnmodifiableSet[T]) Add(e ...T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// AddSlice always panics with [structures.ErrUnmodifiable].
+func (s *UnmodifiableSet[T]) AddSlice(e []T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Remove always panics with [structures.ErrUnmodifiable].
+func (s *UnmodifiableSet[T]) Remove(e T) bool {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Each executes fun for all elements of s.
+func (s *UnmodifiableSet[T]) Each(fun func(element T)) {
+	s.set.Each(fun)
+}
+
+// Stream returns a [Stream] rapresenting s.
+func (s *UnmodifiableSet[T]) Stream() *Stream[T] {
+	return s.set.Stream()
+}
+
+// Clear always panics with [structures.ErrUnmodifiable].
+func (s *UnmodifiableSet[T]) Clear() {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Iter returns an [Iterator] which permits to iterate s.
+// The Remove method of the iterator panics with [structures.ErrUnmodifiable].
+//
+//	for i := s.Iter(); !i.End(); i = i.Next() {
+//		element := i.Element()
+//		// Code
+//	}
+func (s *UnmodifiableSet[T]) Iter() Iterator[T] {
+	return NewUnmodifiableSetIterator(s.set.Iter())
+}
+
+// RangeIter returns a function that allows to iterate s using the range keyword.
+//
+//	for i := range s.RangeIter() {
+//		// Code
+//	}
+func (s *UnmodifiableSet[T]) RangeIter() func(yield func(T) bool) {
+	return s.set.RangeIter()
+}
+
+// Equal returns true if s and st are both sets and have the same length and contains the same elements.
+// In any other case, it returns false.
+func (s *UnmodifiableSet[T]) Equal(st any) bool {
+	return s.set.Equal(st)
+}
+
+// Compare returns 0 if s and st have the same length,
+// -1 if s is shorten than st,
+// 1 if s is longer than st,
+// -2 if st is not a [Set] or if one between s and st is nil.
+func (s *UnmodifiableSet[T]) Compare(st any) int {
+	return s.set.Compare(st)
+}
+
+// Hash returns the hash code of s.
+func (s *UnmodifiableSet[T]) Hash() uint64 {
+	return s.set.Hash()
+}
+
+// Copy returns a modifiable copy of the wrapped set.
+func (s *UnmodifiableSet[T]) Copy() Set[T] {
+	return s.set.Copy()
+}
+
+// String returns a rapresentation of s in the form of a string.
+func (s *UnmodifiableSet[T]) String() string {
+	return fmt.Sprintf("Unmodifiable%v", s.set)
+}
+
+// MarshalJSON returns the JSON encoding of the wrapped set.
+func (s *UnmodifiableSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.set)
+}
+
+// UnmarshalJSON always returns [structures.ErrUnmodifiable].
+func (s *UnmodifiableSet[T]) UnmarshalJSON(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// MarshalBinary returns the binary encoding of the wrapped set.
+func (s *UnmodifiableSet[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(s.set.ToSlice())
+}
+
+// UnmarshalBinary always returns [structures.ErrUnmodifiable].
+func (s *UnmodifiableSet[T]) UnmarshalBinary(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// GobEncode returns the binary encoding of s as [UnmodifiableSet.MarshalBinary].
+func (s *UnmodifiableSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [UnmodifiableSet.UnmarshalBinary].
+func (s *UnmodifiableSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
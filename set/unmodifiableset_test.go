@@ -0,0 +1,132 @@
+package set
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func checkUnmodifiable(fun func()) (result bool) {
+	defer func() {
+		err, ok := recover().(error)
+		result = ok && errors.Is(err, structures.ErrUnmodifiable)
+	}()
+	fun()
+	return false
+}
+func TestNewUnmodifiableSet(t *testing.T) {
+
+	var wrapped *TreeSet[wrapper.Int] = NewTreeSet[wrapper.Int](3, 1, 2)
+	var set Set[wrapper.Int] = Unmodifiable[wrapper.Int](wrapped)
+
+	if set.Len() != 3 || set.IsEmpty() {
+		t.Log("length is", set.Len())
+		t.Fail()
+	}
+	if set.String() != "UnmodifiableTreeSet[wrapper.Int][1 2 3]" {
+		t.Log("set is", set)
+		t.Fail()
+	}
+	if Unmodifiable(set) != set {
+		t.Log("set has been wrapped twice")
+		t.Fail()
+	}
+	if !set.Equal(wrapped) || !wrapped.Equal(set) || set.Compare(wrapped) != 0 || set.Hash() != wrapped.Hash() {
+		t.Log("sets are not equals")
+		t.Fail()
+	}
+	wrapped.Add(4)
+	if set.Len() != 4 || !set.Contains(4) {
+		t.Log("set objects are", set.ToSlice())
+		t.Fail()
+	}
+	result := set.Copy()
+	result.Add(5)
+	if set.Len() != 4 || result.Len() != 5 {
+		t.Log("copy is", result)
+		t.Fail()
+	}
+}
+func TestModifyUnmodifiableSet(t *testing.T) {
+
+	var set *UnmodifiableSet[wrapper.Int] = Unmodifiable[wrapper.Int](NewHashSet[wrapper.Int](1, 2, 3))
+
+	for _, i := range []func(){
+		func() { set.Add(5) },
+		func() { set.AddSlice([]wrapper.Int{5}) },
+		func() { set.Remove(1) },
+		func() { set.Clear() },
+		func() { set.Iter().Remove() },
+	} {
+		if !checkUnmodifiable(i) {
+			t.Log("set has been modified")
+			t.Fail()
+		}
+	}
+	if set.Len() != 3 {
+		t.Log("set objects are", set.ToSlice())
+		t.Fail()
+	}
+}
+func TestIterUnmodifiableSet(t *testing.T) {
+
+	var set *UnmodifiableSet[wrapper.Int] = Unmodifiable[wrapper.Int](NewTreeSet[wrapper.Int](1, 2, 3))
+
+	elements := make([]wrapper.Int, 0)
+	for i := set.Iter(); !i.End(); i = i.Next() {
+		elements = append(elements, i.Element())
+	}
+	if !slices.Equal(elements, []wrapper.Int{1, 2, 3}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+	elements = make([]wrapper.Int, 0)
+	for i := range set.RangeIter() {
+		elements = append(elements, i)
+	}
+	if !slices.Equal(elements, []wrapper.Int{1, 2, 3}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+	if !Unmodifiable[wrapper.Int](NewHashSet[wrapper.Int]()).Iter().End() {
+		t.Log("iterator is not ended")
+		t.Fail()
+	}
+}
+func TestJSONUnmodifiableSet(t *testing.T) {
+
+	var set *UnmodifiableSet[wrapper.Int] = Unmodifiable[wrapper.Int](NewTreeSet[wrapper.Int](1, 2))
+
+	data, err := json.Marshal(set)
+	if err != nil || string(data) != "[1,2]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal(data, set); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestBinaryUnmodifiableSet(t *testing.T) {
+
+	var set *UnmodifiableSet[wrapper.Int] = Unmodifiable[wrapper.Int](NewTreeSet[wrapper.Int](1, 2))
+	var result *HashSet[wrapper.Int] = NewHashSet[wrapper.Int]()
+
+	data, err := set.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !result.Equal(set) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if err := set.UnmarshalBinary(data); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
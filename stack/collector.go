@@ -0,0 +1,16 @@
+package stack
+
+import "github.com/potex02/structures"
+
+// ToStack returns a [structures.Collector] which collects the elements into a [Stack].
+// The elements are pushed in order, so the last element is at the top of the stack.
+func ToStack[T any]() structures.Collector[T, *Stack[T]] {
+	return structures.NewCollector(func() *Stack[T] {
+		return NewStack[T]()
+	}, func(result *Stack[T], element T) *Stack[T] {
+		result.Push(element)
+		return result
+	}, func(result *Stack[T]) *Stack[T] {
+		return result
+	})
+}
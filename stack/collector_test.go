@@ -0,0 +1,16 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestToStack(t *testing.T) {
+
+	var stack *Stack[int] = ToStack[int]().Collect(slices.Values([]int{1, 2, 3}))
+
+	if e, ok := stack.Top(); !ok || e != 3 || stack.Len() != 3 {
+		t.Log("stack is", stack)
+		t.Fail()
+	}
+}
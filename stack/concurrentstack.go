@@ -0,0 +1,225 @@
+package stack
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync/atomic"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+)
+
+var _ structures.Structure[int] = NewConcurrentStack[int]()
+
+// ConcurrentStack provides a generic LIFO structure which can be used concurrently without locks.
+// The stack is the Treiber stack, implemented through a series of linked [structures.AtomicEntry] starting from the top.
+//
+// Push and Pop are atomic, even when more elements are pushed together.
+// Len, ToSlice, Equal, Compare, Hash and String are instead weakly consistent:
+// they read the stack while other goroutines can modify it.
+type ConcurrentStack[T any] struct {
+	// contains filtered or unexported fields
+	top atomic.Pointer[structures.AtomicEntry[T]]
+	len atomic.Int64
+}
+
+// NewConcurrentStack returns a new [ConcurrentStack] containing the elements c.
+// The top of the stack is the last element of c.
+//
+// if no argument is passed, it will be created an empty [ConcurrentStack].
+func NewConcurrentStack[T any](c ...T) *ConcurrentStack[T] {
+	return NewConcurrentStackFromSlice(c)
+}
+
+// NewConcurrentStackFromSlice returns a new [ConcurrentStack] containing the elements of slice c.
+// The top of the stack is the last element of c.
+func NewConcurrentStackFromSlice[T any](c []T) *ConcurrentStack[T] {
+	stack := &ConcurrentStack[T]{}
+	if len(c) != 0 {
+		stack.Push(c...)
+	}
+	return stack
+}
+
+// Len returns the length of s.
+func (s *ConcurrentStack[T]) Len() int {
+	return int(s.len.Load())
+}
+
+// IsEmpty returns a bool which indicate if s is empty or not.
+func (s *ConcurrentStack[T]) IsEmpty() bool {
+	return s.top.Load() == nil
+}
+
+// Top returns the top element of s.
+// The method returns false if s is empty.
+func (s *ConcurrentStack[T]) Top() (T, bool) {
+	top := s.top.Load()
+	if top == nil {
+
+		var result T
+
+		return result, false
+	}
+	return top.Element(), true
+}
+
+// ToSlice returns a slice which contains all elements of s.
+// The last element of the slice is the top of the stack.
+func (s *ConcurrentStack[T]) ToSlice() []T {
+	slice := make([]T, 0, s.Len())
+	for i := s.top.Load(); i != nil; i = i.Next() {
+		slice = append(slice, i.Element())
+	}
+	slices.Reverse(slice)
+	return slice
+}
+
+// Push adds the elements e at the top of s.
+// The last element of e becomes the top of the stack.
+func (s *ConcurrentStack[T]) Push(e ...T) {
+	if len(e) == 0 {
+		return
+	}
+	elements := slices.Clone(e)
+	slices.Reverse(elements)
+	first, last := structures.NewAtomicEntrySlice(elements)
+	s.len.Add(int64(len(e)))
+	for {
+		top := s.top.Load()
+		last.SetNext(top)
+		if s.top.CompareAndSwap(top, first) {
+			return
+		}
+	}
+}
+
+// Pop removes an element from the top of s and returns the removed element.
+// The method returns false if s is empty.
+func (s *ConcurrentStack[T]) Pop() (T, bool) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+
+			var result T
+
+			return result, false
+		}
+		if s.top.CompareAndSwap(top, top.Next()) {
+			s.len.Add(-1)
+			return top.Element(), true
+		}
+	}
+}
+
+// Clear removes all element from s.
+func (s *ConcurrentStack[T]) Clear() {
+	removed := int64(0)
+	for i := s.top.Swap(nil); i != nil; i = i.Next() {
+		removed++
+	}
+	s.len.Add(-removed)
+}
+
+// Equal returns true if st is a [Stack] or a [ConcurrentStack] and its elements are equals to the elements of s.
+// In any other case, it returns false.
+func (s *ConcurrentStack[T]) Equal(st any) bool {
+	stack := stackSlice[T](st)
+	if s != nil && stack != nil {
+		return list.NewArrayListFromSlice(s.ToSlice()).Equal(list.NewArrayListFromSlice(stack))
+	}
+	return false
+}
+
+// Compare returns 0 if s and st are equals,
+// -1 if s is shorten than st,
+// 1 if s is longer than st,
+// -2 if st is not a [Stack] or a [ConcurrentStack] or if one between s and st is nil.
+//
+// If s and st have the same length, the result is the comparison
+// between the first different element of the two stacks if T implemets [util.Comparer],
+// otherwhise the result is 0.
+func (s *ConcurrentStack[T]) Compare(st any) int {
+	stack := stackSlice[T](st)
+	if s != nil && stack != nil {
+		return list.NewArrayListFromSlice(s.ToSlice()).Compare(list.NewArrayListFromSlice(stack))
+	}
+	return -2
+}
+
+// Hash returns the hash code of s.
+func (s *ConcurrentStack[T]) Hash() uint64 {
+	return list.NewArrayListFromSlice(s.ToSlice()).Hash()
+}
+
+// String returns a rapresentation of s in the form of a string.
+func (s *ConcurrentStack[T]) String() string {
+	check := reflect.TypeOf(new(T)).String()
+	element, ok := s.Top()
+	if !ok {
+		return fmt.Sprintf("ConcurrentStack[%v][%d, ]", check[1:], s.Len())
+	}
+	return fmt.Sprintf("ConcurrentStack[%v][%d, %v]", check[1:], s.Len(), element)
+}
+
+// MarshalJSON returns the JSON encoding of s, which is an array containing its elements.
+// The last element of the array is the top of the stack.
+func (s *ConcurrentStack[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of s with the ones decoded from the JSON array data.
+// The last element of the array becomes the top of the stack.
+//
+// The replacement is not atomic, so it must not be done while other goroutines are using s.
+func (s *ConcurrentStack[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, s.decode)
+}
+
+// MarshalBinary returns the binary encoding of s, which contains its elements.
+// The last encoded element is the top of the stack.
+func (s *ConcurrentStack[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(s.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of s with the ones decoded from the binary encoding data.
+// The last decoded element becomes the top of the stack.
+//
+// The replacement is not atomic, so it must not be done while other goroutines are using s.
+func (s *ConcurrentStack[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, s.decode)
+}
+
+// GobEncode returns the binary encoding of s as [ConcurrentStack.MarshalBinary].
+func (s *ConcurrentStack[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [ConcurrentStack.UnmarshalBinary].
+func (s *ConcurrentStack[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of s with objects.
+func (s *ConcurrentStack[T]) decode(objects []T) error {
+	s.Clear()
+	s.Push(objects...)
+	return nil
+}
+
+// stackSlice returns the elements of st if it is a non nil [Stack] or [ConcurrentStack], otherwise it returns nil.
+func stackSlice[T any](st any) []T {
+	switch stack := st.(type) {
+	case *Stack[T]:
+		if stack != nil {
+			return stack.ToSlice()
+		}
+	case *ConcurrentStack[T]:
+		if stack != nil {
+			return stack.ToSlice()
+		}
+	}
+	return nil
+}
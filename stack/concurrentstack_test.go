@@ -0,0 +1,124 @@
+package stack
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/potex02/structures"
+)
+
+func TestNewConcurrentStack(t *testing.T) {
+
+	var stack structures.Structure[int] = NewConcurrentStack[int]()
+	var stackSlice *ConcurrentStack[int] = NewConcurrentStackFromSlice([]int{1, 2, 3})
+
+	if stack.Len() != 0 || !stack.IsEmpty() {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+	if stackSlice.Len() != 3 || !slices.Equal(stackSlice.ToSlice(), []int{1, 2, 3}) {
+		t.Log("stack objects are", stackSlice.ToSlice())
+		t.Fail()
+	}
+	if stackSlice.String() != "ConcurrentStack[int][3, 3]" || stack.String() != "ConcurrentStack[int][0, ]" {
+		t.Log("stacks are", stackSlice, stack)
+		t.Fail()
+	}
+}
+func TestPushPopConcurrentStack(t *testing.T) {
+
+	var stack *ConcurrentStack[int] = NewConcurrentStack[int](1)
+
+	stack.Push(2, 3)
+	if e, ok := stack.Top(); !ok || e != 3 {
+		t.Log("top is", e)
+		t.Fail()
+	}
+	for i := 3; i != 0; i-- {
+		if e, ok := stack.Pop(); !ok || e != i {
+			t.Log("e is", e)
+			t.Fail()
+		}
+	}
+	if _, ok := stack.Pop(); ok || !stack.IsEmpty() {
+		t.Log("the stack is not empty")
+		t.Fail()
+	}
+	stack.Push(4, 5)
+	stack.Clear()
+	if stack.Len() != 0 || !stack.IsEmpty() {
+		t.Log("the stack is not empty")
+		t.Fail()
+	}
+}
+func TestEqualConcurrentStack(t *testing.T) {
+
+	var stack *ConcurrentStack[int] = NewConcurrentStack[int](1, 2, 3)
+
+	if !stack.Equal(NewStack(1, 2, 3)) || !stack.Equal(NewConcurrentStack(1, 2, 3)) {
+		t.Log("stacks are not equals")
+		t.Fail()
+	}
+	if stack.Equal(NewConcurrentStack(1, 2)) || stack.Compare(NewStack(1, 2)) != 1 || stack.Compare(nil) != -2 {
+		t.Log("stacks are equals")
+		t.Fail()
+	}
+	if stack.Hash() != NewConcurrentStack(1, 2, 3).Hash() {
+		t.Log("hashes are different")
+		t.Fail()
+	}
+}
+func TestConcurrentStack(t *testing.T) {
+
+	var stack *ConcurrentStack[int] = NewConcurrentStack[int]()
+
+	var wg sync.WaitGroup
+	popped := make([][]int, 4)
+	for i := 0; i != 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j != 500; j++ {
+				stack.Push(i*1000+j*2, i*1000+j*2+1)
+			}
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			for len(popped[i]) != 1000 {
+				if e, ok := stack.Pop(); ok {
+					popped[i] = append(popped[i], e)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if !stack.IsEmpty() || stack.Len() != 0 {
+		t.Log("stack is", stack)
+		t.Fail()
+	}
+	if all := slices.Sorted(slices.Values(slices.Concat(popped...))); len(all) != 4000 || !slices.Equal(all, slices.Compact(slices.Clone(all))) {
+		t.Log("elements are lost or duplicated")
+		t.Fail()
+	}
+}
+func TestJSONConcurrentStack(t *testing.T) {
+
+	var stack *ConcurrentStack[int] = NewConcurrentStack(1, 2, 3)
+
+	data, err := json.Marshal(stack)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result ConcurrentStack[int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(stack) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	if data, err := json.Marshal(NewConcurrentStack[int]()); err != nil || string(data) != "[]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/list"
 )
 
@@ -122,3 +123,43 @@ func (s *Stack[T]) String() string {
 	element, _ := s.Top()
 	return fmt.Sprintf("Stack[%v][%d, %v]", check[1:], s.objects.Len(), element)
 }
+
+// MarshalJSON returns the JSON encoding of s, which is an array containing its elements.
+// The last element of the array is the top of the stack.
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of s with the ones decoded from the JSON array data.
+// The last element of the array becomes the top of the stack.
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, s.decode)
+}
+
+// MarshalBinary returns the binary encoding of s, which contains its elements.
+// The last encoded element is the top of the stack.
+func (s *Stack[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(s.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of s with the ones decoded from the binary encoding data.
+// The last decoded element becomes the top of the stack.
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, s.decode)
+}
+
+// GobEncode returns the binary encoding of s as [Stack.MarshalBinary].
+func (s *Stack[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode decodes data into s as [Stack.UnmarshalBinary].
+func (s *Stack[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of s with objects.
+func (s *Stack[T]) decode(objects []T) error {
+	s.objects = list.NewArrayListFromSlice(objects)
+	return nil
+}
@@ -1,10 +1,15 @@
 package stack
 
 import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
 	"reflect"
 	"testing"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/util"
 )
 
 func TestNewStack(t *testing.T) {
@@ -104,3 +109,63 @@ func TestEqualStack(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONStack(t *testing.T) {
+
+	var stack *Stack[int] = NewStack(1, 2, 3)
+
+	data, err := json.Marshal(stack)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result Stack[int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(stack) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	if top, ok := result.Top(); !ok || top != 3 {
+		t.Log("top is", top)
+		t.Fail()
+	}
+}
+func TestBinary(t *testing.T) {
+
+	var tests []binaryTest = []binaryTest{
+		{"Stack", NewStack(1, 2, 3), NewStack(4), &Stack[int]{}},
+		{"ConcurrentStack", NewConcurrentStack(1, 2, 3), NewConcurrentStack(4), &ConcurrentStack[int]{}},
+	}
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
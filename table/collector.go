@@ -0,0 +1,71 @@
+package table
+
+import (
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util"
+)
+
+// ToTreeTable returns a [structures.Collector] which collects the elements into a [TreeTable],
+// associating every element to the key returned by key.
+// If more elements have the same key, only the last one is kept.
+func ToTreeTable[K util.Comparer, T any](key func(element T) K) structures.Collector[T, *TreeTable[K, T]] {
+	return structures.NewCollector(func() *TreeTable[K, T] {
+		return NewTreeTable[K, T]()
+	}, func(result *TreeTable[K, T], element T) *TreeTable[K, T] {
+		result.Put(key(element), element)
+		return result
+	}, func(result *TreeTable[K, T]) *TreeTable[K, T] {
+		return result
+	})
+}
+
+// ToMultiHashTable returns a [structures.Collector] which collects the elements into a [MultiHashTable],
+// associating every element to the key returned by key.
+func ToMultiHashTable[K util.Hasher, T any](key func(element T) K) structures.Collector[T, *MultiHashTable[K, T]] {
+	return structures.NewCollector(func() *MultiHashTable[K, T] {
+		return NewMultiHashTable[K, T]()
+	}, func(result *MultiHashTable[K, T], element T) *MultiHashTable[K, T] {
+		result.Put(key(element), element)
+		return result
+	}, func(result *MultiHashTable[K, T]) *MultiHashTable[K, T] {
+		return result
+	})
+}
+
+// EntriesToHashTable returns a [structures.Collector] which collects the entries into a [HashTable].
+// If more entries have the same key, only the last one is kept.
+func EntriesToHashTable[K util.Hasher, T any]() structures.Collector[*Entry[K, T], *HashTable[K, T]] {
+	return structures.NewCollector(func() *HashTable[K, T] {
+		return NewHashTable[K, T]()
+	}, func(result *HashTable[K, T], entry *Entry[K, T]) *HashTable[K, T] {
+		result.Put(entry.Key(), entry.Element())
+		return result
+	}, func(result *HashTable[K, T]) *HashTable[K, T] {
+		return result
+	})
+}
+
+// EntriesToTreeTable returns a [structures.Collector] which collects the entries into a [TreeTable].
+// If more entries have the same key, only the last one is kept.
+func EntriesToTreeTable[K util.Comparer, T any]() structures.Collector[*Entry[K, T], *TreeTable[K, T]] {
+	return structures.NewCollector(func() *TreeTable[K, T] {
+		return NewTreeTable[K, T]()
+	}, func(result *TreeTable[K, T], entry *Entry[K, T]) *TreeTable[K, T] {
+		result.Put(entry.Key(), entry.Element())
+		return result
+	}, func(result *TreeTable[K, T]) *TreeTable[K, T] {
+		return result
+	})
+}
+
+// EntriesToMultiHashTable returns a [structures.Collector] which collects the entries into a [MultiHashTable].
+func EntriesToMultiHashTable[K util.Hasher, T any]() structures.Collector[*Entry[K, T], *MultiHashTable[K, T]] {
+	return structures.NewCollector(func() *MultiHashTable[K, T] {
+		return NewMultiHashTable[K, T]()
+	}, func(result *MultiHashTable[K, T], entry *Entry[K, T]) *MultiHashTable[K, T] {
+		result.Put(entry.Key(), entry.Element())
+		return result
+	}, func(result *MultiHashTable[K, T]) *MultiHashTable[K, T] {
+		return result
+	})
+}
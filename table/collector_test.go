@@ -0,0 +1,71 @@
+package table
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestToTreeTable(t *testing.T) {
+
+	var stream *list.Stream[string] = list.NewArrayList[string]("a", "bb", "cc", "ddd").Stream()
+
+	result := list.CollectTo(stream, ToTreeTable(func(element string) wrapper.Int {
+		return wrapper.Int(len(element))
+	}))
+	if !result.Equal(NewHashTableFromSlice([]wrapper.Int{1, 2, 3}, []string{"a", "cc", "ddd"})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestToMultiHashTable(t *testing.T) {
+
+	var stream *list.Stream[string] = list.NewArrayList[string]("a", "bb", "cc", "ddd").Stream()
+
+	result := list.CollectTo(stream, ToMultiHashTable(func(element string) wrapper.Int {
+		return wrapper.Int(len(element))
+	}))
+	if e := result.Get(2); result.Len() != 4 || !slices.Equal(e, []string{"bb", "cc"}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestEntriesToTable(t *testing.T) {
+
+	var stream *Stream[wrapper.Int, int] = NewMultiTreeTableFromSlice[wrapper.Int, int](
+		[]wrapper.Int{1, 2, 2},
+		[]int{12, -2, 30},
+	).Stream()
+
+	if result := CollectTo(stream, EntriesToHashTable[wrapper.Int, int]()); !result.Equal(NewHashTableFromSlice([]wrapper.Int{1, 2}, []int{12, 30})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := CollectTo(stream, EntriesToTreeTable[wrapper.Int, int]()); !slices.Equal(result.Keys().ToSlice(), []wrapper.Int{1, 2}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := CollectTo(stream, EntriesToMultiHashTable[wrapper.Int, int]()); result.Len() != 3 || !slices.Equal(result.Get(2), []int{-2, 30}) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestCollectTo(t *testing.T) {
+
+	var stream *Stream[wrapper.Int, int] = NewTreeTableFromSlice[wrapper.Int, int](
+		[]wrapper.Int{1, 2, 3},
+		[]int{12, -2, 30},
+	).Stream()
+
+	if result := CollectTo(stream, structures.Counting[*Entry[wrapper.Int, int]]()); result != 3 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := CollectElementsTo(stream, structures.Joining[int](" ")); result != "12 -2 30" {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
@@ -0,0 +1,427 @@
+package table
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sync"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = NewConcurrentHashTable[wrapper.Int, int]()
+var _ BaseTable[wrapper.Int, int] = NewConcurrentHashTable[wrapper.Int, int]()
+var _ Table[wrapper.Int, int] = NewConcurrentHashTable[wrapper.Int, int]()
+
+// DefaultSegments is the number of segments used by [NewConcurrentHashTable].
+const DefaultSegments int = 16
+
+// ConcurrentHashTable provides a generic hash table which can be used concurrently.
+// The keys are divided by their hash code into segments, each of them is a [HashTable] guarded by its own [sync.RWMutex],
+// so the operations on keys of different segments do not block each other.
+//
+// Put, Remove, PutIfAbsent, Compute and Merge are atomic.
+// Len, Iter, RangeIter, Each and the other methods which read all the table are instead weakly consistent:
+// they lock one segment at a time, so they never block the writers for the whole traversal,
+// but they can miss the modifications done by other goroutines during the call.
+//
+// It implements the interface [Table].
+type ConcurrentHashTable[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	segments []*segment[K, T]
+}
+
+type segment[K util.Hasher, T any] struct {
+	objects *HashTable[K, T]
+	lock    sync.RWMutex
+}
+
+// NewConcurrentHashTable returns a new empty [ConcurrentHashTable] with [DefaultSegments] segments.
+func NewConcurrentHashTable[K util.Hasher, T any]() *ConcurrentHashTable[K, T] {
+	return NewConcurrentHashTableSegments[K, T](DefaultSegments)
+}
+
+// NewConcurrentHashTableFromSlice returns a new [ConcurrentHashTable] with [DefaultSegments] segments containing the elements of slice c.
+// It panics if key and c have different lengths.
+func NewConcurrentHashTableFromSlice[K util.Hasher, T any](key []K, c []T) *ConcurrentHashTable[K, T] {
+	table := NewConcurrentHashTable[K, T]()
+	if len(c) != 0 {
+		table.PutSlice(key, c)
+	}
+	return table
+}
+
+// NewConcurrentHashTableSegments returns a new empty [ConcurrentHashTable] with n segments.
+//
+// This function panics if n is less than 1.
+func NewConcurrentHashTableSegments[K util.Hasher, T any](n int) *ConcurrentHashTable[K, T] {
+	if n < 1 {
+		panic(fmt.Sprintf("Cannot create a table with %d segments", n))
+	}
+	table := &ConcurrentHashTable[K, T]{segments: make([]*segment[K, T], n)}
+	for i := range table.segments {
+		table.segments[i] = &segment[K, T]{objects: NewHashTable[K, T]()}
+	}
+	return table
+}
+
+// Segments returns the number of segments of t.
+func (t *ConcurrentHashTable[K, T]) Segments() int {
+	return len(t.segments)
+}
+
+// Len returns the length of t.
+func (t *ConcurrentHashTable[K, T]) Len() int {
+	result := 0
+	for _, i := range t.segments {
+		i.lock.RLock()
+		result += i.objects.Len()
+		i.lock.RUnlock()
+	}
+	return result
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *ConcurrentHashTable[K, T]) IsEmpty() bool {
+	for _, i := range t.segments {
+		i.lock.RLock()
+		empty := i.objects.IsEmpty()
+		i.lock.RUnlock()
+		if !empty {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsKey returns true if the key is present on t.
+func (t *ConcurrentHashTable[K, T]) ContainsKey(key K) bool {
+	segment := t.segment(key)
+	segment.lock.RLock()
+	defer segment.lock.RUnlock()
+	return segment.objects.ContainsKey(key)
+}
+
+// ContainsElement returns true if the element e is present on t.
+func (t *ConcurrentHashTable[K, T]) ContainsElement(e T) bool {
+	for _, i := range t.segments {
+		i.lock.RLock()
+		found := i.objects.ContainsElement(e)
+		i.lock.RUnlock()
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns a [list.List] which contains all keys of t.
+func (t *ConcurrentHashTable[K, T]) Keys() list.List[K] {
+	list := list.NewArrayList[K]()
+	t.Each(func(key K, _ T) {
+		list.Add(key)
+	})
+	return list
+}
+
+// Elements returns a [list.List] which contains all elements of t.
+func (t *ConcurrentHashTable[K, T]) Elements() list.List[T] {
+	list := list.NewArrayList[T]()
+	t.Each(func(_ K, element T) {
+		list.Add(element)
+	})
+	return list
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *ConcurrentHashTable[K, T]) ToSlice() []T {
+	return t.Elements().ToSlice()
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+func (t *ConcurrentHashTable[K, T]) Get(key K) (T, bool) {
+	segment := t.segment(key)
+	segment.lock.RLock()
+	defer segment.lock.RUnlock()
+	return segment.objects.Get(key)
+}
+
+// Put set the element e at the key and returns the overwritten value, if present.
+// If the element is not present, the method returns false.
+func (t *ConcurrentHashTable[K, T]) Put(key K, e T) (T, bool) {
+	segment := t.segment(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	return segment.objects.Put(key, e)
+}
+
+// PutSlice adds the elements of e at t.
+// It panics if key and e have different lengths.
+//
+// Each element is added atomically, but not the whole slice.
+func (t *ConcurrentHashTable[K, T]) PutSlice(key []K, e []T) {
+	if len(key) != len(e) {
+		panic("Different lengths for keys and elements")
+	}
+	for i := 0; i != len(key); i++ {
+		t.Put(key[i], e[i])
+	}
+}
+
+// PutIfAbsent sets the element e at the key if the key is not present and returns e and false.
+// If the key is already present, it returns the element associated at the key and true.
+func (t *ConcurrentHashTable[K, T]) PutIfAbsent(key K, e T) (T, bool) {
+	segment := t.segment(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	if element, ok := segment.objects.Get(key); ok {
+		return element, true
+	}
+	segment.objects.Put(key, e)
+	return e, false
+}
+
+// Compute calls fun with the element associated at the key, and a bool which indicates if the key is present,
+// and sets the element returned by fun at the key.
+// If fun returns false, the key is removed instead.
+//
+// The method returns the element and the bool returned by fun.
+//
+// fun is called holding the lock of the segment of the key, so it must not call the methods of t.
+func (t *ConcurrentHashTable[K, T]) Compute(key K, fun func(key K, element T, ok bool) (T, bool)) (T, bool) {
+	segment := t.segment(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	element, ok := segment.objects.Get(key)
+	result, keep := fun(key, element, ok)
+	if keep {
+		segment.objects.Put(key, result)
+	} else if ok {
+		segment.objects.Remove(key)
+	}
+	return result, keep
+}
+
+// Merge sets e at the key if the key is not present,
+// otherwise it sets the result of fun called with the element associated at the key and e.
+//
+// The method returns the element set at the key.
+//
+// fun is called holding the lock of the segment of the key, so it must not call the methods of t.
+func (t *ConcurrentHashTable[K, T]) Merge(key K, e T, fun func(old T, e T) T) T {
+	segment := t.segment(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	if element, ok := segment.objects.Get(key); ok {
+		e = fun(element, e)
+	}
+	segment.objects.Put(key, e)
+	return e
+}
+
+// Remove removes the key from t and returns the value associated at the key.
+// It returns false if the the key does not exists.
+func (t *ConcurrentHashTable[K, T]) Remove(key K) (T, bool) {
+	segment := t.segment(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	return segment.objects.Remove(key)
+}
+
+// Each executes fun for all elements of t.
+//
+// The elements of a segment are read before calling fun on them, so fun can call the methods of t.
+func (t *ConcurrentHashTable[K, T]) Each(fun func(key K, element T)) {
+	for i, j := range t.RangeIter() {
+		fun(i, j)
+	}
+}
+
+// Stream returns a [Stream] rapresenting t.
+func (t *ConcurrentHashTable[K, T]) Stream() *Stream[K, T] {
+	return NewStream[K, T](t, reflect.ValueOf(func() *ConcurrentHashTable[K, T] {
+		return NewConcurrentHashTableSegments[K, T](len(t.segments))
+	}))
+}
+
+// Clear removes all element from t.
+//
+// The segments are cleared one at a time.
+func (t *ConcurrentHashTable[K, T]) Clear() {
+	for _, i := range t.segments {
+		i.lock.Lock()
+		i.objects.Clear()
+		i.lock.Unlock()
+	}
+}
+
+// Iter returns an [Iterator] which permits to iterate a [ConcurrentHashTable].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		key := i.Key()
+//		element := i.Element()
+//		// Code
+//	}
+func (t *ConcurrentHashTable[K, T]) Iter() Iterator[K, T] {
+	return NewConcurrentHashTableIterator(t)
+}
+
+// RangeIter returns a function that allows to iterate a [ConcurrentHashTable] using the range keyword.
+//
+//	for i := range t.RangeIter() {
+//		// Code
+//	}
+//
+// The entries of a segment are read holding its lock, which is released before yielding them.
+//
+// Unlike [ConcurrentHashTable.Iter], it doesn't allow to remove elements during the iteration.
+func (t *ConcurrentHashTable[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		for i := range t.segments {
+			for _, j := range t.entries(i) {
+				if !yield(j.Key(), j.Element()) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Equal returns true if t and st are both [Table] and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is an [HashTable],
+// but the elements of t and the elements of st are equals, this method returns anyway true.
+func (t *ConcurrentHashTable[K, T]) Equal(st any) bool {
+	table, ok := st.(Table[K, T])
+	if ok && t != nil && table != nil {
+		return equalTables[K, T](t, table)
+	}
+	return false
+}
+
+// Compare returns 0 if t and st have the same length,
+// -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [Table] or if one between t and st is nil.
+func (t *ConcurrentHashTable[K, T]) Compare(st any) int {
+	table, ok := st.(Table[K, T])
+	if ok && t != nil && table != nil {
+		return compareTables[K, T](t, table)
+	}
+	return -2
+}
+
+// Hash returns the hash code of t.
+func (t *ConcurrentHashTable[K, T]) Hash() uint64 {
+	h := fnv.New64()
+	for _, i := range t.segments {
+		i.lock.RLock()
+		h.Write([]byte(fmt.Sprintf("%v", i.objects.Hash())))
+		i.lock.RUnlock()
+	}
+	return h.Sum64()
+}
+
+// Copy returns a table containing a copy of the elements of t.
+// The result of this method is of type [Table], but the effective table which is created is a [ConcurrentHashTable]
+// with the same number of segments of t.
+//
+// This method uses [util.Copy] to make copies of the elements.
+func (t *ConcurrentHashTable[K, T]) Copy() Table[K, T] {
+	table := NewConcurrentHashTableSegments[K, T](len(t.segments))
+	t.Each(func(key K, element T) {
+		table.Put(key, util.Copy(element))
+	})
+	return table
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *ConcurrentHashTable[K, T]) String() string {
+	return tableString[K, T]("ConcurrentHashTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, element] pairs.
+// As for RangeIter, each segment is encoded from a snapshot taken when it is reached.
+func (t *ConcurrentHashTable[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(t.RangeIter())
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from data.
+// If t is the zero value, it is divided into [DefaultSegments] segments.
+//
+// data can be both a JSON object and an array of [key, element] pairs.
+//
+// The replacement is not atomic, so it must not be done while other goroutines are using t.
+func (t *ConcurrentHashTable[K, T]) UnmarshalJSON(data []byte) error {
+	return decodeEntries(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its entries as key and element pairs.
+// As for RangeIter, each segment is encoded from a snapshot taken when it is reached.
+func (t *ConcurrentHashTable[K, T]) MarshalBinary() ([]byte, error) {
+	entries := make([]K, 0, t.Len())
+	elements := make([]T, 0, t.Len())
+	for i, j := range t.RangeIter() {
+		entries = append(entries, i)
+		elements = append(elements, j)
+	}
+	return codec.MarshalBinaryEntries(len(entries), func(yield func(K, T) bool) {
+		for i := range entries {
+			if !yield(entries[i], elements[i]) {
+				return
+			}
+		}
+	})
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+// If t is the zero value, it is divided into [DefaultSegments] segments.
+//
+// The replacement is not atomic, so it must not be done while other goroutines are using t.
+func (t *ConcurrentHashTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [ConcurrentHashTable.MarshalBinary].
+func (t *ConcurrentHashTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [ConcurrentHashTable.UnmarshalBinary].
+func (t *ConcurrentHashTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *ConcurrentHashTable[K, T]) decode(key []K, c []T) error {
+	if t.segments == nil {
+		t.segments = NewConcurrentHashTable[K, T]().segments
+	}
+	t.Clear()
+	t.PutSlice(key, c)
+	return nil
+}
+
+func (t *ConcurrentHashTable[K, T]) segment(key K) *segment[K, T] {
+	return t.segments[key.Hash()%uint64(len(t.segments))]
+}
+
+// entries returns the entries of the segment at index, read holding its lock.
+func (t *ConcurrentHashTable[K, T]) entries(index int) []*Entry[K, T] {
+	segment := t.segments[index]
+	segment.lock.RLock()
+	defer segment.lock.RUnlock()
+	result := make([]*Entry[K, T], 0, segment.objects.Len())
+	for i, j := range segment.objects.RangeIter() {
+		result = append(result, NewEntry(i, j))
+	}
+	return result
+}
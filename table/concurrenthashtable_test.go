@@ -0,0 +1,196 @@
+package table
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewConcurrentHashTable(t *testing.T) {
+
+	var table structures.Structure[int] = NewConcurrentHashTable[wrapper.String, int]()
+	var tableSlice *ConcurrentHashTable[wrapper.String, float32] = NewConcurrentHashTableFromSlice(
+		[]wrapper.String{"Hello", "Ciao"},
+		[]float32{1.2, 5.6},
+	)
+
+	if table.Len() != 0 || !table.IsEmpty() {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+	if tableSlice.Len() != 2 || tableSlice.Segments() != DefaultSegments {
+		t.Log("length is not 2")
+		t.Fail()
+	}
+	if e, _ := tableSlice.Get("Hello"); e != 1.2 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if !tableSlice.Equal(NewHashTableFromSlice([]wrapper.String{"Ciao", "Hello"}, []float32{5.6, 1.2})) {
+		t.Log("tables are not equals")
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("the table has been created")
+			t.Fail()
+		}
+	}()
+	NewConcurrentHashTableSegments[wrapper.Int, int](0)
+}
+func TestPutRemoveConcurrentHashTable(t *testing.T) {
+
+	var table *ConcurrentHashTable[wrapper.Int, string] = NewConcurrentHashTableSegments[wrapper.Int, string](4)
+
+	for i := 0; i != 10; i++ {
+		table.Put(wrapper.Int(i), "a")
+	}
+	if e, ok := table.Put(3, "b"); !ok || e != "a" {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	if e, ok := table.Remove(3); !ok || e != "b" || table.ContainsKey(3) || table.Len() != 9 {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	if _, ok := table.Remove(3); ok {
+		t.Log("the key has been removed")
+		t.Fail()
+	}
+	if !table.ContainsElement("a") || table.ContainsElement("b") {
+		t.Log("table is", table)
+		t.Fail()
+	}
+	table.Clear()
+	if !table.IsEmpty() {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestAtomicsConcurrentHashTable(t *testing.T) {
+
+	var table *ConcurrentHashTable[wrapper.String, int] = NewConcurrentHashTable[wrapper.String, int]()
+
+	if e, ok := table.PutIfAbsent("a", 1); ok || e != 1 {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	if e, ok := table.PutIfAbsent("a", 2); !ok || e != 1 {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	if e := table.Merge("a", 5, func(old int, e int) int { return old + e }); e != 6 {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	if e := table.Merge("b", 5, func(old int, e int) int { return old + e }); e != 5 {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	if e, ok := table.Compute("b", func(_ wrapper.String, element int, ok bool) (int, bool) { return element * 2, ok }); !ok || e != 10 {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	if _, ok := table.Compute("a", func(_ wrapper.String, element int, _ bool) (int, bool) { return element, false }); ok || table.ContainsKey("a") {
+		t.Log("the key has not been removed")
+		t.Fail()
+	}
+	if _, ok := table.Compute("c", func(_ wrapper.String, element int, ok bool) (int, bool) { return element, ok }); ok || table.ContainsKey("c") {
+		t.Log("the key has been added")
+		t.Fail()
+	}
+}
+func TestIterConcurrentHashTable(t *testing.T) {
+
+	var table *ConcurrentHashTable[wrapper.Int, int] = NewConcurrentHashTableSegments[wrapper.Int, int](3)
+
+	for i := 0; i != 10; i++ {
+		table.Put(wrapper.Int(i), i*i)
+	}
+	sum := 0
+	for i := table.Iter(); !i.End(); {
+		if i.Element() != int(i.Key()*i.Key()) {
+			t.Log("element is", i.Element())
+			t.Fail()
+		}
+		sum += i.Element()
+		if i.Key()%2 == 0 {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+		}
+	}
+	if sum != 285 || table.Len() != 5 {
+		t.Log("sum is", sum, "table is", table)
+		t.Fail()
+	}
+	for i := range table.RangeIter() {
+		table.Remove(i)
+	}
+	if !table.IsEmpty() || !NewConcurrentHashTable[wrapper.Int, int]().Iter().End() {
+		t.Log("table is", table)
+		t.Fail()
+	}
+	if table.Copy().Len() != 0 || CollectTo(table.Stream(), EntriesToHashTable[wrapper.Int, int]()).Len() != 0 {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestConcurrentHashTable(t *testing.T) {
+
+	var table *ConcurrentHashTable[wrapper.Int, int] = NewConcurrentHashTableSegments[wrapper.Int, int](4)
+
+	var wg sync.WaitGroup
+	for i := 0; i != 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j != 100; j++ {
+				table.Merge(wrapper.Int(j), 1, func(old int, e int) int { return old + e })
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for k, e := range table.RangeIter() {
+				if e < 1 || k < 0 {
+					t.Log("entry is", k, e)
+					t.Fail()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if table.Len() != 100 {
+		t.Log("length is", table.Len())
+		t.Fail()
+	}
+	for _, i := range table.ToSlice() {
+		if i != 8 {
+			t.Log("element is", i)
+			t.Fail()
+		}
+	}
+}
+func TestJSONConcurrentHashTable(t *testing.T) {
+
+	var table *ConcurrentHashTable[wrapper.Int, int] = NewConcurrentHashTableFromSlice([]wrapper.Int{1, 2, 3}, []int{10, 20, 30})
+
+	data, err := json.Marshal(table)
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	var result ConcurrentHashTable[wrapper.Int, int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(table) || result.Segments() != DefaultSegments {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	segments := NewConcurrentHashTableSegments[wrapper.Int, int](2)
+	if err := json.Unmarshal(data, segments); err != nil || !segments.Equal(table) || segments.Segments() != 2 {
+		t.Log("result is", segments, "err is", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,113 @@
+package table
+
+import (
+	"io"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+)
+
+// Encoder writes the entries of a table on an [io.Writer] one at a time,
+// so that a table can be checkpointed without keeping its whole encoding in memory.
+//
+// The entries use the same binary encoding of the MarshalBinary methods of the tables.
+// The stream must be ended with Close, otherwise the [Decoder] reading it returns [io.ErrUnexpectedEOF].
+type Encoder[K any, T any] struct {
+	// contains filtered or unexported fields
+	stream   *codec.StreamEncoder
+	keys     codec.Codec[K]
+	elements codec.Codec[T]
+}
+
+// NewEncoder returns a new [Encoder] which writes on w.
+func NewEncoder[K any, T any](w io.Writer) *Encoder[K, T] {
+	return &Encoder[K, T]{stream: codec.NewStreamEncoder(w), keys: codec.NewCodec[K](), elements: codec.NewCodec[T]()}
+}
+
+// Encode writes the entry made by key and element.
+// It returns [structures.ErrClosedEncoder] if e has been closed.
+func (e *Encoder[K, T]) Encode(key K, element T) error {
+	if e.stream.Closed() {
+		return structures.ErrClosedEncoder
+	}
+	return e.stream.Write(func(encoder *codec.Encoder) error {
+		if err := e.keys.Encode(encoder, key); err != nil {
+			return err
+		}
+		return e.elements.Encode(encoder, element)
+	})
+}
+
+// EncodeEntries writes all the entries produced by seq, which is usually the result of the RangeIter method of a table.
+//
+//	err := encoder.EncodeEntries(table.RangeIter())
+func (e *Encoder[K, T]) EncodeEntries(seq func(yield func(K, T) bool)) error {
+	for i, j := range seq {
+		if err := e.Encode(i, j); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close ends the stream written by e.
+// It does not close the underlying [io.Writer].
+func (e *Encoder[K, T]) Close() error {
+	return e.stream.Close()
+}
+
+// Decoder reads the entries written by an [Encoder] from an [io.Reader] one at a time.
+type Decoder[K any, T any] struct {
+	// contains filtered or unexported fields
+	stream   *codec.StreamDecoder
+	keys     codec.Codec[K]
+	elements codec.Codec[T]
+}
+
+// NewDecoder returns a new [Decoder] which reads from r.
+//
+// If r does not implement [io.ByteReader], it is buffered,
+// so the data following the end of the stream may be read from r.
+func NewDecoder[K any, T any](r io.Reader) *Decoder[K, T] {
+	return &Decoder[K, T]{stream: codec.NewStreamDecoder(r), keys: codec.NewCodec[K](), elements: codec.NewCodec[T]()}
+}
+
+// Decode reads the next entry.
+// It returns [io.EOF] when the stream is ended.
+func (d *Decoder[K, T]) Decode() (K, T, error) {
+
+	var key K
+	var element T
+
+	err := d.stream.Read(func(decoder *codec.Decoder) error {
+		var err error
+		if key, err = d.keys.Decode(decoder); err != nil {
+			return err
+		}
+		element, err = d.elements.Decode(decoder)
+		return err
+	})
+	return key, element, err
+}
+
+// DecodeTable reads all the remaining entries and puts them in t, one at a time.
+// The method returns when the stream is ended.
+func (d *Decoder[K, T]) DecodeTable(t BaseTable[K, T]) error {
+	for {
+		key, element, err := d.Decode()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch table := t.(type) {
+		case Table[K, T]:
+			table.Put(key, element)
+		case MultiTable[K, T]:
+			table.Put(key, element)
+		default:
+			t.PutSlice([]K{key}, []T{element})
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package table
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestEncoder(t *testing.T) {
+
+	var table *HashTable[wrapper.Int, string] = NewHashTable[wrapper.Int, string]()
+	var buffer bytes.Buffer
+
+	for i := 0; i != 1000; i++ {
+		table.Put(wrapper.Int(i), strconv.Itoa(i*i))
+	}
+	encoder := NewEncoder[wrapper.Int, string](&buffer)
+	if err := encoder.EncodeEntries(table.RangeIter()); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := encoder.Close(); err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := encoder.Encode(1, "a"); !errors.Is(err, structures.ErrClosedEncoder) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	result := NewTreeTable[wrapper.Int, string]()
+	decoder := NewDecoder[wrapper.Int, string](&buffer)
+	if err := decoder.DecodeTable(result); err != nil || !result.Equal(table) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if _, _, err := decoder.Decode(); err != io.EOF {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestEncoderMultiTable(t *testing.T) {
+
+	var table *MultiTreeTable[wrapper.String, int] = NewMultiTreeTableFromSlice([]wrapper.String{"b", "a", "b", "b"}, []int{2, 1, 3, 2})
+	var buffer bytes.Buffer
+
+	encoder := NewEncoder[wrapper.String, int](&buffer)
+	encoder.EncodeEntries(table.RangeIter())
+	encoder.Encode("c", 4)
+	encoder.Close()
+	encoder = NewEncoder[wrapper.String, int](&buffer)
+	encoder.Encode("d", 5)
+	encoder.Close()
+	result := NewMultiHashTable[wrapper.String, int]()
+	if err := NewDecoder[wrapper.String, int](&buffer).DecodeTable(result); err != nil || result.Len() != 5 || !slices.Equal(result.Get("b"), []int{2, 3, 2}) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	decoder := NewDecoder[wrapper.String, int](&buffer)
+	if key, element, err := decoder.Decode(); err != nil || key != "d" || element != 5 {
+		t.Log("key is", key, "element is", element, "err is", err)
+		t.Fail()
+	}
+	if _, _, err := decoder.Decode(); err != io.EOF {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestDecoderTruncated(t *testing.T) {
+
+	var buffer bytes.Buffer
+
+	encoder := NewEncoder[string, []int](&buffer)
+	encoder.Encode("a", []int{1, 2})
+	encoder.Encode("b", []int{3})
+	encoder.Close()
+	data := buffer.Bytes()
+	decoder := NewDecoder[string, []int](bytes.NewReader(data[:len(data)-1]))
+	for _, i := range []string{"a", "b"} {
+		if key, _, err := decoder.Decode(); err != nil || key != i {
+			t.Log("key is", key, "err is", err)
+			t.Fail()
+		}
+	}
+	if _, _, err := decoder.Decode(); err != io.ErrUnexpectedEOF {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	data[0]++
+	if _, _, err := NewDecoder[string, []int](bytes.NewReader(data)).Decode(); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
@@ -8,14 +8,14 @@ import (
 )
 
 // Entry is a component of a hash structure.
-type Entry[K util.Comparer, T any] struct {
+type Entry[K any, T any] struct {
 	// contains filtered or unexported fields
 	key     K
 	element T
 }
 
 // NewEntry returns a new [Entry].
-func NewEntry[K util.Comparer, T any](key K, element T) *Entry[K, T] {
+func NewEntry[K any, T any](key K, element T) *Entry[K, T] {
 	return &Entry[K, T]{key: key, element: element}
 }
 
@@ -35,10 +35,13 @@ func (e *Entry[K, T]) SetElement(element T) {
 }
 
 // Compare returns the comparison between the key of e and o.
+//
+// It returns -2 if o is not an [Entry] or if K does not implement [util.Comparer].
 func (e *Entry[K, T]) Compare(o any) int {
 	entry, ok := o.(*Entry[K, T])
-	if ok && e != nil && entry != nil {
-		return e.key.Compare(entry.Key())
+	key, comparer := interface{}(e.key).(util.Comparer)
+	if ok && comparer && e != nil && entry != nil {
+		return key.Compare(entry.Key())
 	}
 	return -2
 }
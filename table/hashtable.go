@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/list"
 	"github.com/potex02/structures/util"
 	"github.com/potex02/structures/util/wrapper"
@@ -16,11 +17,20 @@ var _ BaseTable[wrapper.Int, int] = NewHashTable[wrapper.Int, int]()
 var _ Table[wrapper.Int, int] = NewHashTable[wrapper.Int, int]()
 
 // HashTable provides a generic table implemented through hashing.
+// The entries with the same hash code are chained in a list, so an entry never moves once it has been put.
+//
+// The length of the table is cached, so Len and IsEmpty run in O(1) time.
+//
+// [OpenHashTable] stores the same data inline with Robin Hood probing, which makes the lookups faster,
+// but moves the entries on every insertion and removal.
+// HashTable remains the default implementation of the structures built on a hash table, like the HashSet of the set package,
+// so their behavior is unchanged, while the open addressing one is chosen explicitly where its speed matters.
 //
 // It implements the interface [Table].
 type HashTable[K util.Hasher, T any] struct {
 	// contains filtered or unexported fields
 	objects map[uint64]list.List[*Entry[K, T]]
+	len     int
 }
 
 // NewHashTable returns a new empty [HashTable].
@@ -39,17 +49,15 @@ func NewHashTableFromSlice[K util.Hasher, T any](key []K, c []T) *HashTable[K, T
 }
 
 // Len returns the length of t.
+//
+// The length is updated at every insertion and removal, so this method runs in O(1) time.
 func (t *HashTable[K, T]) Len() int {
-	result := 0
-	for _, i := range t.objects {
-		result += i.Len()
-	}
-	return result
+	return t.len
 }
 
 // IsEmpty returns a bool which indicates if t is empty or not.
 func (t *HashTable[K, T]) IsEmpty() bool {
-	return t.Len() == 0
+	return t.len == 0
 }
 
 // ContainsKey returns true if the key is present on t.
@@ -130,6 +138,7 @@ func (t *HashTable[K, T]) Put(key K, e T) (T, bool) {
 	if hash == nil {
 		list := list.NewLinkedList(NewEntry(key, e))
 		t.objects[key.Hash()] = list
+		t.len++
 		return result, false
 	}
 	for _, i := range hash.RangeIter() {
@@ -141,6 +150,7 @@ func (t *HashTable[K, T]) Put(key K, e T) (T, bool) {
 		}
 	}
 	hash.Add(NewEntry(key, e))
+	t.len++
 	return result, false
 }
 
@@ -172,6 +182,7 @@ func (t *HashTable[K, T]) Remove(key K) (T, bool) {
 			if hash.IsEmpty() {
 				delete(t.objects, key.Hash())
 			}
+			t.len--
 			return result, true
 		}
 	}
@@ -197,6 +208,7 @@ func (t *HashTable[K, T]) Stream() *Stream[K, T] {
 // Clear removes all element from t.
 func (t *HashTable[K, T]) Clear() {
 	t.objects = map[uint64]list.List[*Entry[K, T]]{}
+	t.len = 0
 }
 
 // Iter returns an [Iterator] which permits to iterate a [HashTable].
@@ -237,17 +249,7 @@ func (t *HashTable[K, T]) RangeIter() func(yield func(K, T) bool) {
 func (t *HashTable[K, T]) Equal(st any) bool {
 	table, ok := st.(Table[K, T])
 	if ok && t != nil && table != nil {
-		if t.Len() != table.Len() {
-			return false
-		}
-		for i := t.Keys().Iter(); !i.End(); i = i.Next() {
-			e1, _ := t.Get(i.Element())
-			other, found := table.Get(i.Element())
-			if !found || !util.EqualFunction(e1)(other) {
-				return false
-			}
-		}
-		return true
+		return equalTables[K, T](t, table)
 	}
 	return false
 }
@@ -259,13 +261,7 @@ func (t *HashTable[K, T]) Equal(st any) bool {
 func (t *HashTable[K, T]) Compare(st any) int {
 	table, ok := st.(Table[K, T])
 	if ok && t != nil && table != nil {
-		if t.Len() < table.Len() {
-			return -1
-		}
-		if t.Len() > table.Len() {
-			return 1
-		}
-		return 0
+		return compareTables[K, T](t, table)
 	}
 	return -2
 }
@@ -293,16 +289,46 @@ func (t *HashTable[K, T]) Copy() Table[K, T] {
 
 // String returns a rapresentation of t in the form of a string.
 func (t *HashTable[K, T]) String() string {
-	check := []string{reflect.TypeOf(new(K)).String(), reflect.TypeOf(new(T)).String()}
-	result := fmt.Sprintf("HashTable[%v, %v][", check[0][1:], check[1][1:])
-	first := true
-	t.Each(func(key K, element T) {
-		if !first {
-			result += ", "
-		}
-		result += fmt.Sprintf("%v: %v", key, element)
-		first = false
-	})
-	result += "]"
-	return result
+	return tableString[K, T]("HashTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, element] pairs.
+func (t *HashTable[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(t.RangeIter())
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from data.
+//
+// data can be both a JSON object and an array of [key, element] pairs.
+func (t *HashTable[K, T]) UnmarshalJSON(data []byte) error {
+	return decodeEntries(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its entries as key and element pairs.
+func (t *HashTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+func (t *HashTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [HashTable.MarshalBinary].
+func (t *HashTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [HashTable.UnmarshalBinary].
+func (t *HashTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *HashTable[K, T]) decode(key []K, c []T) error {
+	t.Clear()
+	t.PutSlice(key, c)
+	return nil
 }
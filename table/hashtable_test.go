@@ -1,6 +1,7 @@
 package table
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/potex02/structures"
@@ -229,6 +230,36 @@ func TestCompareHashTable(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONHashTable(t *testing.T) {
+
+	var table *HashTable[wrapper.String, int] = NewHashTableFromSlice([]wrapper.String{"a", "b", "c"}, []int{1, 2, 3})
+	var result *HashTable[wrapper.String, int] = NewHashTable[wrapper.String, int]()
+
+	data, err := json.Marshal(table)
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	var object map[string]int
+	if err := json.Unmarshal(data, &object); err != nil || len(object) != 3 || object["b"] != 2 {
+		t.Log("data is", string(data))
+		t.Fail()
+	}
+	result.Put("d", 4)
+	if err := json.Unmarshal(data, result); err != nil || !result.Equal(table) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var zero HashTable[wrapper.String, int]
+	if err := json.Unmarshal([]byte(`[["a", 1], ["b", 2]]`), &zero); err != nil || zero.Len() != 2 {
+		t.Log("result is", &zero, "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`{"a": "b"}`), result); err == nil || !result.Equal(table) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
 
 type test struct {
 	n1 int
@@ -1,6 +1,7 @@
 package table
 
 import (
+	"github.com/potex02/structures"
 	"github.com/potex02/structures/list"
 	"github.com/potex02/structures/tree"
 	"github.com/potex02/structures/util"
@@ -9,11 +10,19 @@ import (
 
 var _ Iterator[wrapper.Int, int] = NewHashTableIterator[wrapper.Int, int](NewHashTable[wrapper.Int, int]())
 var _ Iterator[wrapper.Int, int] = NewTreeTableIterator[wrapper.Int, int](NewTreeTable[wrapper.Int, int]())
+var _ Iterator[wrapper.Int, int] = NewTreeSubTableIterator[wrapper.Int, int](NewTreeTable[wrapper.Int, int]().TailTable(0, true))
 var _ Iterator[wrapper.Int, int] = NewMultiHashTableIterator[wrapper.Int, int](NewMultiHashTable[wrapper.Int, int]())
+var _ Iterator[wrapper.Int, int] = NewOpenHashTableIterator[wrapper.Int, int](NewOpenHashTable[wrapper.Int, int]())
+var _ Iterator[wrapper.Int, int] = NewMultiOpenHashTableIterator[wrapper.Int, int](NewMultiOpenHashTable[wrapper.Int, int]())
+var _ Iterator[wrapper.Int, int] = NewConcurrentHashTableIterator[wrapper.Int, int](NewConcurrentHashTable[wrapper.Int, int]())
+var _ Iterator[wrapper.Int, int] = NewUnmodifiableTableIterator[wrapper.Int, int](NewHashTable[wrapper.Int, int]().Iter())
 var _ Iterator[wrapper.Int, int] = &endIterator[wrapper.Int, int]{}
+var _ IndexedIterator[wrapper.Int, int] = &TreeTableIterator[wrapper.Int, int]{}
+var _ IndexedIterator[wrapper.Int, int] = &TreeSubTableIterator[wrapper.Int, int]{}
+var _ IndexedIterator[wrapper.Int, int] = &MultiTreeTableIterator[wrapper.Int, int]{}
 
 // Iterator provides the methods to iterate over a [Table] or a [MultiTable].
-type Iterator[K util.Comparer, T any] interface {
+type Iterator[K any, T any] interface {
 	// Elements returns the element of the iterator.
 	Element() T
 	// Index returns the key of the element the iterator.
@@ -51,6 +60,13 @@ type Iterator[K util.Comparer, T any] interface {
 	End() bool
 }
 
+// IndexedIterator is an [Iterator] of an ordered table which knows the position of its element.
+type IndexedIterator[K any, T any] interface {
+	Iterator[K, T]
+	// Index returns the position of the element of the iterator in the order of the table.
+	Index() int
+}
+
 // HashTableIterator is an iterator of a [HashTable].
 type HashTableIterator[K util.Hasher, T any] struct {
 	// contains filtered or unexported fields
@@ -139,28 +155,36 @@ func (i *HashTableIterator[K, T]) nextKey() Iterator[K, T] {
 }
 
 // TreeTableIterator is an iterator of a [TreeTable].
-type TreeTableIterator[K util.Comparer, T any] struct {
+type TreeTableIterator[K any, T any] struct {
 	// contains filtered or unexported fields
-	table    *TreeTable[K, T]
-	iterator tree.Iterator[*Entry[K, T]]
+	table *TreeTable[K, T]
+	node  *tree.Node[*Entry[K, T]]
 }
 
 // NewTreeTableIterator returns a new [TreeTableIterator] associated at the table parameter.
-func NewTreeTableIterator[K util.Comparer, T any](table *TreeTable[K, T]) Iterator[K, T] {
+func NewTreeTableIterator[K any, T any](table *TreeTable[K, T]) Iterator[K, T] {
 	if table.IsEmpty() {
 		return &endIterator[K, T]{}
 	}
-	return &TreeTableIterator[K, T]{table: table, iterator: tree.NewTreeIterator[*Entry[K, T]](table.objects)}
+	return &TreeTableIterator[K, T]{table: table, node: table.objects.Root().Min()}
 }
 
 // Elements returns the element of the iterator.
 func (i *TreeTableIterator[K, T]) Element() T {
-	return i.iterator.Element().Element()
+	return i.node.Element().Element()
 }
 
 // Index returns the key of the element the iterator.
 func (i *TreeTableIterator[K, T]) Key() K {
-	return i.iterator.Element().Key()
+	return i.node.Element().Key()
+}
+
+// Index returns the position of the element of the iterator in the order of the table.
+//
+// The position is computed from the tree, so it takes into account the removals done during the iteration.
+// This method runs in O(log n) time.
+func (i *TreeTableIterator[K, T]) Index() int {
+	return i.node.Index()
 }
 
 // Remove removes the element from the table and returns the iterator of the next element.
@@ -189,8 +213,8 @@ func (i *TreeTableIterator[K, T]) Key() K {
 //		// Code
 //	}
 func (i *TreeTableIterator[K, T]) Remove() Iterator[K, T] {
-	i.iterator = i.iterator.Remove()
-	if i.iterator.End() {
+	i.node = i.table.objects.RemoveNode(i.node)
+	if i.node == nil {
 		return &endIterator[K, T]{}
 	}
 	return i
@@ -198,8 +222,8 @@ func (i *TreeTableIterator[K, T]) Remove() Iterator[K, T] {
 
 // Next returns the iterator of the next element.
 func (i *TreeTableIterator[K, T]) Next() Iterator[K, T] {
-	i.iterator = i.iterator.Next()
-	if i.iterator.End() {
+	i.node = i.node.Successor()
+	if i.node == nil {
 		return &endIterator[K, T]{}
 	}
 	return i
@@ -210,6 +234,87 @@ func (i *TreeTableIterator[K, T]) End() bool {
 	return false
 }
 
+// TreeSubTableIterator is an iterator of a [TreeSubTable].
+type TreeSubTableIterator[K any, T any] struct {
+	// contains filtered or unexported fields
+	table *TreeSubTable[K, T]
+	node  *tree.Node[*Entry[K, T]]
+}
+
+// NewTreeSubTableIterator returns a new [TreeSubTableIterator] associated at the table parameter.
+func NewTreeSubTableIterator[K any, T any](table *TreeSubTable[K, T]) Iterator[K, T] {
+	node := table.bounds.First()
+	if node == nil {
+		return &endIterator[K, T]{}
+	}
+	return &TreeSubTableIterator[K, T]{table: table, node: node}
+}
+
+// Elements returns the element of the iterator.
+func (i *TreeSubTableIterator[K, T]) Element() T {
+	return i.node.Element().Element()
+}
+
+// Index returns the key of the element the iterator.
+func (i *TreeSubTableIterator[K, T]) Key() K {
+	return i.node.Element().Key()
+}
+
+// Index returns the position of the element of the iterator in the order of the [TreeSubTable].
+//
+// The position is computed from the tree, so it takes into account the removals done during the iteration.
+// This method runs in O(log n) time.
+func (i *TreeSubTableIterator[K, T]) Index() int {
+	return i.table.bounds.Index(i.node)
+}
+
+// Remove removes the element from the table and returns the iterator of the next element.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+//
+// An example of the use of the method is the following:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i = i.Remove()
+//		}
+//		// Code
+//	}
+//
+// The following code, instead, can lead to undefined program behavior:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i.Remove()
+//		}
+//		// Code
+//	}
+func (i *TreeSubTableIterator[K, T]) Remove() Iterator[K, T] {
+	i.node = i.table.bounds.Remove(i.node)
+	if i.node == nil {
+		return &endIterator[K, T]{}
+	}
+	return i
+}
+
+// Next returns the iterator of the next element.
+func (i *TreeSubTableIterator[K, T]) Next() Iterator[K, T] {
+	i.node = i.table.bounds.Next(i.node)
+	if i.node == nil {
+		return &endIterator[K, T]{}
+	}
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *TreeSubTableIterator[K, T]) End() bool {
+	return false
+}
+
 // MultiHashTableIterator is an iterator of a [MultiHashTable].
 type MultiHashTableIterator[K util.Hasher, T any] struct {
 	// contains filtered or unexported fields
@@ -299,28 +404,36 @@ func (i *MultiHashTableIterator[K, T]) nextKey() Iterator[K, T] {
 }
 
 // MultiTreeTableIterator is an iterator of a [MultiTreeTable].
-type MultiTreeTableIterator[K util.Comparer, T any] struct {
+type MultiTreeTableIterator[K any, T any] struct {
 	// contains filtered or unexported fields
-	table    *MultiTreeTable[K, T]
-	iterator tree.Iterator[*Entry[K, T]]
+	table *MultiTreeTable[K, T]
+	node  *tree.Node[*Entry[K, T]]
 }
 
 // NewMultiTreeTableIterator returns a new [MultiTreeTableIterator] associated at the table parameter.
-func NewMultiTreeTableIterator[K util.Comparer, T any](table *MultiTreeTable[K, T]) Iterator[K, T] {
+func NewMultiTreeTableIterator[K any, T any](table *MultiTreeTable[K, T]) Iterator[K, T] {
 	if table.IsEmpty() {
 		return &endIterator[K, T]{}
 	}
-	return &MultiTreeTableIterator[K, T]{table: table, iterator: tree.NewTreeIterator[*Entry[K, T]](table.objects)}
+	return &MultiTreeTableIterator[K, T]{table: table, node: table.objects.Root().Min()}
 }
 
 // Elements returns the element of the iterator.
 func (i *MultiTreeTableIterator[K, T]) Element() T {
-	return i.iterator.Element().Element()
+	return i.node.Element().Element()
 }
 
 // Index returns the key of the element the iterator.
 func (i *MultiTreeTableIterator[K, T]) Key() K {
-	return i.iterator.Element().Key()
+	return i.node.Element().Key()
+}
+
+// Index returns the position of the element of the iterator in the order of the table.
+//
+// The position is computed from the tree, so it takes into account the removals done during the iteration.
+// This method runs in O(log n) time.
+func (i *MultiTreeTableIterator[K, T]) Index() int {
+	return i.node.Index()
 }
 
 // Remove removes the element from the table and returns the iterator of the next element.
@@ -349,8 +462,8 @@ func (i *MultiTreeTableIterator[K, T]) Key() K {
 //		// Code
 //	}
 func (i *MultiTreeTableIterator[K, T]) Remove() Iterator[K, T] {
-	i.iterator = i.iterator.Remove()
-	if i.iterator.End() {
+	i.node = i.table.objects.RemoveNode(i.node)
+	if i.node == nil {
 		return &endIterator[K, T]{}
 	}
 	return i
@@ -358,6 +471,288 @@ func (i *MultiTreeTableIterator[K, T]) Remove() Iterator[K, T] {
 
 // Next returns the iterator of the next element.
 func (i *MultiTreeTableIterator[K, T]) Next() Iterator[K, T] {
+	i.node = i.node.Successor()
+	if i.node == nil {
+		return &endIterator[K, T]{}
+	}
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *MultiTreeTableIterator[K, T]) End() bool {
+	return false
+}
+
+// OpenHashTableIterator is an iterator of an [OpenHashTable].
+//
+// Since a removal can move the following entries of the table, the iterator works on a copy of the entries taken at its creation.
+type OpenHashTableIterator[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	table   *OpenHashTable[K, T]
+	entries []*Entry[K, T]
+	index   int
+}
+
+// NewOpenHashTableIterator returns a new [OpenHashTableIterator] associated at the table parameter.
+func NewOpenHashTableIterator[K util.Hasher, T any](table *OpenHashTable[K, T]) Iterator[K, T] {
+	if table.IsEmpty() {
+		return &endIterator[K, T]{}
+	}
+	entries := make([]*Entry[K, T], 0, table.Len())
+	table.Each(func(key K, element T) {
+		entries = append(entries, NewEntry(key, element))
+	})
+	return &OpenHashTableIterator[K, T]{table: table, entries: entries, index: 0}
+}
+
+// Elements returns the element of the iterator.
+func (i *OpenHashTableIterator[K, T]) Element() T {
+	return i.entries[i.index].Element()
+}
+
+// Index returns the key of the element the iterator.
+func (i *OpenHashTableIterator[K, T]) Key() K {
+	return i.entries[i.index].Key()
+}
+
+// Remove removes the element from the table and returns the iterator of the next element.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+//
+// An example of the use of the method is the following:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i = i.Remove()
+//		}
+//		// Code
+//	}
+//
+// The following code, instead, can lead to undefined program behavior:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i.Remove()
+//		}
+//		// Code
+//	}
+func (i *OpenHashTableIterator[K, T]) Remove() Iterator[K, T] {
+	i.table.Remove(i.entries[i.index].Key())
+	return i.Next()
+}
+
+// Next returns the iterator of the next element.
+func (i *OpenHashTableIterator[K, T]) Next() Iterator[K, T] {
+	i.index++
+	if i.index == len(i.entries) {
+		return &endIterator[K, T]{}
+	}
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *OpenHashTableIterator[K, T]) End() bool {
+	return false
+}
+
+// MultiOpenHashTableIterator is an iterator of a [MultiOpenHashTable].
+//
+// Since a removal can move the following entries of the table, the iterator works on a copy of the entries taken at its creation.
+type MultiOpenHashTableIterator[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	table   *MultiOpenHashTable[K, T]
+	entries []*Entry[K, T]
+	index   int
+}
+
+// NewMultiOpenHashTableIterator returns a new [MultiOpenHashTableIterator] associated at the table parameter.
+func NewMultiOpenHashTableIterator[K util.Hasher, T any](table *MultiOpenHashTable[K, T]) Iterator[K, T] {
+	if table.IsEmpty() {
+		return &endIterator[K, T]{}
+	}
+	entries := make([]*Entry[K, T], 0, table.Len())
+	table.Each(func(key K, element T) {
+		entries = append(entries, NewEntry(key, element))
+	})
+	return &MultiOpenHashTableIterator[K, T]{table: table, entries: entries, index: 0}
+}
+
+// Elements returns the element of the iterator.
+func (i *MultiOpenHashTableIterator[K, T]) Element() T {
+	return i.entries[i.index].Element()
+}
+
+// Index returns the key of the element the iterator.
+func (i *MultiOpenHashTableIterator[K, T]) Key() K {
+	return i.entries[i.index].Key()
+}
+
+// Remove removes the element from the table and returns the iterator of the next element.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+//
+// An example of the use of the method is the following:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i = i.Remove()
+//		}
+//		// Code
+//	}
+//
+// The following code, instead, can lead to undefined program behavior:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i.Remove()
+//		}
+//		// Code
+//	}
+func (i *MultiOpenHashTableIterator[K, T]) Remove() Iterator[K, T] {
+	i.table.Remove(i.entries[i.index].Key(), i.entries[i.index].Element())
+	return i.Next()
+}
+
+// Next returns the iterator of the next element.
+func (i *MultiOpenHashTableIterator[K, T]) Next() Iterator[K, T] {
+	i.index++
+	if i.index == len(i.entries) {
+		return &endIterator[K, T]{}
+	}
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *MultiOpenHashTableIterator[K, T]) End() bool {
+	return false
+}
+
+// ConcurrentHashTableIterator is an iterator of a [ConcurrentHashTable].
+//
+// The entries of a segment are read when the iterator reaches it,
+// so the iteration is weakly consistent and does not block the writers.
+type ConcurrentHashTableIterator[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	table   *ConcurrentHashTable[K, T]
+	entries []*Entry[K, T]
+	segment int
+	index   int
+}
+
+// NewConcurrentHashTableIterator returns a new [ConcurrentHashTableIterator] associated at the table parameter.
+func NewConcurrentHashTableIterator[K util.Hasher, T any](table *ConcurrentHashTable[K, T]) Iterator[K, T] {
+	iterator := &ConcurrentHashTableIterator[K, T]{table: table, entries: table.entries(0), segment: 0, index: 0}
+	if len(iterator.entries) == 0 {
+		return iterator.nextSegment()
+	}
+	return iterator
+}
+
+// Elements returns the element of the iterator.
+func (i *ConcurrentHashTableIterator[K, T]) Element() T {
+	return i.entries[i.index].Element()
+}
+
+// Index returns the key of the element the iterator.
+func (i *ConcurrentHashTableIterator[K, T]) Key() K {
+	return i.entries[i.index].Key()
+}
+
+// Remove removes the element from the table and returns the iterator of the next element.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+//
+// An example of the use of the method is the following:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i = i.Remove()
+//		}
+//		// Code
+//	}
+//
+// The following code, instead, can lead to undefined program behavior:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i.Remove()
+//		}
+//		// Code
+//	}
+func (i *ConcurrentHashTableIterator[K, T]) Remove() Iterator[K, T] {
+	i.table.Remove(i.Key())
+	return i.Next()
+}
+
+// Next returns the iterator of the next element.
+func (i *ConcurrentHashTableIterator[K, T]) Next() Iterator[K, T] {
+	i.index++
+	if i.index == len(i.entries) {
+		return i.nextSegment()
+	}
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *ConcurrentHashTableIterator[K, T]) End() bool {
+	return false
+}
+
+func (i *ConcurrentHashTableIterator[K, T]) nextSegment() Iterator[K, T] {
+	for i.index == len(i.entries) {
+		i.segment++
+		if i.segment == len(i.table.segments) {
+			return &endIterator[K, T]{}
+		}
+		i.entries = i.table.entries(i.segment)
+		i.index = 0
+	}
+	return i
+}
+
+// UnmodifiableTableIterator is an iterator of an [UnmodifiableTable] or [UnmodifiableMultiTable].
+type UnmodifiableTableIterator[K any, T any] struct {
+	// contains filtered or unexported fields
+	iterator Iterator[K, T]
+}
+
+// NewUnmodifiableTableIterator returns a new [UnmodifiableTableIterator] associated at the iterator parameter.
+func NewUnmodifiableTableIterator[K any, T any](iterator Iterator[K, T]) Iterator[K, T] {
+	if iterator.End() {
+		return &endIterator[K, T]{}
+	}
+	return &UnmodifiableTableIterator[K, T]{iterator: iterator}
+}
+
+// Elements returns the element of i.
+func (i *UnmodifiableTableIterator[K, T]) Element() T {
+	return i.iterator.Element()
+}
+
+// Key returns the key of i.
+func (i *UnmodifiableTableIterator[K, T]) Key() K {
+	return i.iterator.Key()
+}
+
+// Remove always panics with [structures.ErrUnmodifiable].
+func (i *UnmodifiableTableIterator[K, T]) Remove() Iterator[K, T] {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Next returns the iterator of the next element.
+func (i *UnmodifiableTableIterator[K, T]) Next() Iterator[K, T] {
 	i.iterator = i.iterator.Next()
 	if i.iterator.End() {
 		return &endIterator[K, T]{}
@@ -366,11 +761,11 @@ func (i *MultiTreeTableIterator[K, T]) Next() Iterator[K, T] {
 }
 
 // End checks if the iteration is finished.
-func (i *MultiTreeTableIterator[K, T]) End() bool {
+func (i *UnmodifiableTableIterator[K, T]) End() bool {
 	return false
 }
 
-type endIterator[K util.Comparer, T any] struct{}
+type endIterator[K any, T any] struct{}
 
 func (i *endIterator[K, T]) Element() T {
 	return *new(T)
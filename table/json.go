@@ -0,0 +1,171 @@
+package table
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+)
+
+var textMarshaler = reflect.TypeFor[encoding.TextMarshaler]()
+var textUnmarshaler = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// objectKey returns true if K can be used as the key of a JSON object.
+// As for the maps encoded by [json.Marshal], these are strings, integers and the types which implement [encoding.TextMarshaler].
+func objectKey[K any]() bool {
+	key := reflect.TypeFor[K]()
+	switch key.Kind() {
+	case reflect.String, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return key.Implements(textMarshaler) && reflect.PointerTo(key).Implements(textUnmarshaler)
+}
+
+func marshalKey[K any](key K) (string, error) {
+	value := reflect.ValueOf(&key).Elem()
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	}
+	text, err := any(key).(encoding.TextMarshaler).MarshalText()
+	return string(text), err
+}
+
+func unmarshalKey[K any](text string) (K, error) {
+
+	var key K
+
+	value := reflect.ValueOf(&key).Elem()
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+		return key, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		result, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		value.SetInt(result)
+		return key, err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		result, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		value.SetUint(result)
+		return key, err
+	}
+	unmarshaler, ok := any(&key).(encoding.TextUnmarshaler)
+	if !ok {
+		return key, errors.New("Cannot use a JSON object key as " + value.Type().String())
+	}
+	err := unmarshaler.UnmarshalText([]byte(text))
+	return key, err
+}
+
+// marshalEntries returns the JSON encoding of the entries produced by seq.
+//
+// The entries are encoded as a JSON object if K can be used as an object key,
+// otherwise they are encoded as an array of [key, element] pairs.
+func marshalEntries[K any, T any](seq func(yield func(K, T) bool)) ([]byte, error) {
+	object := objectKey[K]()
+	var buffer bytes.Buffer
+	if object {
+		buffer.WriteByte('{')
+	} else {
+		buffer.WriteByte('[')
+	}
+	first := true
+	for key, element := range seq {
+		if !first {
+			buffer.WriteByte(',')
+		}
+		first = false
+		var encodedKey []byte
+		var err error
+		if object {
+			var text string
+			if text, err = marshalKey(key); err == nil {
+				encodedKey, err = json.Marshal(text)
+			}
+		} else {
+			encodedKey, err = json.Marshal(key)
+		}
+		if err != nil {
+			return nil, err
+		}
+		encodedElement, err := json.Marshal(element)
+		if err != nil {
+			return nil, err
+		}
+		if object {
+			buffer.Write(encodedKey)
+			buffer.WriteByte(':')
+			buffer.Write(encodedElement)
+		} else {
+			buffer.WriteByte('[')
+			buffer.Write(encodedKey)
+			buffer.WriteByte(',')
+			buffer.Write(encodedElement)
+			buffer.WriteByte(']')
+		}
+	}
+	if object {
+		buffer.WriteByte('}')
+	} else {
+		buffer.WriteByte(']')
+	}
+	return buffer.Bytes(), nil
+}
+
+// unmarshalEntries decodes the entries encoded by [marshalEntries] and returns their keys and their elements.
+// It accepts both a JSON object and an array of [key, element] pairs.
+func unmarshalEntries[K any, T any](data []byte) ([]K, []T, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) != 0 && data[0] == '{' {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(data, &object); err != nil {
+			return nil, nil, err
+		}
+		keys := make([]K, 0, len(object))
+		elements := make([]T, 0, len(object))
+		for i, j := range object {
+			key, err := unmarshalKey[K](i)
+			if err != nil {
+				return nil, nil, err
+			}
+			var element T
+			if err := json.Unmarshal(j, &element); err != nil {
+				return nil, nil, err
+			}
+			keys = append(keys, key)
+			elements = append(elements, element)
+		}
+		return keys, elements, nil
+	}
+	var raw [][2]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, err
+	}
+	keys := make([]K, len(raw))
+	elements := make([]T, len(raw))
+	for i, j := range raw {
+		if err := json.Unmarshal(j[0], &keys[i]); err != nil {
+			return nil, nil, err
+		}
+		if err := json.Unmarshal(j[1], &elements[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return keys, elements, nil
+}
+
+// decodeEntries decodes the entries encoded by [marshalEntries] and passes their keys and their elements to decode.
+func decodeEntries[K any, T any](data []byte, decode func(keys []K, elements []T) error) error {
+	keys, elements, err := unmarshalEntries[K, T](data)
+	if err != nil {
+		return err
+	}
+	return decode(keys, elements)
+}
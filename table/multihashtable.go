@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/list"
 	"github.com/potex02/structures/util"
 	"github.com/potex02/structures/util/wrapper"
@@ -16,11 +17,17 @@ var _ BaseTable[wrapper.Int, int] = NewMultiHashTable[wrapper.Int, int]()
 var _ MultiTable[wrapper.Int, int] = NewMultiHashTable[wrapper.Int, int]()
 
 // MultiHashTable provides a generic table with duplicate keys implemented through hashing.
+// The entries with the same hash code are chained in a list, so an entry never moves once it has been put.
+//
+// The length of the table is cached, so Len and IsEmpty run in O(1) time.
+// Like [HashTable], it is kept beside its open addressing counterpart [MultiOpenHashTable],
+// which is faster but moves the entries on every insertion and removal.
 //
 // It implements the interface [MultiTable].
 type MultiHashTable[K util.Hasher, T any] struct {
 	// contains filtered or unexported fields
 	objects map[uint64]list.List[*Entry[K, T]]
+	len     int
 }
 
 // NewHashTable returns a new empty [MultiHashTable].
@@ -39,17 +46,15 @@ func NewMultiHashTableFromSlice[K util.Hasher, T any](key []K, c []T) *MultiHash
 }
 
 // Len returns the length of t.
+//
+// The length is updated at every insertion and removal, so this method runs in O(1) time.
 func (t *MultiHashTable[K, T]) Len() int {
-	result := 0
-	for _, i := range t.objects {
-		result += i.Len()
-	}
-	return result
+	return t.len
 }
 
 // IsEmpty returns a bool which indicates if t is empty or not.
 func (t *MultiHashTable[K, T]) IsEmpty() bool {
-	return t.Len() == 0
+	return t.len == 0
 }
 
 // Contains returns true if the key is present in on t associated with the element e.
@@ -141,11 +146,13 @@ func (t *MultiHashTable[K, T]) Put(key K, e ...T) {
 			list.Add(NewEntry(key, j))
 		}
 		t.objects[key.Hash()] = list
+		t.len += len(e)
 		return
 	}
 	for _, j := range e {
 		hash.Add(NewEntry(key, j))
 	}
+	t.len += len(e)
 }
 
 // PutSlice adds the elements of e at the table.
@@ -193,6 +200,7 @@ func (t *MultiHashTable[K, T]) Remove(key K, e T) bool {
 			if hash.IsEmpty() {
 				delete(t.objects, key.Hash())
 			}
+			t.len--
 			return true
 		}
 	}
@@ -215,6 +223,7 @@ func (t *MultiHashTable[K, T]) RemoveKey(key K) []T {
 	if hash.IsEmpty() {
 		delete(t.objects, key.Hash())
 	}
+	t.len -= len(result)
 	return result
 }
 
@@ -237,6 +246,7 @@ func (t *MultiHashTable[K, T]) Stream() *Stream[K, T] {
 // Clear removes all element from t.
 func (t *MultiHashTable[K, T]) Clear() {
 	t.objects = map[uint64]list.List[*Entry[K, T]]{}
+	t.len = 0
 }
 
 // Iter returns an [Iterator] which permits to iterate a [MultiHashTable].
@@ -299,13 +309,7 @@ func (t *MultiHashTable[K, T]) Equal(st any) bool {
 func (t *MultiHashTable[K, T]) Compare(st any) int {
 	table, ok := st.(MultiTable[K, T])
 	if ok && t != nil && table != nil {
-		if t.Len() < table.Len() {
-			return -1
-		}
-		if t.Len() > table.Len() {
-			return 1
-		}
-		return 0
+		return compareTables[K, T](t, table)
 	}
 	return -2
 }
@@ -333,16 +337,62 @@ func (t *MultiHashTable[K, T]) Copy() MultiTable[K, T] {
 
 // String returns a rapresentation of t in the form of a string.
 func (t *MultiHashTable[K, T]) String() string {
-	check := []string{reflect.TypeOf(new(K)).String(), reflect.TypeOf(new(T)).String()}
-	result := fmt.Sprintf("MultiHashTable[%v, %v][", check[0][1:], check[1][1:])
-	first := true
+	return tableString[K, T]("MultiHashTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t, in which every key is associated with the array of its elements.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, elements] pairs.
+func (t *MultiHashTable[K, T]) MarshalJSON() ([]byte, error) {
+	groups := NewOpenHashTable[K, []T]()
 	t.Each(func(key K, element T) {
-		if !first {
-			result += ", "
-		}
-		result += fmt.Sprintf("%v: %v", key, element)
-		first = false
+		elements, _ := groups.Get(key)
+		groups.Put(key, append(elements, element))
 	})
-	result += "]"
-	return result
+	return marshalEntries(groups.RangeIter())
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from data.
+//
+// data can be both a JSON object and an array of [key, elements] pairs.
+func (t *MultiHashTable[K, T]) UnmarshalJSON(data []byte) error {
+	key, c, err := unmarshalEntries[K, []T](data)
+	if err != nil {
+		return err
+	}
+	t.Clear()
+	for i := range key {
+		t.Put(key[i], c[i]...)
+	}
+	return nil
+}
+
+// MarshalBinary returns the binary encoding of t, which contains every element with its key.
+// The elements associated at the same key keep their order.
+func (t *MultiHashTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+func (t *MultiHashTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [MultiHashTable.MarshalBinary].
+func (t *MultiHashTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [MultiHashTable.UnmarshalBinary].
+func (t *MultiHashTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *MultiHashTable[K, T]) decode(key []K, c []T) error {
+	t.Clear()
+	for i := range key {
+		t.Put(key[i], c[i])
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package table
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/potex02/structures"
@@ -305,3 +306,23 @@ func TestCompareMultiHashTable(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONMultiHashTable(t *testing.T) {
+
+	var table *MultiHashTable[wrapper.Int, int] = NewMultiHashTableFromSlice([]wrapper.Int{1, 2, 1}, []int{10, 20, 11})
+
+	data, err := json.Marshal(table)
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	var object map[string][]int
+	if err := json.Unmarshal(data, &object); err != nil || len(object) != 2 || len(object["1"]) != 2 {
+		t.Log("data is", string(data))
+		t.Fail()
+	}
+	var result MultiHashTable[wrapper.Int, int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(table) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
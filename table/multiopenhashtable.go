@@ -0,0 +1,374 @@
+package table
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = NewMultiOpenHashTable[wrapper.Int, int]()
+var _ BaseTable[wrapper.Int, int] = NewMultiOpenHashTable[wrapper.Int, int]()
+var _ MultiTable[wrapper.Int, int] = NewMultiOpenHashTable[wrapper.Int, int]()
+
+// MultiOpenHashTable provides a generic table with duplicate keys implemented through an [OpenHashTable].
+// Every key is stored once, associated at the slice of its elements.
+//
+// The length of the table is cached, so Len and IsEmpty run in O(1) time.
+//
+// The zero value of a MultiOpenHashTable is an empty table with load factor [DefaultLoadFactor].
+//
+// It implements the interface [MultiTable].
+type MultiOpenHashTable[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	objects OpenHashTable[K, []T]
+	len     int
+}
+
+// NewMultiOpenHashTable returns a new empty [MultiOpenHashTable].
+//
+// The load factor of the table is [DefaultLoadFactor].
+func NewMultiOpenHashTable[K util.Hasher, T any]() *MultiOpenHashTable[K, T] {
+	return NewMultiOpenHashTableLoadFactor[K, T](DefaultLoadFactor)
+}
+
+// NewMultiOpenHashTableFromSlice returns a new [MultiOpenHashTable] containing the elements of slice c.
+// It panics if key and c have different lengths.
+func NewMultiOpenHashTableFromSlice[K util.Hasher, T any](key []K, c []T) *MultiOpenHashTable[K, T] {
+	table := NewMultiOpenHashTable[K, T]()
+	if len(c) != 0 {
+		table.PutSlice(key, c)
+	}
+	return table
+}
+
+// NewMultiOpenHashTableLoadFactor returns a new empty [MultiOpenHashTable] with the load factor loadFactor.
+//
+// This function panics if loadFactor is not greater than 0 and less than 1.
+func NewMultiOpenHashTableLoadFactor[K util.Hasher, T any](loadFactor float64) *MultiOpenHashTable[K, T] {
+	return &MultiOpenHashTable[K, T]{objects: *NewOpenHashTableLoadFactor[K, []T](loadFactor)}
+}
+
+// Len returns the length of t.
+func (t *MultiOpenHashTable[K, T]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *MultiOpenHashTable[K, T]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// LoadFactor returns the max ratio between the number of keys and the capacity of t.
+func (t *MultiOpenHashTable[K, T]) LoadFactor() float64 {
+	return t.objects.LoadFactor()
+}
+
+// Contains returns true if the key is present in on t associated with the element e.
+func (t *MultiOpenHashTable[K, T]) Contains(key K, e T) bool {
+	fun := util.EqualFunction(e)
+	elements, _ := t.objects.Get(key)
+	return slices.ContainsFunc(elements, func(i T) bool {
+		return fun(i)
+	})
+}
+
+// ContainsKey returns true if the key is present on t.
+func (t *MultiOpenHashTable[K, T]) ContainsKey(key K) bool {
+	return t.objects.ContainsKey(key)
+}
+
+// ContainsElement returns true if the element e is associated at any key of t.
+func (t *MultiOpenHashTable[K, T]) ContainsElement(e T) bool {
+	fun := util.EqualFunction(e)
+	for _, i := range t.objects.RangeIter() {
+		if slices.ContainsFunc(i, func(j T) bool {
+			return fun(j)
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns a [list.List] which contains all keys of t.
+func (t *MultiOpenHashTable[K, T]) Keys() list.List[K] {
+	list := list.NewArrayList[K]()
+	t.Each(func(key K, _ T) {
+		list.Add(key)
+	})
+	return list
+}
+
+// Elements returns a [list.List] which contains all elements of t.
+func (t *MultiOpenHashTable[K, T]) Elements() list.List[T] {
+	list := list.NewArrayList[T]()
+	t.Each(func(_ K, element T) {
+		list.Add(element)
+	})
+	return list
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *MultiOpenHashTable[K, T]) ToSlice() []T {
+	return t.Elements().ToSlice()
+}
+
+// Get returns a slice cotaining the elements associated at the key.
+func (t *MultiOpenHashTable[K, T]) Get(key K) []T {
+	elements, _ := t.objects.Get(key)
+	return append(make([]T, 0, len(elements)), elements...)
+}
+
+// Put add the elements of e at the key.
+func (t *MultiOpenHashTable[K, T]) Put(key K, e ...T) {
+	if len(e) == 0 {
+		return
+	}
+	index := t.objects.find(key)
+	if index == -1 {
+		t.objects.Put(key, slices.Clone(e))
+	} else {
+		t.objects.objects[index].element = append(t.objects.objects[index].element, e...)
+	}
+	t.len += len(e)
+}
+
+// PutSlice adds the elements of e at the table.
+// It panics if key and e have different lengths.
+func (t *MultiOpenHashTable[K, T]) PutSlice(key []K, e []T) {
+	if len(key) != len(e) {
+		panic("Different lengths for keys and elements")
+	}
+	for i := 0; i != len(key); i++ {
+		t.Put(key[i], e[i])
+	}
+}
+
+// Replace replace all elements associated at the key with e and returns the slice of overwritten values.
+func (t *MultiOpenHashTable[K, T]) Replace(key K, e ...T) []T {
+	result := t.RemoveKey(key)
+	t.Put(key, e...)
+	return result
+}
+
+// ReplaceSlice replace all elements associated at the key with e and returns the slice of overwritten values.
+func (t *MultiOpenHashTable[K, T]) ReplaceSlice(key []K, e []T) []T {
+	if len(key) != len(e) {
+		panic("Different lengths for keys and elements")
+	}
+	result := make([]T, 0)
+	for _, i := range key {
+		result = append(result, t.RemoveKey(i)...)
+	}
+	t.PutSlice(key, e)
+	return result
+}
+
+// Remove removes the key associated at e from t.
+// It returns false if the the entry does not exists.
+func (t *MultiOpenHashTable[K, T]) Remove(key K, e T) bool {
+	index := t.objects.find(key)
+	if index == -1 {
+		return false
+	}
+	fun := util.EqualFunction(e)
+	elements := t.objects.objects[index].element
+	position := slices.IndexFunc(elements, func(i T) bool {
+		return fun(i)
+	})
+	if position == -1 {
+		return false
+	}
+	if len(elements) == 1 {
+		t.objects.Remove(key)
+	} else {
+		t.objects.objects[index].element = slices.Delete(elements, position, position+1)
+	}
+	t.len--
+	return true
+}
+
+// RemoveKey remove all elements associated at the key and returns the slice of removed values.
+func (t *MultiOpenHashTable[K, T]) RemoveKey(key K) []T {
+	result, ok := t.objects.Remove(key)
+	if !ok {
+		return make([]T, 0)
+	}
+	t.len -= len(result)
+	return result
+}
+
+// Each executes fun for all elements of t.
+//
+// This method should be used to remove elements. Use Iter insted.
+func (t *MultiOpenHashTable[K, T]) Each(fun func(key K, element T)) {
+	t.objects.Each(func(key K, elements []T) {
+		for _, i := range elements {
+			fun(key, i)
+		}
+	})
+}
+
+// Stream returns a [Stream] rapresenting t.
+func (t *MultiOpenHashTable[K, T]) Stream() *Stream[K, T] {
+	return NewStream[K, T](t, reflect.ValueOf(func() *MultiOpenHashTable[K, T] {
+		return NewMultiOpenHashTableLoadFactor[K, T](t.LoadFactor())
+	}))
+}
+
+// Clear removes all element from t.
+func (t *MultiOpenHashTable[K, T]) Clear() {
+	t.objects.Clear()
+	t.len = 0
+}
+
+// Iter returns an [Iterator] which permits to iterate a [MultiOpenHashTable].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		key := i.Key()
+//		element := i.Element()
+//		// Code
+//	}
+func (t *MultiOpenHashTable[K, T]) Iter() Iterator[K, T] {
+	return NewMultiOpenHashTableIterator(t)
+}
+
+// RangeIter returns a function that allows to iterate a [MultiOpenHashTable] using the range keyword.
+//
+//	for i := range t.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [MultiOpenHashTable.Iter], it doesn't allow to remove elements during the iteration.
+func (t *MultiOpenHashTable[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		for key, elements := range t.objects.RangeIter() {
+			for _, i := range elements {
+				if !yield(key, i) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Equal returns true if t and st are both multitables and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [MultiHashTable],
+// but the elements of t and the elements of st are equals, this method returns anyway true.
+func (t *MultiOpenHashTable[K, T]) Equal(st any) bool {
+	table, ok := st.(MultiTable[K, T])
+	if ok && t != nil && table != nil {
+		if t.Len() != table.Len() {
+			return false
+		}
+		for key, element := range t.RangeIter() {
+			if !table.Contains(key, element) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Compare returns 0 if t and st have the same length,
+// -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [MultiTable] or if one between t and st is nil.
+func (t *MultiOpenHashTable[K, T]) Compare(st any) int {
+	table, ok := st.(MultiTable[K, T])
+	if ok && t != nil && table != nil {
+		return compareTables[K, T](t, table)
+	}
+	return -2
+}
+
+// Hash returns the hash code of t.
+//
+// The hash codes of the entries are summed, so the result does not depend on the order of the entries in t.
+func (t *MultiOpenHashTable[K, T]) Hash() uint64 {
+	var result uint64
+	t.Each(func(key K, element T) {
+		result += NewEntry(key, element).Hash()
+	})
+	return result
+}
+
+// Copy returns a multitable containing a copy of the elements of t.
+// The result of this method is of type [MultiTable], but the effective table which is created is a [MultiOpenHashTable]
+// with the same load factor of t.
+//
+// This method uses [util.Copy] to make copies of the elements.
+func (t *MultiOpenHashTable[K, T]) Copy() MultiTable[K, T] {
+	table := NewMultiOpenHashTableLoadFactor[K, T](t.LoadFactor())
+	t.Each(func(key K, element T) {
+		table.Put(key, util.Copy(element))
+	})
+	return table
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *MultiOpenHashTable[K, T]) String() string {
+	return tableString[K, T]("MultiOpenHashTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t, in which every key is associated with the array of its elements.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, elements] pairs.
+func (t *MultiOpenHashTable[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(t.objects.RangeIter())
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from data.
+// If t is the zero value, its load factor is [DefaultLoadFactor].
+//
+// data can be both a JSON object and an array of [key, elements] pairs.
+func (t *MultiOpenHashTable[K, T]) UnmarshalJSON(data []byte) error {
+	key, c, err := unmarshalEntries[K, []T](data)
+	if err != nil {
+		return err
+	}
+	t.Clear()
+	for i := range key {
+		t.Put(key[i], c[i]...)
+	}
+	return nil
+}
+
+// MarshalBinary returns the binary encoding of t, which contains every element with its key.
+// The elements associated at the same key keep their order.
+func (t *MultiOpenHashTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+// If t is the zero value, its load factor is [DefaultLoadFactor].
+func (t *MultiOpenHashTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [MultiOpenHashTable.MarshalBinary].
+func (t *MultiOpenHashTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [MultiOpenHashTable.UnmarshalBinary].
+func (t *MultiOpenHashTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *MultiOpenHashTable[K, T]) decode(key []K, c []T) error {
+	t.Clear()
+	for i := range key {
+		t.Put(key[i], c[i])
+	}
+	return nil
+}
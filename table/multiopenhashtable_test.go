@@ -0,0 +1,229 @@
+package table
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewMultiOpenHashTable(t *testing.T) {
+
+	var table structures.Structure[int] = NewMultiOpenHashTable[wrapper.Int, int]()
+
+	if table == nil {
+		t.Log("table is nil")
+		t.Fail()
+	}
+	if table.Len() != 0 {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+}
+func TestNewMultiOpenHashTableFromSlice(t *testing.T) {
+
+	var table *MultiOpenHashTable[wrapper.String, float32] = NewMultiOpenHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao", "a", "Ciao"},
+		[]float32{1.2, 5.6, -1, 0},
+	)
+
+	if table == nil {
+		t.Log("table is nil")
+		t.Fail()
+	}
+	if table.Len() != 4 {
+		t.Log("length is not 4")
+		t.Fail()
+	}
+}
+func TestZeroMultiOpenHashTable(t *testing.T) {
+
+	var table MultiOpenHashTable[wrapper.String, int]
+
+	if table.ContainsKey("a") || len(table.Get("a")) != 0 {
+		t.Log("found a in table")
+		t.Fail()
+	}
+	table.Put("a", 1, 2)
+	if !slices.Equal(table.Get("a"), []int{1, 2}) || table.Len() != 2 {
+		t.Log("table is", &table)
+		t.Fail()
+	}
+}
+func TestContainsMultiOpenHashTable(t *testing.T) {
+
+	var table MultiTable[wrapper.String, float32] = NewMultiOpenHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao", "a", "Ciao"},
+		[]float32{1.2, 5.6, -1, 0},
+	)
+
+	if !table.Contains("Ciao", 0) {
+		t.Log("not found \"Ciao\"-0 in table")
+		t.Fail()
+	}
+	if !table.Contains("Ciao", 5.6) {
+		t.Log("not found \"Ciao\"-5.6 in table")
+		t.Fail()
+	}
+	if table.Contains("Ciao", -1) {
+		t.Log("found \"Ciao\"-1 in table")
+		t.Fail()
+	}
+	if !table.ContainsElement(-1) || table.ContainsElement(2) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestReplaceMultiOpenHashTable(t *testing.T) {
+
+	var table *MultiOpenHashTable[wrapper.String, float32] = NewMultiOpenHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao", "a", "Ciao"},
+		[]float32{1.2, 5.6, -1, 0},
+	)
+
+	if len(table.Replace("Ciao", 2)) != 2 {
+		t.Log("not found 2 elements")
+		t.Fail()
+	}
+	if len(table.Get("Ciao")) != 1 {
+		t.Log("not found 1 element")
+		t.Fail()
+	}
+	if table.Len() != 3 {
+		t.Log("length is", table.Len())
+		t.Fail()
+	}
+}
+func TestRemoveMultiOpenHashTable(t *testing.T) {
+
+	var table *MultiOpenHashTable[wrapper.String, float32] = NewMultiOpenHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao", "a", "Ciao"},
+		[]float32{1.2, 5.6, -1, 0},
+	)
+
+	if !table.Remove("Ciao", 0) {
+		t.Log("not found \"Ciao\"-0 in table")
+		t.Fail()
+	}
+	if len(table.Get("Ciao")) != 1 {
+		t.Log("not found 1 element")
+		t.Fail()
+	}
+	if table.Remove("Ciao", 0) {
+		t.Log("found \"Ciao\"-0 in table")
+		t.Fail()
+	}
+	if table.Remove("A", 12) {
+		t.Log("found \"A\"-12 elements")
+		t.Fail()
+	}
+	if !table.Remove("Ciao", 5.6) || table.ContainsKey("Ciao") {
+		t.Log("found \"Ciao\" in table")
+		t.Fail()
+	}
+	if table.Len() != 2 {
+		t.Log("length is", table.Len())
+		t.Fail()
+	}
+}
+func TestRemoveKeyMultiOpenHashTable(t *testing.T) {
+
+	var table *MultiOpenHashTable[wrapper.String, float32] = NewMultiOpenHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao", "a", "Ciao"},
+		[]float32{1.2, 5.6, -1, 0},
+	)
+
+	if len(table.RemoveKey("Ciao")) != 2 {
+		t.Log("not found 2 elements")
+		t.Fail()
+	}
+	if len(table.Get("Ciao")) != 0 {
+		t.Log("not found 0 element")
+		t.Fail()
+	}
+	if len(table.RemoveKey("A")) != 0 {
+		t.Log("not found 0 elements")
+		t.Fail()
+	}
+	if table.Len() != 2 {
+		t.Log("length is", table.Len())
+		t.Fail()
+	}
+}
+func TestIterMultiOpenHashTable(t *testing.T) {
+
+	var table *MultiOpenHashTable[wrapper.String, float32] = NewMultiOpenHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao", "a", "Ciao"},
+		[]float32{1.2, 5.6, -1, 0},
+	)
+
+	for i := table.Iter(); !i.End(); {
+		if i.Key() == "Ciao" {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+		}
+	}
+	if table.Len() != 2 || table.ContainsKey("Ciao") {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestEqualMultiOpenHashTable(t *testing.T) {
+
+	var table BaseTable[wrapper.String, float32] = NewMultiOpenHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao", "a", "Ciao"},
+		[]float32{1.2, 5.6, -1, 0},
+	)
+
+	if !table.Equal(NewMultiHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Ciao", "Ciao", "a", "Hello"},
+		[]float32{0, 5.6, -1, 1.2},
+	)) {
+		t.Log("tables are not equals")
+		t.Fail()
+	}
+	if table.Equal(NewMultiOpenHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao", "a", "Ciao"},
+		[]float32{1.2, 5.6, -1, 1},
+	)) {
+		t.Log("tables are equals")
+		t.Fail()
+	}
+}
+func TestCompareMultiOpenHashTable(t *testing.T) {
+
+	var table *MultiOpenHashTable[wrapper.String, float32] = NewMultiOpenHashTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao", "a", "Ciao"},
+		[]float32{1.2, 5.6, -1, 0},
+	)
+
+	if table.Compare(NewMultiTreeTableFromSlice[wrapper.String, float32](
+		[]wrapper.String{"Hello", "Ciao"},
+		[]float32{1.2, 5.6},
+	)) != 1 {
+		t.Log("compare is not 1")
+		t.Fail()
+	}
+	if table.Compare(nil) != -2 {
+		t.Log("compare is not -2")
+		t.Fail()
+	}
+}
+func TestJSONMultiOpenHashTable(t *testing.T) {
+
+	var table *MultiOpenHashTable[wrapper.Int, int] = NewMultiOpenHashTableFromSlice([]wrapper.Int{1, 2, 1}, []int{10, 20, 11})
+
+	data, err := json.Marshal(table)
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	var result MultiOpenHashTable[wrapper.Int, int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(table) || result.Len() != 3 {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
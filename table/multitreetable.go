@@ -1,12 +1,14 @@
 package table
 
 import (
+	"errors"
 	"fmt"
 	"hash/fnv"
 	"math/rand"
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/list"
 	"github.com/potex02/structures/tree"
 	"github.com/potex02/structures/util"
@@ -17,24 +19,48 @@ var _ structures.Structure[int] = NewMultiTreeTable[wrapper.Int, int]()
 var _ BaseTable[wrapper.Int, int] = NewMultiTreeTable[wrapper.Int, int]()
 var _ MultiTable[wrapper.Int, int] = NewMultiTreeTable[wrapper.Int, int]()
 
-// MultiTreeTable provides a generic table with duplicate keys implemented through a [tree.BinaryTree].
+// MultiTreeTable provides a generic table with duplicate keys implemented through a [tree.RedBlackTree].
 // It maintains the order of the keys.
 //
+// The order is determined by the Compare method if the table is created with [NewMultiTreeTable],
+// otherwise it is determined by the comparison function passed to [NewMultiTreeTableFunc].
+//
 // It implements the interface [MultiTable].
-type MultiTreeTable[K util.Comparer, T any] struct {
+type MultiTreeTable[K any, T any] struct {
 	// contains filtered or unexported fields
-	objects *tree.BinaryTree[*Entry[K, T]]
+	objects *tree.RedBlackTree[*Entry[K, T]]
+	compare func(i K, j K) int
 }
 
 // NewMultiTreeTable returns a new empty [MultiTreeTable] containing the elements c.
 func NewMultiTreeTable[K util.Comparer, T any]() *MultiTreeTable[K, T] {
-	return &MultiTreeTable[K, T]{objects: tree.NewBinaryTree[*Entry[K, T]]()}
+	return NewMultiTreeTableFunc[K, T](util.Compare[K])
 }
 
 // NewMultiTreeTableFromSlice returns a new [MultiTreeTable] containing the elements of slice c.
 // It panics if key and c have different lengths.
 func NewMultiTreeTableFromSlice[K util.Comparer, T any](key []K, c []T) *MultiTreeTable[K, T] {
-	table := NewMultiTreeTable[K, T]()
+	return NewMultiTreeTableFromSliceFunc(util.Compare[K], key, c)
+}
+
+// NewMultiTreeTableFunc returns a new empty [MultiTreeTable], whose keys are ordered by the compare function.
+//
+// compare must return a negative number if i is placed before j, a positive number if i is placed after j
+// and zero if they are equals.
+func NewMultiTreeTableFunc[K any, T any](compare func(i K, j K) int) *MultiTreeTable[K, T] {
+	return &MultiTreeTable[K, T]{
+		objects: tree.NewRedBlackTreeFunc(func(i *Entry[K, T], j *Entry[K, T]) int {
+			return compare(i.Key(), j.Key())
+		}),
+		compare: compare,
+	}
+}
+
+// NewMultiTreeTableFromSliceFunc returns a new [MultiTreeTable] containing the elements of slice c,
+// whose keys are ordered by the compare function.
+// It panics if key and c have different lengths.
+func NewMultiTreeTableFromSliceFunc[K any, T any](compare func(i K, j K) int, key []K, c []T) *MultiTreeTable[K, T] {
+	table := NewMultiTreeTableFunc[K, T](compare)
 	if len(c) != 0 {
 		table.PutSlice(key, c)
 	}
@@ -55,15 +81,13 @@ func (t *MultiTreeTable[K, T]) IsEmpty() bool {
 func (t *MultiTreeTable[K, T]) Contains(key K, e T) bool {
 	fun := util.EqualFunction(e)
 	return t.objects.Any(t.objects.Root(), func(i *tree.Node[*Entry[K, T]]) bool {
-		return key.Compare(i.Element().Key()) == 0 && fun(i.Element().Element())
+		return t.compare(key, i.Element().Key()) == 0 && fun(i.Element().Element())
 	})
 }
 
 // ContainsKey returns true if the key is present on t.
 func (t *MultiTreeTable[K, T]) ContainsKey(key K) bool {
-	return t.objects.Any(t.objects.Root(), func(i *tree.Node[*Entry[K, T]]) bool {
-		return key.Compare(i.Element().Key()) == 0
-	})
+	return t.objects.Contains(NewEntry(key, *new(T)))
 }
 
 // ContainsElement returns true if the element e is associated at any key of t.
@@ -97,7 +121,7 @@ func (t *MultiTreeTable[K, T]) ToSlice() []T {
 func (t *MultiTreeTable[K, T]) Get(key K) []T {
 	result := make([]T, 0)
 	t.objects.All(t.objects.Root(), func(i *tree.Node[*Entry[K, T]]) bool {
-		check := key.Compare(i.Element().Key())
+		check := t.compare(key, i.Element().Key())
 		if check == 0 {
 			result = append(result, i.Element().Element())
 		}
@@ -150,9 +174,9 @@ func (t *MultiTreeTable[K, T]) Remove(key K, e T) bool {
 	fun := util.EqualFunction(e)
 	found := false
 	return !t.objects.None(t.objects.Root(), func(i *tree.Node[*Entry[K, T]]) bool {
-		if key.Compare(i.Element().Key()) == 0 && fun(i.Element().Element()) {
+		if t.compare(key, i.Element().Key()) == 0 && fun(i.Element().Element()) {
 			t.objects.RemoveFunc(i.Element(), func(i *Entry[K, T], other *tree.Node[*Entry[K, T]]) bool {
-				return i.Key().Compare(other.Element().Key()) == 0 && util.EqualFunction(i.Element())(other.Element().Element())
+				return t.compare(i.Key(), other.Element().Key()) == 0 && util.EqualFunction(i.Element())(other.Element().Element())
 			})
 			found = true
 		}
@@ -164,13 +188,13 @@ func (t *MultiTreeTable[K, T]) Remove(key K, e T) bool {
 func (t *MultiTreeTable[K, T]) RemoveKey(key K) []T {
 	result := make([]T, 0)
 	for i := t.objects.Iter(); !i.End(); i = i.Next() {
-		check := key.Compare(i.Element().Key())
+		check := t.compare(key, i.Element().Key())
 		for !i.End() && check == 0 {
 			result = append(result, i.Element().Element())
 			i = i.Remove()
-			check = key.Compare(i.Element().Key())
+			check = t.compare(key, i.Element().Key())
 		}
-		if check == -1 {
+		if check < 0 {
 			return result
 		}
 	}
@@ -188,7 +212,9 @@ func (t *MultiTreeTable[K, T]) Each(fun func(key K, element T)) {
 
 // Stream returns a [MultiStream] rapresenting t.
 func (t *MultiTreeTable[K, T]) Stream() *Stream[K, T] {
-	return NewStream[K, T](t, reflect.ValueOf(NewMultiTreeTable[K, T]))
+	return NewStream[K, T](t, reflect.ValueOf(func() *MultiTreeTable[K, T] {
+		return NewMultiTreeTableFunc[K, T](t.compare)
+	}))
 }
 
 // Clear removes all element from t.
@@ -249,13 +275,7 @@ func (t *MultiTreeTable[K, T]) Equal(st any) bool {
 func (t *MultiTreeTable[K, T]) Compare(st any) int {
 	table, ok := st.(MultiTable[K, T])
 	if ok && t != nil && table != nil {
-		if t.Len() < table.Len() {
-			return -1
-		}
-		if t.Len() > table.Len() {
-			return 1
-		}
-		return 0
+		return compareTables[K, T](t, table)
 	}
 	return -2
 }
@@ -276,7 +296,7 @@ func (t *MultiTreeTable[K, T]) Hash() uint64 {
 func (t *MultiTreeTable[K, T]) Copy() MultiTable[K, T] {
 	slice := t.objects.ToSlice()
 	rand.Shuffle(len(slice), func(i, j int) { slice[i], slice[j] = slice[j], slice[i] })
-	result := NewMultiTreeTable[K, T]()
+	result := NewMultiTreeTableFunc[K, T](t.compare)
 	for _, i := range slice {
 		result.Put(i.Key(), util.Copy(i.Element()))
 	}
@@ -285,16 +305,94 @@ func (t *MultiTreeTable[K, T]) Copy() MultiTable[K, T] {
 
 // String returns a rapresentation of t in the form of a string.
 func (t *MultiTreeTable[K, T]) String() string {
-	check := []string{reflect.TypeOf(new(K)).String(), reflect.TypeOf(new(T)).String()}
-	result := fmt.Sprintf("MultiTreeTable[%v, %v][", check[0][1:], check[1][1:])
-	first := true
-	t.objects.Each(t.objects.Root(), func(i *tree.Node[*Entry[K, T]]) {
-		if !first {
-			result += ", "
+	return tableString[K, T]("MultiTreeTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t, in which every key is associated with the array of its elements.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, elements] pairs.
+// In both cases, the entries are in ascending order of the keys.
+func (t *MultiTreeTable[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(func(yield func(K, []T) bool) {
+		var key K
+		var elements []T
+		for i, j := range t.RangeIter() {
+			if len(elements) != 0 && t.compare(key, i) != 0 {
+				if !yield(key, elements) {
+					return
+				}
+				elements = nil
+			}
+			key = i
+			elements = append(elements, j)
+		}
+		if len(elements) != 0 {
+			yield(key, elements)
 		}
-		result += fmt.Sprintf("%v: %v", i.Element().Key(), i.Element().Element())
-		first = false
 	})
-	result += "]"
-	return result
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from data.
+//
+// data can be both a JSON object and an array of [key, elements] pairs.
+//
+// If t is the zero value, the keys are ordered by their Compare method.
+// In this case, the method returns an error if K does not implement [util.Comparer].
+func (t *MultiTreeTable[K, T]) UnmarshalJSON(data []byte) error {
+	key, c, err := unmarshalEntries[K, []T](data)
+	if err != nil {
+		return err
+	}
+	if t.objects == nil {
+		compare := codec.Compare[K]()
+		if compare == nil {
+			return errors.New("Cannot decode a MultiTreeTable whose keys do not implement util.Comparer")
+		}
+		*t = *NewMultiTreeTableFunc[K, T](compare)
+	}
+	t.Clear()
+	for i := range key {
+		t.Put(key[i], c[i]...)
+	}
+	return nil
+}
+
+// MarshalBinary returns the binary encoding of t, which contains every element with its key in ascending order of the keys.
+// The elements associated at the same key keep their order.
+func (t *MultiTreeTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+//
+// If t is the zero value, the keys are ordered by their Compare method.
+// In this case, the method returns an error if K does not implement [util.Comparer].
+func (t *MultiTreeTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [MultiTreeTable.MarshalBinary].
+func (t *MultiTreeTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [MultiTreeTable.UnmarshalBinary].
+func (t *MultiTreeTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *MultiTreeTable[K, T]) decode(key []K, c []T) error {
+	if t.objects == nil {
+		compare := codec.Compare[K]()
+		if compare == nil {
+			return errors.New("Cannot decode a MultiTreeTable whose keys do not implement util.Comparer")
+		}
+		*t = *NewMultiTreeTableFunc[K, T](compare)
+	}
+	t.Clear()
+	for i := range key {
+		t.Put(key[i], c[i])
+	}
+	return nil
 }
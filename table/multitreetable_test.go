@@ -1,6 +1,7 @@
 package table
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/potex02/structures"
@@ -305,3 +306,22 @@ func TestCompareMultiTreeTable(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONMultiTreeTable(t *testing.T) {
+
+	var table *MultiTreeTable[wrapper.String, int] = NewMultiTreeTableFromSlice([]wrapper.String{"b", "a", "b"}, []int{2, 1, 3})
+
+	data, err := json.Marshal(table)
+	if err != nil || (string(data) != `{"a":[1],"b":[2,3]}` && string(data) != `{"a":[1],"b":[3,2]}`) {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result MultiTreeTable[wrapper.String, int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(table) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	if data, err := json.Marshal(NewMultiTreeTable[wrapper.String, int]()); err != nil || string(data) != "{}" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,421 @@
+package table
+
+import (
+	"fmt"
+	"math/bits"
+	"reflect"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = NewOpenHashTable[wrapper.Int, int]()
+var _ BaseTable[wrapper.Int, int] = NewOpenHashTable[wrapper.Int, int]()
+var _ Table[wrapper.Int, int] = NewOpenHashTable[wrapper.Int, int]()
+
+// DefaultLoadFactor is the load factor used by [NewOpenHashTable].
+const DefaultLoadFactor float64 = 0.75
+
+const minCapacity int = 8
+
+// OpenHashTable provides a generic table implemented through open addressing.
+// The entries are stored in a single slice and the collisions are resolved with Robin Hood linear probing.
+//
+// The length of the table is cached, so Len and IsEmpty run in O(1) time.
+// When the ratio between the length and the capacity of the table exceeds the load factor, the capacity is doubled.
+//
+// The zero value of an OpenHashTable is an empty table with load factor [DefaultLoadFactor],
+// whose slots are allocated by the first call of Put.
+//
+// It implements the interface [Table].
+type OpenHashTable[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	objects    []slot[K, T]
+	len        int
+	shift      int
+	loadFactor float64
+}
+
+type slot[K util.Hasher, T any] struct {
+	key     K
+	element T
+	hash    uint64
+	// distance is the probe distance from the home index plus one, 0 for an empty slot.
+	distance int
+}
+
+// NewOpenHashTable returns a new empty [OpenHashTable].
+//
+// The load factor of the table is [DefaultLoadFactor].
+func NewOpenHashTable[K util.Hasher, T any]() *OpenHashTable[K, T] {
+	return NewOpenHashTableLoadFactor[K, T](DefaultLoadFactor)
+}
+
+// NewOpenHashTableFromSlice returns a new [OpenHashTable] containing the elements of slice c.
+// It panics if key and c have different lengths.
+func NewOpenHashTableFromSlice[K util.Hasher, T any](key []K, c []T) *OpenHashTable[K, T] {
+	table := NewOpenHashTable[K, T]()
+	if len(c) != 0 {
+		table.PutSlice(key, c)
+	}
+	return table
+}
+
+// NewOpenHashTableLoadFactor returns a new empty [OpenHashTable] with the load factor loadFactor.
+//
+// This function panics if loadFactor is not greater than 0 and less than 1.
+func NewOpenHashTableLoadFactor[K util.Hasher, T any](loadFactor float64) *OpenHashTable[K, T] {
+	if loadFactor <= 0 || loadFactor >= 1 {
+		panic(fmt.Sprintf("Invalid load factor %v", loadFactor))
+	}
+	table := &OpenHashTable[K, T]{loadFactor: loadFactor}
+	table.resize(minCapacity)
+	return table
+}
+
+// Len returns the length of t.
+func (t *OpenHashTable[K, T]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *OpenHashTable[K, T]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// Cap returns the number of slots of t.
+func (t *OpenHashTable[K, T]) Cap() int {
+	return len(t.objects)
+}
+
+// LoadFactor returns the max ratio between the length and the capacity of t.
+func (t *OpenHashTable[K, T]) LoadFactor() float64 {
+	return t.loadFactor
+}
+
+// ContainsKey returns true if the key is present on t.
+func (t *OpenHashTable[K, T]) ContainsKey(key K) bool {
+	return t.find(key) != -1
+}
+
+// ContainsElement returns true if the element e is present on t.
+func (t *OpenHashTable[K, T]) ContainsElement(e T) bool {
+	fun := util.EqualFunction(e)
+	for _, i := range t.objects {
+		if i.distance != 0 && fun(i.element) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns a [list.List] which contains all keys of t.
+func (t *OpenHashTable[K, T]) Keys() list.List[K] {
+	list := list.NewArrayList[K]()
+	t.Each(func(key K, _ T) {
+		list.Add(key)
+	})
+	return list
+}
+
+// Elements returns a [list.List] which contains all elements of t.
+func (t *OpenHashTable[K, T]) Elements() list.List[T] {
+	list := list.NewArrayList[T]()
+	t.Each(func(_ K, element T) {
+		list.Add(element)
+	})
+	return list
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *OpenHashTable[K, T]) ToSlice() []T {
+	return t.Elements().ToSlice()
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+func (t *OpenHashTable[K, T]) Get(key K) (T, bool) {
+	index := t.find(key)
+	if index == -1 {
+
+		var result T
+
+		return result, false
+	}
+	return t.objects[index].element, true
+}
+
+// Put set the element e at the key and returns the overwritten value, if present.
+// If the element is not present, the method returns false.
+func (t *OpenHashTable[K, T]) Put(key K, e T) (T, bool) {
+
+	var result T
+
+	if index := t.find(key); index != -1 {
+		result = t.objects[index].element
+		t.objects[index].element = e
+		return result, true
+	}
+	if len(t.objects) == 0 {
+		if t.loadFactor == 0 {
+			t.loadFactor = DefaultLoadFactor
+		}
+		t.resize(minCapacity)
+	} else if float64(t.len+1) > float64(len(t.objects))*t.loadFactor {
+		t.resize(len(t.objects) * 2)
+	}
+	t.insert(slot[K, T]{key: key, element: e, hash: key.Hash()})
+	t.len++
+	return result, false
+}
+
+// PutSlice adds the elements of e at t.
+// It panics if key and e have different lengths.
+func (t *OpenHashTable[K, T]) PutSlice(key []K, e []T) {
+	if len(key) != len(e) {
+		panic("Different lengths for keys and elements")
+	}
+	for i := 0; i != len(key); i++ {
+		t.Put(key[i], e[i])
+	}
+}
+
+// Remove removes the key from t and returns the value associated at the key.
+// It returns false if the the key does not exists.
+//
+// The following entries of the same cluster are shifted backward, so no tombstone is left in t.
+func (t *OpenHashTable[K, T]) Remove(key K) (T, bool) {
+
+	var result T
+
+	index := t.find(key)
+	if index == -1 {
+		return result, false
+	}
+	result = t.objects[index].element
+	mask := len(t.objects) - 1
+	for {
+		next := (index + 1) & mask
+		if t.objects[next].distance <= 1 {
+			t.objects[index] = slot[K, T]{}
+			break
+		}
+		t.objects[index] = t.objects[next]
+		t.objects[index].distance--
+		index = next
+	}
+	t.len--
+	return result, true
+}
+
+// Each executes fun for all elements of t.
+//
+// This method should be used to remove elements. Use Iter insted.
+func (t *OpenHashTable[K, T]) Each(fun func(key K, element T)) {
+	for _, i := range t.objects {
+		if i.distance != 0 {
+			fun(i.key, i.element)
+		}
+	}
+}
+
+// Stream returns a [Stream] rapresenting t.
+func (t *OpenHashTable[K, T]) Stream() *Stream[K, T] {
+	return NewStream[K, T](t, reflect.ValueOf(func() *OpenHashTable[K, T] {
+		return NewOpenHashTableLoadFactor[K, T](t.loadFactor)
+	}))
+}
+
+// Clear removes all element from t.
+func (t *OpenHashTable[K, T]) Clear() {
+	t.objects = nil
+	t.resize(minCapacity)
+	t.len = 0
+}
+
+// Iter returns an [Iterator] which permits to iterate an [OpenHashTable].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		key := i.Key()
+//		element := i.Element()
+//		// Code
+//	}
+func (t *OpenHashTable[K, T]) Iter() Iterator[K, T] {
+	return NewOpenHashTableIterator(t)
+}
+
+// RangeIter returns a function that allows to iterate an [OpenHashTable] using the range keyword.
+//
+//	for i := range t.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [OpenHashTable.Iter], it doesn't allow to remove elements during the iteration.
+func (t *OpenHashTable[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		for _, i := range t.objects {
+			if i.distance != 0 && !yield(i.key, i.element) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns true if t and st are both [Table] and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [HashTable],
+// but the elements of t and the elements of st are equals, this method returns anyway true.
+func (t *OpenHashTable[K, T]) Equal(st any) bool {
+	table, ok := st.(Table[K, T])
+	if ok && t != nil && table != nil {
+		return equalTables[K, T](t, table)
+	}
+	return false
+}
+
+// Compare returns 0 if t and st have the same length,
+// -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [Table] or if one between t and st is nil.
+func (t *OpenHashTable[K, T]) Compare(st any) int {
+	table, ok := st.(Table[K, T])
+	if ok && t != nil && table != nil {
+		return compareTables[K, T](t, table)
+	}
+	return -2
+}
+
+// Hash returns the hash code of t.
+//
+// The hash codes of the entries are summed, so the result does not depend on the position of the entries in t.
+func (t *OpenHashTable[K, T]) Hash() uint64 {
+	var result uint64
+	for _, i := range t.objects {
+		if i.distance != 0 {
+			result += NewEntry(i.key, i.element).Hash()
+		}
+	}
+	return result
+}
+
+// Copy returns a table containing a copy of the elements of t.
+// The result of this method is of type [Table], but the effective table which is created is an [OpenHashTable]
+// with the same load factor of t.
+//
+// This method uses [util.Copy] to make copies of the elements.
+func (t *OpenHashTable[K, T]) Copy() Table[K, T] {
+	table := NewOpenHashTableLoadFactor[K, T](t.loadFactor)
+	t.Each(func(key K, element T) {
+		table.Put(key, util.Copy(element))
+	})
+	return table
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *OpenHashTable[K, T]) String() string {
+	return tableString[K, T]("OpenHashTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, element] pairs.
+func (t *OpenHashTable[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(t.RangeIter())
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from data.
+// If t is the zero value, its load factor is [DefaultLoadFactor].
+//
+// data can be both a JSON object and an array of [key, element] pairs.
+func (t *OpenHashTable[K, T]) UnmarshalJSON(data []byte) error {
+	return decodeEntries(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its entries as key and element pairs.
+func (t *OpenHashTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+// If t is the zero value, its load factor is [DefaultLoadFactor].
+func (t *OpenHashTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [OpenHashTable.MarshalBinary].
+func (t *OpenHashTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [OpenHashTable.UnmarshalBinary].
+func (t *OpenHashTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *OpenHashTable[K, T]) decode(key []K, c []T) error {
+	if t.loadFactor == 0 {
+		t.loadFactor = DefaultLoadFactor
+	}
+	t.Clear()
+	t.PutSlice(key, c)
+	return nil
+}
+
+// home returns the preferred index of the hash.
+// The hash is spread with a Fibonacci multiplication, so keys with close hash codes do not form long clusters.
+func (t *OpenHashTable[K, T]) home(hash uint64) int {
+	return int((hash * 0x9E3779B97F4A7C15) >> t.shift)
+}
+
+func (t *OpenHashTable[K, T]) find(key K) int {
+	if len(t.objects) == 0 {
+		return -1
+	}
+	hash := key.Hash()
+	mask := len(t.objects) - 1
+	index := t.home(hash)
+	for distance := 1; ; distance++ {
+		current := &t.objects[index]
+		if current.distance < distance {
+			return -1
+		}
+		if current.hash == hash && key.Compare(current.key) == 0 {
+			return index
+		}
+		index = (index + 1) & mask
+	}
+}
+
+func (t *OpenHashTable[K, T]) insert(element slot[K, T]) {
+	mask := len(t.objects) - 1
+	index := t.home(element.hash)
+	element.distance = 1
+	for {
+		current := &t.objects[index]
+		if current.distance == 0 {
+			*current = element
+			return
+		}
+		if current.distance < element.distance {
+			*current, element = element, *current
+		}
+		index = (index + 1) & mask
+		element.distance++
+	}
+}
+
+func (t *OpenHashTable[K, T]) resize(capacity int) {
+	old := t.objects
+	t.objects = make([]slot[K, T], capacity)
+	t.shift = 64 - bits.TrailingZeros(uint(capacity))
+	for _, i := range old {
+		if i.distance != 0 {
+			t.insert(i)
+		}
+	}
+}
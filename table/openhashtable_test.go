@@ -0,0 +1,262 @@
+package table
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewOpenHashTable(t *testing.T) {
+
+	var table structures.Structure[int] = NewOpenHashTable[wrapper.String, int]()
+
+	if table == nil {
+		t.Log("table is nil")
+		t.Fail()
+	}
+	if table.Len() != 0 {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+}
+func TestNewOpenHashTableFromSlice(t *testing.T) {
+
+	var table *OpenHashTable[wrapper.String, float32] = NewOpenHashTableFromSlice(
+		[]wrapper.String{"Hello", "Ciao"},
+		[]float32{1.2, 5.6},
+	)
+
+	if table == nil {
+		t.Log("table is nil")
+		t.Fail()
+	}
+	if table.Len() != 2 {
+		t.Log("length is not 2")
+		t.Fail()
+	}
+	if e, _ := table.Get("Hello"); e != 1.2 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, _ := table.Get("Ciao"); e != 5.6 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+}
+func TestNewOpenHashTableLoadFactor(t *testing.T) {
+
+	var table *OpenHashTable[wrapper.Int, int] = NewOpenHashTableLoadFactor[wrapper.Int, int](0.5)
+
+	for i := 0; i != 100; i++ {
+		table.Put(wrapper.Int(i), i)
+	}
+	if float64(table.Len()) > float64(table.Cap())*table.LoadFactor() {
+		t.Log("capacity is", table.Cap())
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("load factor 1 does not panic")
+			t.Fail()
+		}
+	}()
+	NewOpenHashTableLoadFactor[wrapper.Int, int](1)
+}
+func TestZeroOpenHashTable(t *testing.T) {
+
+	var table OpenHashTable[wrapper.String, int]
+
+	if _, ok := table.Get("a"); ok || table.ContainsKey("a") {
+		t.Log("found a in table")
+		t.Fail()
+	}
+	if _, ok := table.Remove("a"); ok {
+		t.Log("removed a from table")
+		t.Fail()
+	}
+	table.Put("a", 1)
+	if e, ok := table.Get("a"); !ok || e != 1 || table.LoadFactor() != DefaultLoadFactor {
+		t.Log("table is", &table)
+		t.Fail()
+	}
+}
+func TestContainsKeyOpenHashTable(t *testing.T) {
+
+	var table Table[wrapper.String, float32] = NewOpenHashTableFromSlice(
+		[]wrapper.String{"Hello", "Ciao"},
+		[]float32{1.2, 5.6},
+	)
+
+	if ok := table.ContainsKey("hello"); ok {
+		t.Log("found \"hello\" in table")
+		t.Fail()
+	}
+	if ok := table.ContainsKey("Hello"); !ok {
+		t.Log("not found \"Hello\" in table")
+		t.Fail()
+	}
+}
+func TestContainsElementOpenHashTable(t *testing.T) {
+
+	var table *OpenHashTable[wrapper.String, float32] = NewOpenHashTableFromSlice(
+		[]wrapper.String{"Hello", "Ciao"},
+		[]float32{1.2, 5.6},
+	)
+
+	if ok := table.ContainsElement(-1); ok {
+		t.Log("found -1 in table")
+		t.Fail()
+	}
+	if ok := table.ContainsElement(1.2); !ok {
+		t.Log("not found 1.2 in table")
+		t.Fail()
+	}
+}
+func TestPutOpenHashTable(t *testing.T) {
+
+	var table *OpenHashTable[wrapper.String, float32] = NewOpenHashTableFromSlice(
+		[]wrapper.String{"Hello", "Ciao"},
+		[]float32{1.2, 5.6},
+	)
+
+	if _, ok := table.Put("a", -7.8); ok {
+		t.Log("found \"a\" in table")
+		t.Fail()
+	}
+	if e, _ := table.Get("a"); e != -7.8 {
+		t.Log("table[\"a\"] is", e)
+		t.Fail()
+	}
+	if _, ok := table.Put("Hello", -7.85); !ok {
+		t.Log("not found \"Hello\" in table")
+		t.Fail()
+	}
+	if e, _ := table.Get("Hello"); e != -7.85 {
+		t.Log("table[\"Hello\"] is", e)
+		t.Fail()
+	}
+	if table.Len() != 3 {
+		t.Log("length is", table.Len())
+		t.Fail()
+	}
+}
+func TestRemoveOpenHashTable(t *testing.T) {
+
+	var table *OpenHashTable[wrapper.Int, int] = NewOpenHashTable[wrapper.Int, int]()
+
+	for i := 0; i != 1000; i++ {
+		table.Put(wrapper.Int(i), i*2)
+	}
+	for i := 0; i < 1000; i += 3 {
+		if e, ok := table.Remove(wrapper.Int(i)); !ok || e != i*2 {
+			t.Log("removed element is", e)
+			t.Fail()
+		}
+	}
+	if _, ok := table.Remove(-1); ok {
+		t.Log("found -1 in table")
+		t.Fail()
+	}
+	if table.Len() != 666 {
+		t.Log("length is", table.Len())
+		t.Fail()
+	}
+	for i := 0; i != 1000; i++ {
+		if e, ok := table.Get(wrapper.Int(i)); ok != (i%3 != 0) || (ok && e != i*2) {
+			t.Log("table[", i, "] is", e, ok)
+			t.FailNow()
+		}
+	}
+	table.Clear()
+	if !table.IsEmpty() || table.ContainsKey(1) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestIterOpenHashTable(t *testing.T) {
+
+	var table *OpenHashTable[wrapper.Int, int] = NewOpenHashTable[wrapper.Int, int]()
+
+	for i := 0; i != 100; i++ {
+		table.Put(wrapper.Int(i), i)
+	}
+	j := 0
+	for i := table.Iter(); !i.End(); {
+		if i.Element()%2 == 0 {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+		}
+		j++
+	}
+	if j != 100 {
+		t.Log("iterated elements are", j)
+		t.Fail()
+	}
+	if table.Len() != 50 || table.ContainsKey(10) || !table.ContainsKey(11) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestEqualOpenHashTable(t *testing.T) {
+
+	var table BaseTable[wrapper.String, float32] = NewOpenHashTableFromSlice(
+		[]wrapper.String{"Hello", "Ciao"},
+		[]float32{1.2, 5.6},
+	)
+
+	if !table.Equal(NewHashTableFromSlice([]wrapper.String{"Ciao", "Hello"}, []float32{5.6, 1.2})) {
+		t.Log("tables are not equals")
+		t.Fail()
+	}
+	if table.Equal(NewOpenHashTableFromSlice([]wrapper.String{"Hello", "Ciao"}, []float32{1.5, 5.6})) {
+		t.Log("tables are equals")
+		t.Fail()
+	}
+	if table.Hash() != NewOpenHashTableFromSlice([]wrapper.String{"Ciao", "Hello"}, []float32{5.6, 1.2}).Hash() {
+		t.Log("hashes are not equals")
+		t.Fail()
+	}
+}
+func TestCompareOpenHashTable(t *testing.T) {
+
+	var table *OpenHashTable[wrapper.String, float32] = NewOpenHashTableFromSlice(
+		[]wrapper.String{"Hello", "Ciao"},
+		[]float32{1.2, 5.6},
+	)
+
+	if table.Compare(NewTreeTableFromSlice([]wrapper.String{"Hello", "Ciao"}, []float32{1.2, 5.6})) != 0 {
+		t.Log("compare is not 0")
+		t.Fail()
+	}
+	if table.Compare(NewHashTableFromSlice([]wrapper.String{"Hello"}, []float32{5.6})) != 1 {
+		t.Log("compare is not 1")
+		t.Fail()
+	}
+	if table.Compare(nil) != -2 {
+		t.Log("compare is not -2")
+		t.Fail()
+	}
+}
+func TestJSONOpenHashTable(t *testing.T) {
+
+	var table *OpenHashTable[wrapper.String, int] = NewOpenHashTableFromSlice([]wrapper.String{"a", "b", "c"}, []int{1, 2, 3})
+
+	data, err := json.Marshal(table)
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	var result OpenHashTable[wrapper.String, int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(table) || result.loadFactor != DefaultLoadFactor {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	loadFactor := NewOpenHashTableLoadFactor[wrapper.String, int](0.5)
+	if err := json.Unmarshal(data, loadFactor); err != nil || !loadFactor.Equal(table) || loadFactor.loadFactor != 0.5 {
+		t.Log("result is", loadFactor, "err is", err)
+		t.Fail()
+	}
+}
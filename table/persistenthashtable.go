@@ -0,0 +1,508 @@
+package table
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"reflect"
+	"slices"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ ReadOnlyTable[wrapper.Int, int] = NewPersistentHashTable[wrapper.Int, int]()
+
+const (
+	hamtBits uint   = 5
+	hamtMask uint64 = 1<<hamtBits - 1
+)
+
+// PersistentHashTable provides a generic immutable table implemented through a hash array mapped trie.
+//
+// Each level of the trie uses 5 bits of the hash code of the keys. The keys with the same hash code are stored in a collision node.
+// Put and Remove do not modify the table, but they return a new version which shares most of its nodes with the old one,
+// so keeping many versions of the table is cheap.
+//
+// Get, Put and Remove run in O(log32 n) time.
+// A [TransientHashTable], returned by [PersistentHashTable.Transient], can be used to build a new version with many modifications.
+//
+// The zero value is not a valid table: use [NewPersistentHashTable] to create an empty one.
+//
+// It implements the interface [ReadOnlyTable].
+type PersistentHashTable[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	root *hamtNode[K, T]
+	len  int
+}
+
+// TransientHashTable is a mutable builder of a [PersistentHashTable].
+//
+// It modifies in place the nodes created by itself, while the nodes shared with the original table are copied,
+// so the original table is never modified.
+// After [TransientHashTable.Persistent] is called, the transient can not be used anymore.
+type TransientHashTable[K util.Hasher, T any] struct {
+	// contains filtered or unexported fields
+	root  *hamtNode[K, T]
+	len   int
+	owner *owner
+}
+
+// owner identifies the transient which can modify a node in place.
+type owner struct {
+	_ byte
+}
+
+type hamtNode[K util.Hasher, T any] struct {
+	bitmap     uint32
+	slots      []hamtSlot[K, T]
+	collisions []*Entry[K, T]
+	owner      *owner
+}
+
+type hamtSlot[K util.Hasher, T any] struct {
+	entry *Entry[K, T]
+	node  *hamtNode[K, T]
+}
+
+// NewPersistentHashTable returns a new empty [PersistentHashTable].
+func NewPersistentHashTable[K util.Hasher, T any]() *PersistentHashTable[K, T] {
+	return &PersistentHashTable[K, T]{root: &hamtNode[K, T]{}}
+}
+
+// NewPersistentHashTableFromSlice returns a new [PersistentHashTable] containing the elements of slice c.
+// It panics if key and c have different lengths.
+func NewPersistentHashTableFromSlice[K util.Hasher, T any](key []K, c []T) *PersistentHashTable[K, T] {
+	return NewPersistentHashTable[K, T]().PutSlice(key, c)
+}
+
+// Len returns the length of t.
+func (t *PersistentHashTable[K, T]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *PersistentHashTable[K, T]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// ContainsKey returns true if the key is present on t.
+func (t *PersistentHashTable[K, T]) ContainsKey(key K) bool {
+	_, ok := t.root.get(key)
+	return ok
+}
+
+// ContainsElement returns true if the element e is present on t.
+func (t *PersistentHashTable[K, T]) ContainsElement(e T) bool {
+	fun := util.EqualFunction(e)
+	for _, i := range t.RangeIter() {
+		if fun(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns a [list.List] which contains all keys of t.
+func (t *PersistentHashTable[K, T]) Keys() list.List[K] {
+	list := list.NewArrayList[K]()
+	t.Each(func(key K, _ T) {
+		list.Add(key)
+	})
+	return list
+}
+
+// Elements returns a [list.List] which contains all elements of t.
+func (t *PersistentHashTable[K, T]) Elements() list.List[T] {
+	list := list.NewArrayList[T]()
+	t.Each(func(_ K, element T) {
+		list.Add(element)
+	})
+	return list
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *PersistentHashTable[K, T]) ToSlice() []T {
+	return t.Elements().ToSlice()
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+func (t *PersistentHashTable[K, T]) Get(key K) (T, bool) {
+	return t.root.get(key)
+}
+
+// Put returns a new version of t where the element e is associated at the key.
+func (t *PersistentHashTable[K, T]) Put(key K, e T) *PersistentHashTable[K, T] {
+	added := false
+	root := t.root.put(0, key.Hash(), NewEntry(key, e), nil, &added)
+	if added {
+		return &PersistentHashTable[K, T]{root: root, len: t.len + 1}
+	}
+	return &PersistentHashTable[K, T]{root: root, len: t.len}
+}
+
+// PutSlice returns a new version of t where the elements of e are associated at the keys.
+// It panics if key and e have different lengths.
+//
+// The new version is built through a [TransientHashTable].
+func (t *PersistentHashTable[K, T]) PutSlice(key []K, e []T) *PersistentHashTable[K, T] {
+	if len(key) != len(e) {
+		panic("Different lengths for keys and elements")
+	}
+	transient := t.Transient()
+	for i := range key {
+		transient.Put(key[i], e[i])
+	}
+	return transient.Persistent()
+}
+
+// Remove returns a new version of t without the key.
+// If the key is not present, t is returned.
+func (t *PersistentHashTable[K, T]) Remove(key K) *PersistentHashTable[K, T] {
+	removed := false
+	root := t.root.remove(0, key.Hash(), key, nil, &removed)
+	if !removed {
+		return t
+	}
+	if root == nil {
+		root = &hamtNode[K, T]{}
+	}
+	return &PersistentHashTable[K, T]{root: root, len: t.len - 1}
+}
+
+// Transient returns a [TransientHashTable] containing the entries of t.
+func (t *PersistentHashTable[K, T]) Transient() *TransientHashTable[K, T] {
+	return &TransientHashTable[K, T]{root: t.root, len: t.len, owner: &owner{}}
+}
+
+// Each executes fun for all elements of t.
+func (t *PersistentHashTable[K, T]) Each(fun func(key K, element T)) {
+	for i, j := range t.RangeIter() {
+		fun(i, j)
+	}
+}
+
+// Stream returns a [Stream] rapresenting t.
+//
+// The Collect methods of the stream return a [HashTable], since a [PersistentHashTable] can not be modified in place.
+func (t *PersistentHashTable[K, T]) Stream() *Stream[K, T] {
+	return NewStream[K, T](t, reflect.ValueOf(NewHashTable[K, T]))
+}
+
+// RangeIter returns a function that allows to iterate a [PersistentHashTable] using the range keyword.
+//
+//	for i, j := range t.RangeIter() {
+//		// Code
+//	}
+func (t *PersistentHashTable[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		t.root.each(yield)
+	}
+}
+
+// Equal returns true if t and st are both read-only tables and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [HashTable],
+// but the elements of t and the elements of st are equals, this method returns anyway true.
+func (t *PersistentHashTable[K, T]) Equal(st any) bool {
+	table, ok := st.(ReadOnlyTable[K, T])
+	if ok && t != nil && table != nil {
+		return equalTables[K, T](t, table)
+	}
+	return false
+}
+
+// Compare returns 0 if t and st have the same length,
+// -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [ReadOnlyTable] or if one between t and st is nil.
+func (t *PersistentHashTable[K, T]) Compare(st any) int {
+	table, ok := st.(ReadOnlyTable[K, T])
+	if ok && t != nil && table != nil {
+		return compareTables[K, T](t, table)
+	}
+	return -2
+}
+
+// Hash returns the hash code of t.
+func (t *PersistentHashTable[K, T]) Hash() uint64 {
+	h := fnv.New64()
+	for i, j := range t.RangeIter() {
+		h.Write([]byte(fmt.Sprintf("%v", NewEntry(i, j).Hash())))
+	}
+	return h.Sum64()
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *PersistentHashTable[K, T]) String() string {
+	return tableString[K, T]("PersistentHashTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, element] pairs.
+func (t *PersistentHashTable[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(t.RangeIter())
+}
+
+// UnmarshalJSON sets t to a new version containing the elements decoded from data.
+// The versions derived from t before this call are not affected.
+//
+// data can be both a JSON object and an array of [key, element] pairs.
+func (t *PersistentHashTable[K, T]) UnmarshalJSON(data []byte) error {
+	return decodeEntries(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its entries as key and element pairs.
+func (t *PersistentHashTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary sets t to a new version containing the elements decoded from the binary encoding data.
+// The versions derived from t before this call are not affected.
+func (t *PersistentHashTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [PersistentHashTable.MarshalBinary].
+func (t *PersistentHashTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [PersistentHashTable.UnmarshalBinary].
+func (t *PersistentHashTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *PersistentHashTable[K, T]) decode(key []K, c []T) error {
+	*t = *NewPersistentHashTableFromSlice(key, c)
+	return nil
+}
+
+// Len returns the length of t.
+//
+// This method panics if [TransientHashTable.Persistent] has already been called.
+func (t *TransientHashTable[K, T]) Len() int {
+	t.check()
+	return t.len
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+//
+// This method panics if [TransientHashTable.Persistent] has already been called.
+func (t *TransientHashTable[K, T]) Get(key K) (T, bool) {
+	t.check()
+	return t.root.get(key)
+}
+
+// Put sets the element e at the key.
+//
+// This method panics if [TransientHashTable.Persistent] has already been called.
+func (t *TransientHashTable[K, T]) Put(key K, e T) {
+	t.check()
+	added := false
+	t.root = t.root.put(0, key.Hash(), NewEntry(key, e), t.owner, &added)
+	if added {
+		t.len++
+	}
+}
+
+// Remove removes the key from t.
+// It returns false if the the key does not exists.
+//
+// This method panics if [TransientHashTable.Persistent] has already been called.
+func (t *TransientHashTable[K, T]) Remove(key K) bool {
+	t.check()
+	removed := false
+	t.root = t.root.remove(0, key.Hash(), key, t.owner, &removed)
+	if t.root == nil {
+		t.root = &hamtNode[K, T]{owner: t.owner}
+	}
+	if removed {
+		t.len--
+	}
+	return removed
+}
+
+// Persistent returns a [PersistentHashTable] containing the entries of t.
+// After this call, t can not be used anymore.
+//
+// This method panics if it has already been called.
+func (t *TransientHashTable[K, T]) Persistent() *PersistentHashTable[K, T] {
+	t.check()
+	t.owner = nil
+	return &PersistentHashTable[K, T]{root: t.root, len: t.len}
+}
+
+func (t *TransientHashTable[K, T]) check() {
+	if t.owner == nil {
+		panic("Cannot use a transient table after Persistent")
+	}
+}
+
+// editable returns n if it can be modified in place by owner, otherwise a copy of n owned by owner.
+func (n *hamtNode[K, T]) editable(owner *owner) *hamtNode[K, T] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+	return &hamtNode[K, T]{bitmap: n.bitmap, slots: slices.Clone(n.slots), collisions: slices.Clone(n.collisions), owner: owner}
+}
+
+func (n *hamtNode[K, T]) get(key K) (T, bool) {
+	hash := key.Hash()
+	for shift := uint(0); ; shift += hamtBits {
+		if shift >= 64 {
+			for _, i := range n.collisions {
+				if key.Compare(i.Key()) == 0 {
+					return i.Element(), true
+				}
+			}
+			break
+		}
+		bit, index := n.position(hash, shift)
+		if n.bitmap&bit == 0 {
+			break
+		}
+		slot := n.slots[index]
+		if slot.node == nil {
+			if key.Compare(slot.entry.Key()) == 0 {
+				return slot.entry.Element(), true
+			}
+			break
+		}
+		n = slot.node
+	}
+
+	var result T
+
+	return result, false
+}
+
+func (n *hamtNode[K, T]) put(shift uint, hash uint64, entry *Entry[K, T], owner *owner, added *bool) *hamtNode[K, T] {
+	if shift >= 64 {
+		result := n.editable(owner)
+		for i, j := range result.collisions {
+			if entry.Key().Compare(j.Key()) == 0 {
+				result.collisions[i] = entry
+				return result
+			}
+		}
+		result.collisions = append(result.collisions, entry)
+		*added = true
+		return result
+	}
+	bit, index := n.position(hash, shift)
+	result := n.editable(owner)
+	if n.bitmap&bit == 0 {
+		result.bitmap |= bit
+		result.slots = slices.Insert(result.slots, index, hamtSlot[K, T]{entry: entry})
+		*added = true
+		return result
+	}
+	slot := n.slots[index]
+	switch {
+	case slot.node != nil:
+		result.slots[index].node = slot.node.put(shift+hamtBits, hash, entry, owner, added)
+	case entry.Key().Compare(slot.entry.Key()) == 0:
+		result.slots[index].entry = entry
+	default:
+		moved := false
+		node := (&hamtNode[K, T]{owner: owner}).put(shift+hamtBits, slot.entry.Key().Hash(), slot.entry, owner, &moved)
+		result.slots[index] = hamtSlot[K, T]{node: node.put(shift+hamtBits, hash, entry, owner, added)}
+	}
+	return result
+}
+
+// remove returns n without the key, or nil if n becomes empty.
+func (n *hamtNode[K, T]) remove(shift uint, hash uint64, key K, owner *owner, removed *bool) *hamtNode[K, T] {
+	if shift >= 64 {
+		for i, j := range n.collisions {
+			if key.Compare(j.Key()) == 0 {
+				*removed = true
+				if len(n.collisions) == 1 {
+					return nil
+				}
+				result := n.editable(owner)
+				result.collisions = slices.Delete(result.collisions, i, i+1)
+				return result
+			}
+		}
+		return n
+	}
+	bit, index := n.position(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n
+	}
+	slot := n.slots[index]
+	if slot.node == nil {
+		if key.Compare(slot.entry.Key()) != 0 {
+			return n
+		}
+		*removed = true
+		if len(n.slots) == 1 {
+			return nil
+		}
+		result := n.editable(owner)
+		result.bitmap &^= bit
+		result.slots = slices.Delete(result.slots, index, index+1)
+		return result
+	}
+	child := slot.node.remove(shift+hamtBits, hash, key, owner, removed)
+	if !*removed {
+		return n
+	}
+	result := n.editable(owner)
+	switch {
+	case child == nil:
+		if len(n.slots) == 1 {
+			return nil
+		}
+		result.bitmap &^= bit
+		result.slots = slices.Delete(result.slots, index, index+1)
+	case child.single() != nil:
+		result.slots[index] = hamtSlot[K, T]{entry: child.single()}
+	default:
+		result.slots[index].node = child
+	}
+	return result
+}
+
+// single returns the only entry of n if n contains exactly one entry and no other nodes, otherwise it returns nil.
+func (n *hamtNode[K, T]) single() *Entry[K, T] {
+	if len(n.collisions) == 1 {
+		return n.collisions[0]
+	}
+	if len(n.slots) == 1 && n.slots[0].node == nil {
+		return n.slots[0].entry
+	}
+	return nil
+}
+
+func (n *hamtNode[K, T]) position(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *hamtNode[K, T]) each(yield func(K, T) bool) bool {
+	for _, i := range n.collisions {
+		if !yield(i.Key(), i.Element()) {
+			return false
+		}
+	}
+	for _, i := range n.slots {
+		if i.node != nil {
+			if !i.node.each(yield) {
+				return false
+			}
+		} else if !yield(i.entry.Key(), i.entry.Element()) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package table
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+type collidingKey int
+
+func (k collidingKey) Compare(o any) int {
+	return int(k) - int(o.(collidingKey))
+}
+func (k collidingKey) Hash() uint64 {
+	return uint64(k % 3)
+}
+func TestNewPersistentHashTable(t *testing.T) {
+
+	var table ReadOnlyTable[wrapper.String, int] = NewPersistentHashTable[wrapper.String, int]()
+	var tableSlice *PersistentHashTable[wrapper.String, int] = NewPersistentHashTableFromSlice([]wrapper.String{"a", "b"}, []int{1, 2})
+
+	if table.Len() != 0 || !table.IsEmpty() {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+	if e, ok := tableSlice.Get("b"); !ok || e != 2 || tableSlice.Len() != 2 {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	if !tableSlice.Equal(NewHashTableFromSlice([]wrapper.String{"b", "a"}, []int{2, 1})) || tableSlice.Compare(table) != 1 {
+		t.Log("tables are not equals")
+		t.Fail()
+	}
+	if tableSlice.Hash() != NewPersistentHashTable[wrapper.String, int]().Put("a", 1).Put("b", 2).Hash() {
+		t.Log("hashes are different")
+		t.Fail()
+	}
+}
+func TestPutRemovePersistentHashTable(t *testing.T) {
+
+	var table *PersistentHashTable[wrapper.Int, int] = NewPersistentHashTable[wrapper.Int, int]()
+
+	random := rand.New(rand.NewSource(1))
+	model := map[wrapper.Int]int{}
+	versions := []*PersistentHashTable[wrapper.Int, int]{table}
+	models := []map[wrapper.Int]int{{}}
+	for i := 0; i != 5000; i++ {
+		key := wrapper.Int(random.Intn(2000))
+		if random.Intn(3) == 0 {
+			table = table.Remove(key)
+			delete(model, key)
+		} else {
+			table = table.Put(key, i)
+			model[key] = i
+		}
+		if i%500 == 0 {
+			versions = append(versions, table)
+			copy := map[wrapper.Int]int{}
+			for k, v := range model {
+				copy[k] = v
+			}
+			models = append(models, copy)
+		}
+	}
+	versions = append(versions, table)
+	models = append(models, model)
+	for i, j := range versions {
+		if j.Len() != len(models[i]) {
+			t.Log("length of version", i, "is", j.Len())
+			t.Fail()
+		}
+		for k, v := range models[i] {
+			if e, ok := j.Get(k); !ok || e != v {
+				t.Log("element of version", i, "is", e)
+				t.Fail()
+			}
+		}
+		count := 0
+		for k, v := range j.RangeIter() {
+			if models[i][k] != v {
+				t.Log("entry of version", i, "is", k, v)
+				t.Fail()
+			}
+			count++
+		}
+		if count != j.Len() {
+			t.Log("count of version", i, "is", count)
+			t.Fail()
+		}
+	}
+}
+func TestCollisionsPersistentHashTable(t *testing.T) {
+
+	var table *PersistentHashTable[collidingKey, int] = NewPersistentHashTable[collidingKey, int]()
+
+	for i := 0; i != 30; i++ {
+		table = table.Put(collidingKey(i), i)
+	}
+	old := table
+	for i := 0; i != 30; i += 2 {
+		table = table.Remove(collidingKey(i))
+	}
+	if table.Len() != 15 || old.Len() != 30 {
+		t.Log("lengths are", table.Len(), old.Len())
+		t.Fail()
+	}
+	for i := 0; i != 30; i++ {
+		if _, ok := table.Get(collidingKey(i)); ok != (i%2 == 1) {
+			t.Log("key", i, "is", ok)
+			t.Fail()
+		}
+		if e, ok := old.Get(collidingKey(i)); !ok || e != i {
+			t.Log("key", i, "of old version is", e)
+			t.Fail()
+		}
+	}
+}
+func TestTransientHashTable(t *testing.T) {
+
+	var table *PersistentHashTable[wrapper.Int, int] = NewPersistentHashTableFromSlice([]wrapper.Int{1, 2, 3}, []int{1, 2, 3})
+
+	transient := table.Transient()
+	for i := 0; i != 1000; i++ {
+		transient.Put(wrapper.Int(i), -i)
+	}
+	if !transient.Remove(500) || transient.Remove(5000) || transient.Len() != 999 {
+		t.Log("length is", transient.Len())
+		t.Fail()
+	}
+	result := transient.Persistent()
+	if e, _ := table.Get(2); e != 2 || table.Len() != 3 {
+		t.Log("the original table has been modified")
+		t.Fail()
+	}
+	if e, _ := result.Get(2); e != -2 || result.Len() != 999 || result.ContainsKey(500) {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("the transient has been used")
+			t.Fail()
+		}
+	}()
+	transient.Put(1, 1)
+}
+func TestJSONPersistentHashTable(t *testing.T) {
+
+	var table *PersistentHashTable[wrapper.Int, string] = NewPersistentHashTableFromSlice([]wrapper.Int{1, 2, 3}, []string{"a", "b", "c"})
+
+	data, err := json.Marshal(table)
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	result := table.Remove(1)
+	previous := result
+	if err := json.Unmarshal(data, result); err != nil || !result.Equal(table) || previous.Len() != 3 {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var zero PersistentHashTable[wrapper.Int, string]
+	if err := json.Unmarshal(data, &zero); err != nil || !zero.Equal(table) {
+		t.Log("result is", &zero, "err is", err)
+		t.Fail()
+	}
+}
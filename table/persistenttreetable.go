@@ -0,0 +1,572 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ ReadOnlyTable[wrapper.Int, int] = NewPersistentTreeTable[wrapper.Int, int]()
+
+// PersistentTreeTable provides a generic immutable table implemented through a left-leaning red-black tree.
+// It maintains the order of the keys.
+//
+// Put and Remove do not modify the table, but they return a new version which copies only the nodes
+// on the path from the root to the modified key, so keeping many versions of the table is cheap.
+//
+// Get, Put and Remove run in O(log n) time.
+// A [TransientTreeTable], returned by [PersistentTreeTable.Transient], can be used to build a new version with many modifications.
+//
+// The order is determined by the Compare method if the table is created with [NewPersistentTreeTable],
+// otherwise it is determined by the comparison function passed to [NewPersistentTreeTableFunc].
+//
+// It implements the interface [ReadOnlyTable].
+type PersistentTreeTable[K any, T any] struct {
+	// contains filtered or unexported fields
+	root    *persistentTreeNode[K, T]
+	len     int
+	compare func(i K, j K) int
+}
+
+// TransientTreeTable is a mutable builder of a [PersistentTreeTable].
+//
+// It modifies in place the nodes created by itself, while the nodes shared with the original table are copied,
+// so the original table is never modified.
+// After [TransientTreeTable.Persistent] is called, the transient can not be used anymore.
+type TransientTreeTable[K any, T any] struct {
+	// contains filtered or unexported fields
+	tree  *PersistentTreeTable[K, T]
+	owner *owner
+}
+
+type persistentTreeNode[K any, T any] struct {
+	key     K
+	element T
+	left    *persistentTreeNode[K, T]
+	right   *persistentTreeNode[K, T]
+	red     bool
+	owner   *owner
+}
+
+// NewPersistentTreeTable returns a new empty [PersistentTreeTable].
+func NewPersistentTreeTable[K util.Comparer, T any]() *PersistentTreeTable[K, T] {
+	return NewPersistentTreeTableFunc[K, T](util.Compare[K])
+}
+
+// NewPersistentTreeTableFromSlice returns a new [PersistentTreeTable] containing the elements of slice c.
+// It panics if key and c have different lengths.
+func NewPersistentTreeTableFromSlice[K util.Comparer, T any](key []K, c []T) *PersistentTreeTable[K, T] {
+	return NewPersistentTreeTableFromSliceFunc(util.Compare[K], key, c)
+}
+
+// NewPersistentTreeTableFunc returns a new empty [PersistentTreeTable], whose keys are ordered by the compare function.
+//
+// compare must return a negative number if i is placed before j, a positive number if i is placed after j
+// and zero if they are equals.
+func NewPersistentTreeTableFunc[K any, T any](compare func(i K, j K) int) *PersistentTreeTable[K, T] {
+	return &PersistentTreeTable[K, T]{compare: compare}
+}
+
+// NewPersistentTreeTableFromSliceFunc returns a new [PersistentTreeTable] containing the elements of slice c,
+// whose keys are ordered by the compare function.
+// It panics if key and c have different lengths.
+func NewPersistentTreeTableFromSliceFunc[K any, T any](compare func(i K, j K) int, key []K, c []T) *PersistentTreeTable[K, T] {
+	return NewPersistentTreeTableFunc[K, T](compare).PutSlice(key, c)
+}
+
+// Len returns the length of t.
+func (t *PersistentTreeTable[K, T]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *PersistentTreeTable[K, T]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// ContainsKey returns true if the key is present on t.
+func (t *PersistentTreeTable[K, T]) ContainsKey(key K) bool {
+	return t.find(key) != nil
+}
+
+// ContainsElement returns true if the element e is present on t.
+func (t *PersistentTreeTable[K, T]) ContainsElement(e T) bool {
+	fun := util.EqualFunction(e)
+	for _, i := range t.RangeIter() {
+		if fun(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns a [list.List] which contains all keys of t, in ascending order.
+func (t *PersistentTreeTable[K, T]) Keys() list.List[K] {
+	list := list.NewArrayList[K]()
+	t.Each(func(key K, _ T) {
+		list.Add(key)
+	})
+	return list
+}
+
+// Elements returns a [list.List] which contains all elements of t, ordered by their keys.
+func (t *PersistentTreeTable[K, T]) Elements() list.List[T] {
+	list := list.NewArrayList[T]()
+	t.Each(func(_ K, element T) {
+		list.Add(element)
+	})
+	return list
+}
+
+// ToSlice returns a slice which contains all elements of t, ordered by their keys.
+func (t *PersistentTreeTable[K, T]) ToSlice() []T {
+	return t.Elements().ToSlice()
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+func (t *PersistentTreeTable[K, T]) Get(key K) (T, bool) {
+	if node := t.find(key); node != nil {
+		return node.element, true
+	}
+
+	var result T
+
+	return result, false
+}
+
+// Put returns a new version of t where the element e is associated at the key.
+func (t *PersistentTreeTable[K, T]) Put(key K, e T) *PersistentTreeTable[K, T] {
+	result := &PersistentTreeTable[K, T]{root: t.root, len: t.len, compare: t.compare}
+	result.put(key, e, &owner{})
+	return result
+}
+
+// PutSlice returns a new version of t where the elements of e are associated at the keys.
+// It panics if key and e have different lengths.
+//
+// The new version is built through a [TransientTreeTable].
+func (t *PersistentTreeTable[K, T]) PutSlice(key []K, e []T) *PersistentTreeTable[K, T] {
+	if len(key) != len(e) {
+		panic("Different lengths for keys and elements")
+	}
+	transient := t.Transient()
+	for i := range key {
+		transient.Put(key[i], e[i])
+	}
+	return transient.Persistent()
+}
+
+// Remove returns a new version of t without the key.
+// If the key is not present, t is returned.
+func (t *PersistentTreeTable[K, T]) Remove(key K) *PersistentTreeTable[K, T] {
+	if !t.ContainsKey(key) {
+		return t
+	}
+	result := &PersistentTreeTable[K, T]{root: t.root, len: t.len, compare: t.compare}
+	result.remove(key, &owner{})
+	return result
+}
+
+// Min returns the minimum key of t and its element.
+// The method returns false if t is empty.
+func (t *PersistentTreeTable[K, T]) Min() (K, T, bool) {
+	if t.root == nil {
+
+		var key K
+		var element T
+
+		return key, element, false
+	}
+	node := t.root.min()
+	return node.key, node.element, true
+}
+
+// Max returns the maximum key of t and its element.
+// The method returns false if t is empty.
+func (t *PersistentTreeTable[K, T]) Max() (K, T, bool) {
+	if t.root == nil {
+
+		var key K
+		var element T
+
+		return key, element, false
+	}
+	node := t.root
+	for node.right != nil {
+		node = node.right
+	}
+	return node.key, node.element, true
+}
+
+// Transient returns a [TransientTreeTable] containing the entries of t.
+func (t *PersistentTreeTable[K, T]) Transient() *TransientTreeTable[K, T] {
+	return &TransientTreeTable[K, T]{tree: &PersistentTreeTable[K, T]{root: t.root, len: t.len, compare: t.compare}, owner: &owner{}}
+}
+
+// Each executes fun for all elements of t, in ascending order of the keys.
+func (t *PersistentTreeTable[K, T]) Each(fun func(key K, element T)) {
+	for i, j := range t.RangeIter() {
+		fun(i, j)
+	}
+}
+
+// Stream returns a [Stream] rapresenting t.
+//
+// The Collect methods of the stream return a [TreeTable] with the same order of t, since a [PersistentTreeTable] can not be modified in place.
+func (t *PersistentTreeTable[K, T]) Stream() *Stream[K, T] {
+	return NewStream[K, T](t, reflect.ValueOf(func() *TreeTable[K, T] {
+		return NewTreeTableFunc[K, T](t.compare)
+	}))
+}
+
+// RangeIter returns a function that allows to iterate a [PersistentTreeTable] in ascending order of the keys using the range keyword.
+//
+//	for i, j := range t.RangeIter() {
+//		// Code
+//	}
+func (t *PersistentTreeTable[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		stack := make([]*persistentTreeNode[K, T], 0)
+		for node := t.root; node != nil || len(stack) != 0; {
+			if node != nil {
+				stack = append(stack, node)
+				node = node.left
+				continue
+			}
+			node = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(node.key, node.element) {
+				return
+			}
+			node = node.right
+		}
+	}
+}
+
+// Equal returns true if t and st are both read-only tables and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [TreeTable],
+// but the elements of t and the elements of st are equals, this method returns anyway true.
+func (t *PersistentTreeTable[K, T]) Equal(st any) bool {
+	table, ok := st.(ReadOnlyTable[K, T])
+	if ok && t != nil && table != nil {
+		return equalTables[K, T](t, table)
+	}
+	return false
+}
+
+// Compare returns 0 if t and st have the same length,
+// -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [ReadOnlyTable] or if one between t and st is nil.
+func (t *PersistentTreeTable[K, T]) Compare(st any) int {
+	table, ok := st.(ReadOnlyTable[K, T])
+	if ok && t != nil && table != nil {
+		return compareTables[K, T](t, table)
+	}
+	return -2
+}
+
+// Hash returns the hash code of t.
+func (t *PersistentTreeTable[K, T]) Hash() uint64 {
+	h := fnv.New64()
+	for i, j := range t.RangeIter() {
+		h.Write([]byte(fmt.Sprintf("%v", NewEntry(i, j).Hash())))
+	}
+	return h.Sum64()
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *PersistentTreeTable[K, T]) String() string {
+	return tableString[K, T]("PersistentTreeTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, element] pairs.
+// In both cases, the entries are in ascending order of the keys.
+func (t *PersistentTreeTable[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(t.RangeIter())
+}
+
+// UnmarshalJSON sets t to a new version containing the elements decoded from data.
+// The versions derived from t before this call are not affected.
+//
+// data can be both a JSON object and an array of [key, element] pairs.
+//
+// If t is the zero value, the keys are ordered by their Compare method.
+// In this case, the method returns an error if K does not implement [util.Comparer].
+func (t *PersistentTreeTable[K, T]) UnmarshalJSON(data []byte) error {
+	return decodeEntries(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its entries as key and element pairs in ascending order of the keys.
+func (t *PersistentTreeTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary sets t to a new version containing the elements decoded from the binary encoding data.
+// The versions derived from t before this call are not affected.
+//
+// If t is the zero value, the keys are ordered by their Compare method.
+// In this case, the method returns an error if K does not implement [util.Comparer].
+func (t *PersistentTreeTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [PersistentTreeTable.MarshalBinary].
+func (t *PersistentTreeTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [PersistentTreeTable.UnmarshalBinary].
+func (t *PersistentTreeTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *PersistentTreeTable[K, T]) decode(key []K, c []T) error {
+	compare := t.compare
+	if compare == nil {
+		if compare = codec.Compare[K](); compare == nil {
+			return errors.New("Cannot decode a PersistentTreeTable whose keys do not implement util.Comparer")
+		}
+	}
+	*t = *NewPersistentTreeTableFromSliceFunc(compare, key, c)
+	return nil
+}
+
+// Len returns the length of t.
+//
+// This method panics if [TransientTreeTable.Persistent] has already been called.
+func (t *TransientTreeTable[K, T]) Len() int {
+	t.check()
+	return t.tree.len
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+//
+// This method panics if [TransientTreeTable.Persistent] has already been called.
+func (t *TransientTreeTable[K, T]) Get(key K) (T, bool) {
+	t.check()
+	return t.tree.Get(key)
+}
+
+// Put sets the element e at the key.
+//
+// This method panics if [TransientTreeTable.Persistent] has already been called.
+func (t *TransientTreeTable[K, T]) Put(key K, e T) {
+	t.check()
+	t.tree.put(key, e, t.owner)
+}
+
+// Remove removes the key from t.
+// It returns false if the the key does not exists.
+//
+// This method panics if [TransientTreeTable.Persistent] has already been called.
+func (t *TransientTreeTable[K, T]) Remove(key K) bool {
+	t.check()
+	if !t.tree.ContainsKey(key) {
+		return false
+	}
+	t.tree.remove(key, t.owner)
+	return true
+}
+
+// Persistent returns a [PersistentTreeTable] containing the entries of t.
+// After this call, t can not be used anymore.
+//
+// This method panics if it has already been called.
+func (t *TransientTreeTable[K, T]) Persistent() *PersistentTreeTable[K, T] {
+	t.check()
+	t.owner = nil
+	return t.tree
+}
+
+func (t *TransientTreeTable[K, T]) check() {
+	if t.owner == nil {
+		panic("Cannot use a transient table after Persistent")
+	}
+}
+
+func (t *PersistentTreeTable[K, T]) find(key K) *persistentTreeNode[K, T] {
+	for node := t.root; node != nil; {
+		switch check := t.compare(key, node.key); {
+		case check < 0:
+			node = node.left
+		case check > 0:
+			node = node.right
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// put sets e at the key, copying the nodes not owned by owner.
+func (t *PersistentTreeTable[K, T]) put(key K, e T, owner *owner) {
+	t.root = t.insert(t.root, key, e, owner)
+	t.root.red = false
+}
+
+// remove removes the key, which must be present, copying the nodes not owned by owner.
+func (t *PersistentTreeTable[K, T]) remove(key K, owner *owner) {
+	root := t.root.editable(owner)
+	if !isRedNode(root.left) && !isRedNode(root.right) {
+		root.red = true
+	}
+	t.root = t.delete(root, key, owner)
+	if t.root != nil {
+		t.root = t.root.editable(owner)
+		t.root.red = false
+	}
+	t.len--
+}
+
+func (t *PersistentTreeTable[K, T]) insert(node *persistentTreeNode[K, T], key K, e T, owner *owner) *persistentTreeNode[K, T] {
+	if node == nil {
+		t.len++
+		return &persistentTreeNode[K, T]{key: key, element: e, red: true, owner: owner}
+	}
+	node = node.editable(owner)
+	switch check := t.compare(key, node.key); {
+	case check < 0:
+		node.left = t.insert(node.left, key, e, owner)
+	case check > 0:
+		node.right = t.insert(node.right, key, e, owner)
+	default:
+		node.element = e
+	}
+	return node.balance(owner)
+}
+
+func (t *PersistentTreeTable[K, T]) delete(node *persistentTreeNode[K, T], key K, owner *owner) *persistentTreeNode[K, T] {
+	node = node.editable(owner)
+	if t.compare(key, node.key) < 0 {
+		if !isRedNode(node.left) && !isRedNode(node.left.left) {
+			node = node.moveRedLeft(owner)
+		}
+		node.left = t.delete(node.left, key, owner)
+		return node.balance(owner)
+	}
+	if isRedNode(node.left) {
+		node = node.rotateRight(owner)
+	}
+	if t.compare(key, node.key) == 0 && node.right == nil {
+		return nil
+	}
+	if !isRedNode(node.right) && !isRedNode(node.right.left) {
+		node = node.moveRedRight(owner)
+	}
+	if t.compare(key, node.key) == 0 {
+		min := node.right.min()
+		node.key = min.key
+		node.element = min.element
+		node.right = node.right.deleteMin(owner)
+	} else {
+		node.right = t.delete(node.right, key, owner)
+	}
+	return node.balance(owner)
+}
+
+// editable returns n if it can be modified in place by owner, otherwise a copy of n owned by owner.
+func (n *persistentTreeNode[K, T]) editable(owner *owner) *persistentTreeNode[K, T] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+	result := *n
+	result.owner = owner
+	return &result
+}
+
+func (n *persistentTreeNode[K, T]) min() *persistentTreeNode[K, T] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (n *persistentTreeNode[K, T]) deleteMin(owner *owner) *persistentTreeNode[K, T] {
+	if n.left == nil {
+		return nil
+	}
+	n = n.editable(owner)
+	if !isRedNode(n.left) && !isRedNode(n.left.left) {
+		n = n.moveRedLeft(owner)
+	}
+	n.left = n.left.deleteMin(owner)
+	return n.balance(owner)
+}
+
+// The following methods must be called on nodes already owned by owner.
+
+func (n *persistentTreeNode[K, T]) rotateLeft(owner *owner) *persistentTreeNode[K, T] {
+	result := n.right.editable(owner)
+	n.right = result.left
+	result.left = n
+	result.red = n.red
+	n.red = true
+	return result
+}
+
+func (n *persistentTreeNode[K, T]) rotateRight(owner *owner) *persistentTreeNode[K, T] {
+	result := n.left.editable(owner)
+	n.left = result.right
+	result.right = n
+	result.red = n.red
+	n.red = true
+	return result
+}
+
+func (n *persistentTreeNode[K, T]) flipColors(owner *owner) {
+	n.red = !n.red
+	n.left = n.left.editable(owner)
+	n.left.red = !n.left.red
+	n.right = n.right.editable(owner)
+	n.right.red = !n.right.red
+}
+
+func (n *persistentTreeNode[K, T]) moveRedLeft(owner *owner) *persistentTreeNode[K, T] {
+	n.flipColors(owner)
+	if isRedNode(n.right.left) {
+		n.right = n.right.rotateRight(owner)
+		n = n.rotateLeft(owner)
+		n.flipColors(owner)
+	}
+	return n
+}
+
+func (n *persistentTreeNode[K, T]) moveRedRight(owner *owner) *persistentTreeNode[K, T] {
+	n.flipColors(owner)
+	if isRedNode(n.left.left) {
+		n = n.rotateRight(owner)
+		n.flipColors(owner)
+	}
+	return n
+}
+
+func (n *persistentTreeNode[K, T]) balance(owner *owner) *persistentTreeNode[K, T] {
+	if isRedNode(n.right) && !isRedNode(n.left) {
+		n = n.rotateLeft(owner)
+	}
+	if isRedNode(n.left) && isRedNode(n.left.left) {
+		n = n.rotateRight(owner)
+	}
+	if isRedNode(n.left) && isRedNode(n.right) {
+		n.flipColors(owner)
+	}
+	return n
+}
+
+func isRedNode[K any, T any](node *persistentTreeNode[K, T]) bool {
+	return node != nil && node.red
+}
@@ -0,0 +1,164 @@
+package table
+
+import (
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func checkPersistentTree[K any, T any](node *persistentTreeNode[K, T]) (int, bool) {
+	if node == nil {
+		return 1, true
+	}
+	if isRedNode(node.right) || (node.red && isRedNode(node.left)) {
+		return 0, false
+	}
+	left, ok := checkPersistentTree(node.left)
+	right, okRight := checkPersistentTree(node.right)
+	if !ok || !okRight || left != right {
+		return 0, false
+	}
+	if !node.red {
+		left++
+	}
+	return left, true
+}
+func TestNewPersistentTreeTable(t *testing.T) {
+
+	var table ReadOnlyTable[wrapper.Int, string] = NewPersistentTreeTable[wrapper.Int, string]()
+	var tableSlice *PersistentTreeTable[wrapper.Int, string] = NewPersistentTreeTableFromSlice([]wrapper.Int{3, 1, 2}, []string{"c", "a", "b"})
+
+	if table.Len() != 0 || !table.IsEmpty() {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+	if !slices.Equal(tableSlice.ToSlice(), []string{"a", "b", "c"}) {
+		t.Log("table elements are", tableSlice.ToSlice())
+		t.Fail()
+	}
+	if tableSlice.String() != "PersistentTreeTable[wrapper.Int, string][1: a, 2: b, 3: c]" {
+		t.Log("table is", tableSlice)
+		t.Fail()
+	}
+	if key, e, ok := tableSlice.Min(); !ok || key != 1 || e != "a" {
+		t.Log("min is", key)
+		t.Fail()
+	}
+	if key, e, ok := tableSlice.Max(); !ok || key != 3 || e != "c" {
+		t.Log("max is", key)
+		t.Fail()
+	}
+	if !tableSlice.Equal(NewTreeTableFromSlice([]wrapper.Int{1, 2, 3}, []string{"a", "b", "c"})) || !tableSlice.Equal(CollectTo(tableSlice.Stream(), EntriesToTreeTable[wrapper.Int, string]())) {
+		t.Log("tables are not equals")
+		t.Fail()
+	}
+}
+func TestPutRemovePersistentTreeTable(t *testing.T) {
+
+	var table *PersistentTreeTable[wrapper.Int, int] = NewPersistentTreeTable[wrapper.Int, int]()
+
+	random := rand.New(rand.NewSource(1))
+	model := map[wrapper.Int]int{}
+	versions := []*PersistentTreeTable[wrapper.Int, int]{table}
+	models := []map[wrapper.Int]int{{}}
+	for i := 0; i != 5000; i++ {
+		key := wrapper.Int(random.Intn(1000))
+		if random.Intn(3) == 0 {
+			table = table.Remove(key)
+			delete(model, key)
+		} else {
+			table = table.Put(key, i)
+			model[key] = i
+		}
+		if _, ok := checkPersistentTree(table.root); !ok {
+			t.Log("the tree is not balanced after", i, "operations")
+			t.FailNow()
+		}
+		if i%500 == 0 {
+			versions = append(versions, table)
+			copy := map[wrapper.Int]int{}
+			for k, v := range model {
+				copy[k] = v
+			}
+			models = append(models, copy)
+		}
+	}
+	versions = append(versions, table)
+	models = append(models, model)
+	for i, j := range versions {
+		if j.Len() != len(models[i]) {
+			t.Log("length of version", i, "is", j.Len())
+			t.Fail()
+		}
+		keys := j.Keys().ToSlice()
+		if !slices.IsSortedFunc(keys, func(i wrapper.Int, j wrapper.Int) int { return i.Compare(j) }) || len(keys) != j.Len() {
+			t.Log("keys of version", i, "are not sorted")
+			t.Fail()
+		}
+		for k, v := range models[i] {
+			if e, ok := j.Get(k); !ok || e != v {
+				t.Log("element of version", i, "is", e)
+				t.Fail()
+			}
+		}
+	}
+}
+func TestTransientTreeTable(t *testing.T) {
+
+	var table *PersistentTreeTable[wrapper.Int, int] = NewPersistentTreeTableFromSlice([]wrapper.Int{1, 2, 3}, []int{1, 2, 3})
+
+	transient := table.Transient()
+	for i := 0; i != 1000; i++ {
+		transient.Put(wrapper.Int(i), -i)
+	}
+	for i := 0; i != 1000; i += 2 {
+		transient.Remove(wrapper.Int(i))
+	}
+	if transient.Remove(5000) || transient.Len() != 500 {
+		t.Log("length is", transient.Len())
+		t.Fail()
+	}
+	result := transient.Persistent()
+	if _, ok := checkPersistentTree(result.root); !ok {
+		t.Log("the tree is not balanced")
+		t.Fail()
+	}
+	if e, _ := table.Get(2); e != 2 || table.Len() != 3 {
+		t.Log("the original table has been modified")
+		t.Fail()
+	}
+	if e, _ := result.Get(3); e != -3 || result.Len() != 500 || result.ContainsKey(2) {
+		t.Log("e is", e)
+		t.Fail()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Log("the transient has been used")
+			t.Fail()
+		}
+	}()
+	transient.Put(1, 1)
+}
+func TestJSONPersistentTreeTable(t *testing.T) {
+
+	var table *PersistentTreeTable[wrapper.Int, string] = NewPersistentTreeTableFromSlice([]wrapper.Int{3, 1, 2}, []string{"c", "a", "b"})
+
+	data, err := json.Marshal(table)
+	if err != nil || string(data) != `{"1":"a","2":"b","3":"c"}` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	old := table.Remove(1)
+	if err := json.Unmarshal(data, old); err != nil || !old.Equal(table) {
+		t.Log("result is", old, "err is", err)
+		t.Fail()
+	}
+	var zero PersistentTreeTable[wrapper.Int, string]
+	if err := json.Unmarshal(data, &zero); err != nil || !zero.Equal(table) {
+		t.Log("result is", &zero, "err is", err)
+		t.Fail()
+	}
+}
@@ -1,28 +1,80 @@
 package table
 
 import (
+	"iter"
 	"reflect"
+	"slices"
 
-	"github.com/potex02/structures/util"
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/seq"
+	"github.com/potex02/structures/list"
 )
 
 // Stream provides aggregate operations for a [BaseTable].
-type Stream[K util.Comparer, T any] struct {
+//
+// A stream is lazy: Map, Filter and FilterMap only add a stage to the pipeline,
+// which is executed entry by entry when a terminal operation (Any, All, None, Count, a Collect method or RangeIter) is called.
+// The terminal operations stop pulling entries as soon as the result is known.
+//
+// Since the source is read only by the terminal operations, the modifications of the source done before them are visible in the result,
+// and every terminal operation runs the whole pipeline again.
+//
+// A stream can be set in parallel mode by Parallel.
+// In this mode, the stages added by Map, Filter, FilterMap, [MapTo] and [FlatMap] and the operations Any, All, None and Count
+// split the entries produced by the previous stage between a pool of workers, so the functions passed to them must be safe for concurrent use.
+// The other stages are executed sequentially.
+type Stream[K any, T any] struct {
 	// contains filtered or unexported fields
-	objects     []*Entry[K, T]
+	objects     iter.Seq2[K, T]
 	constructor reflect.Value
+	workers     int
+	ordered     bool
 }
 
-// NewStream returns a new [Stream] for a [BaseTable] associated at the table parameter.
+// NewStream returns a new [Stream] for a [ReadOnlyBaseTable] associated at the table parameter.
 //
 // Constructor a [reflect.Value] rapresenting the function that create the resulting table from the stream.
 // This function must have no parameters or must be a variadic function and must returns a BaseTable[K, T].
-func NewStream[K util.Comparer, T any](table BaseTable[K, T], constructor reflect.Value) *Stream[K, T] {
-	objects := make([]*Entry[K, T], 0)
-	table.Each(func(key K, element T) {
-		objects = append(objects, NewEntry(key, element))
-	})
-	return &Stream[K, T]{objects: objects, constructor: constructor}
+// It is used only by the Collect methods, so it can be the zero [reflect.Value] if s is collected through [CollectTo].
+//
+// Deprecated: the constructor is checked only when a Collect method is called, which panics if it is wrong.
+// Use [NewStreamFromSeq] and collect the stream through [CollectTo].
+func NewStream[K any, T any](table ReadOnlyBaseTable[K, T], constructor reflect.Value) *Stream[K, T] {
+	return &Stream[K, T]{objects: iter.Seq2[K, T](table.RangeIter()), constructor: constructor}
+}
+
+// NewStreamFromSeq returns a new [Stream] whose entries are produced by seq.
+// seq can be infinite, as long as the stream is consumed by a short-circuiting operation.
+//
+// The stream has no constructor, so it must be collected through [CollectTo].
+func NewStreamFromSeq[K any, T any](seq iter.Seq2[K, T]) *Stream[K, T] {
+	return &Stream[K, T]{objects: seq}
+}
+
+// Parallel sets s in parallel mode, using at most workers goroutines for each parallel stage.
+// If workers is less than 1, the number of workers is [runtime.GOMAXPROCS].
+//
+// If ordered is true, the parallel stages produce the entries in the same order of the sequential mode,
+// otherwise they produce them as soon as they are computed.
+//
+// The mode is applied to the stages added after the call of this method.
+func (s *Stream[K, T]) Parallel(workers int, ordered bool) *Stream[K, T] {
+	s.workers = seq.Workers(workers)
+	s.ordered = ordered
+	return s
+}
+
+// Sequential sets s in sequential mode, which is the default.
+//
+// The mode is applied to the stages added after the call of this method.
+func (s *Stream[K, T]) Sequential() *Stream[K, T] {
+	s.workers = 0
+	return s
+}
+
+// IsParallel returns true if s is in parallel mode.
+func (s *Stream[K, T]) IsParallel() bool {
+	return s.workers != 0
 }
 
 // Map executes fun for all elements of s and returns a [Stream] containing the resulting elements.
@@ -30,12 +82,9 @@ func NewStream[K util.Comparer, T any](table BaseTable[K, T], constructor reflec
 // This method modifies the state of s, so it is not necessary to assign to resulting stream, but it
 // can be useful for concatenate operations in a single instruction.
 func (s *Stream[K, T]) Map(fun func(key K, element T) T) *Stream[K, T] {
-	result := make([]*Entry[K, T], 0)
-	for _, i := range s.objects {
-		result = append(result, NewEntry(i.Key(), fun(i.Key(), i.Element())))
-	}
-	s.objects = result
-	return s
+	return s.FilterMap(func(key K, element T) (T, bool) {
+		return fun(key, element), true
+	})
 }
 
 // Filter returns a [Stream] containing the elements that satisfy fun.
@@ -43,14 +92,9 @@ func (s *Stream[K, T]) Map(fun func(key K, element T) T) *Stream[K, T] {
 // This method modifies the state of s, so it is not necessary to assign to resulting stream, but it
 // can be useful for concatenate operations in a single instruction.
 func (s *Stream[K, T]) Filter(fun func(key K, element T) bool) *Stream[K, T] {
-	result := make([]*Entry[K, T], 0)
-	for _, i := range s.objects {
-		if fun(i.Key(), i.Element()) {
-			result = append(result, NewEntry(i.Key(), i.Element()))
-		}
-	}
-	s.objects = result
-	return s
+	return s.FilterMap(func(key K, element T) (T, bool) {
+		return element, fun(key, element)
+	})
 }
 
 // FilterMap executes fun for all elements of s and returns a [Stream] containing the resulting elements that satisfy fun.
@@ -58,20 +102,30 @@ func (s *Stream[K, T]) Filter(fun func(key K, element T) bool) *Stream[K, T] {
 // This method modifies the state of s, so it is not necessary to assign to resulting stream, but it
 // can be useful for concatenate operations in a single instruction.
 func (s *Stream[K, T]) FilterMap(fun func(key K, element T) (T, bool)) *Stream[K, T] {
-	result := make([]*Entry[K, T], 0)
-	for _, i := range s.objects {
-		if element, ok := fun(i.Key(), i.Element()); ok {
-			result = append(result, NewEntry(i.Key(), element))
+	objects := s.objects
+	if s.IsParallel() {
+		s.objects = parallelFilterMap(objects, s.workers, s.ordered, fun)
+		return s
+	}
+	s.objects = func(yield func(K, T) bool) {
+		for i, j := range objects {
+			if element, ok := fun(i, j); ok && !yield(i, element) {
+				return
+			}
 		}
 	}
-	s.objects = result
 	return s
 }
 
 // Any returns true if at least one element of s satisfies fun.
 func (s *Stream[K, T]) Any(fun func(key K, element T) bool) bool {
-	for _, i := range s.objects {
-		if fun(i.Key(), i.Element()) {
+	if s.IsParallel() {
+		return seq.ParallelAny(s.entries(), s.workers, func(_ int, entry *Entry[K, T]) bool {
+			return fun(entry.Key(), entry.Element())
+		})
+	}
+	for i, j := range s.objects {
+		if fun(i, j) {
 			return true
 		}
 	}
@@ -80,8 +134,13 @@ func (s *Stream[K, T]) Any(fun func(key K, element T) bool) bool {
 
 // All returns true if all elements of s satisfy fun.
 func (s *Stream[K, T]) All(fun func(key K, element T) bool) bool {
-	for _, i := range s.objects {
-		if !fun(i.Key(), i.Element()) {
+	if s.IsParallel() {
+		return !seq.ParallelAny(s.entries(), s.workers, func(_ int, entry *Entry[K, T]) bool {
+			return !fun(entry.Key(), entry.Element())
+		})
+	}
+	for i, j := range s.objects {
+		if !fun(i, j) {
 			return false
 		}
 	}
@@ -90,34 +149,45 @@ func (s *Stream[K, T]) All(fun func(key K, element T) bool) bool {
 
 // None returns true if none of the elements of s satisfies fun.
 func (s *Stream[K, T]) None(fun func(key K, element T) bool) bool {
-	for _, i := range s.objects {
-		if fun(i.Key(), i.Element()) {
-			return false
-		}
-	}
-	return true
+	return !s.Any(fun)
 }
 
 // Count returns the number of elements that satisfy fun.
 func (s *Stream[K, T]) Count(fun func(key K, element T) bool) int {
+	if s.IsParallel() {
+		return seq.ParallelCount(s.entries(), s.workers, func(_ int, entry *Entry[K, T]) bool {
+			return fun(entry.Key(), entry.Element())
+		})
+	}
 	result := 0
-	for _, i := range s.objects {
-		if fun(i.Key(), i.Element()) {
+	for i, j := range s.objects {
+		if fun(i, j) {
 			result++
 		}
 	}
 	return result
 }
 
+// RangeIter returns a function that allows to iterate the entries of s using the range keyword.
+//
+//	for i, j := range s.RangeIter() {
+//		// Code
+//	}
+func (s *Stream[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return s.objects
+}
+
 // Collect returns a [Table] from s.
 //
 // the effective type of the result is the same the constructor.
 //
 // This method panics if constructor have wrong parameters or not returns a Table[K, T].
+//
+// Deprecated: use [CollectTo] with a typed collector, like [EntriesToHashTable].
 func (s *Stream[K, T]) Collect() Table[K, T] {
 	result := s.constructor.Call([]reflect.Value{})[0].Interface().(Table[K, T])
-	for _, i := range s.objects {
-		result.Put(i.Key(), i.Element())
+	for i, j := range s.objects {
+		result.Put(i, j)
 	}
 	return result
 }
@@ -127,10 +197,221 @@ func (s *Stream[K, T]) Collect() Table[K, T] {
 // the effective type of the result is the same the constructor.
 //
 // This method panics if constructor have wrong parameters or not returns a MultiTable[K, T].
+//
+// Deprecated: use [CollectTo] with a typed collector, like [EntriesToMultiHashTable].
 func (s *Stream[K, T]) CollectMulti() MultiTable[K, T] {
 	result := s.constructor.Call([]reflect.Value{})[0].Interface().(MultiTable[K, T])
+	for i, j := range s.objects {
+		result.Put(i, j)
+	}
+	return result
+}
+
+// CollectTo returns the result of collector applied at the entries of s.
+func CollectTo[K any, T any, R any](s *Stream[K, T], collector structures.Collector[*Entry[K, T], R]) R {
+	return collector.Collect(s.entries())
+}
+
+// CollectElementsTo returns the result of collector applied at the elements of s, ignoring their keys.
+func CollectElementsTo[K any, T any, R any](s *Stream[K, T], collector structures.Collector[T, R]) R {
+	return collector.Collect(func(yield func(T) bool) {
+		for _, i := range s.objects {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+}
+
+func (s *Stream[K, T]) entries() iter.Seq[*Entry[K, T]] {
+	return toEntries(s.objects)
+}
+
+func toEntries[K any, T any](objects iter.Seq2[K, T]) iter.Seq[*Entry[K, T]] {
+	return func(yield func(*Entry[K, T]) bool) {
+		for i, j := range objects {
+			if !yield(NewEntry(i, j)) {
+				return
+			}
+		}
+	}
+}
+
+func fromEntries[K any, T any](entries iter.Seq[*Entry[K, T]]) iter.Seq2[K, T] {
+	return func(yield func(K, T) bool) {
+		for i := range entries {
+			if !yield(i.Key(), i.Element()) {
+				return
+			}
+		}
+	}
+}
+
+// parallelFilterMap returns a sequence containing the keys of objects associated to the results of fun that satisfy it,
+// computed by at most workers goroutines. If ordered is true, the results keep the order of objects.
+func parallelFilterMap[K any, T any, R any](objects iter.Seq2[K, T], workers int, ordered bool, fun func(key K, element T) (R, bool)) iter.Seq2[K, R] {
+	return fromEntries(seq.ParallelFilterMap(toEntries(objects), workers, ordered, func(_ int, entry *Entry[K, T]) (*Entry[K, R], bool) {
+		element, ok := fun(entry.Key(), entry.Element())
+		return NewEntry(entry.Key(), element), ok
+	}))
+}
+
+// derive returns a new [Stream] producing objects with the same mode of s.
+func derive[K any, T any, R any](s *Stream[K, T], objects iter.Seq2[K, R], constructor reflect.Value) *Stream[K, R] {
+	return &Stream[K, R]{objects: objects, constructor: constructor, workers: s.workers, ordered: s.ordered}
+}
+
+// MapTo executes fun for all entries of s and returns a new [Stream] containing the same keys associated to the resulting elements,
+// which can be of a different type than the elements of s.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+func MapTo[K any, T any, R any](s *Stream[K, T], fun func(key K, element T) R) *Stream[K, R] {
+	objects := s.objects
+	if s.IsParallel() {
+		return derive(s, parallelFilterMap(objects, s.workers, s.ordered, func(key K, element T) (R, bool) {
+			return fun(key, element), true
+		}), reflect.Value{})
+	}
+	return derive(s, func(yield func(K, R) bool) {
+		for i, j := range objects {
+			if !yield(i, fun(i, j)) {
+				return
+			}
+		}
+	}, reflect.Value{})
+}
+
+// FlatMap executes fun for all entries of s and returns a new [Stream] where the key of every entry is associated,
+// in order, to each element of the resulting slice.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+func FlatMap[K any, T any, R any](s *Stream[K, T], fun func(key K, element T) []R) *Stream[K, R] {
+	objects := s.objects
+	if s.IsParallel() {
+		slices := parallelFilterMap(objects, s.workers, s.ordered, func(key K, element T) ([]R, bool) {
+			return fun(key, element), true
+		})
+		return derive(s, func(yield func(K, R) bool) {
+			for i, j := range slices {
+				for _, k := range j {
+					if !yield(i, k) {
+						return
+					}
+				}
+			}
+		}, reflect.Value{})
+	}
+	return derive(s, func(yield func(K, R) bool) {
+		for i, j := range objects {
+			for _, k := range fun(i, j) {
+				if !yield(i, k) {
+					return
+				}
+			}
+		}
+	}, reflect.Value{})
+}
+
+// Reduce combines the elements of s through fun, using the first element as initial result.
+// The function returns false if s is empty.
+func Reduce[K any, T any](s *Stream[K, T], fun func(result T, element T) T) (T, bool) {
+
+	var result T
+
+	found := false
 	for _, i := range s.objects {
-		result.Put(i.Key(), i.Element())
+		if found {
+			result = fun(result, i)
+		} else {
+			result = i
+			found = true
+		}
+	}
+	return result, found
+}
+
+// Fold combines the entries of s through fun, starting from initial.
+func Fold[K any, T any, R any](s *Stream[K, T], initial R, fun func(result R, key K, element T) R) R {
+	result := initial
+	for i, j := range s.objects {
+		result = fun(result, i, j)
+	}
+	return result
+}
+
+// GroupBy returns a map which associates every group returned by fun to the result of collector
+// applied at the entries of s in that group.
+func GroupBy[K any, T any, G comparable, R any](s *Stream[K, T], fun func(key K, element T) G, collector structures.Collector[*Entry[K, T], R]) map[G]R {
+	groups := map[G][]*Entry[K, T]{}
+	for i := range s.entries() {
+		group := fun(i.Key(), i.Element())
+		groups[group] = append(groups[group], i)
+	}
+	result := make(map[G]R, len(groups))
+	for i, j := range groups {
+		result[i] = collector.Collect(slices.Values(j))
 	}
 	return result
 }
+
+// Partition returns the result of collector applied at the entries of s that satisfy fun
+// and the result of collector applied at the other entries.
+func Partition[K any, T any, R any](s *Stream[K, T], fun func(key K, element T) bool, collector structures.Collector[*Entry[K, T], R]) (R, R) {
+
+	var accepted, rejected []*Entry[K, T]
+
+	for i := range s.entries() {
+		if fun(i.Key(), i.Element()) {
+			accepted = append(accepted, i)
+		} else {
+			rejected = append(rejected, i)
+		}
+	}
+	return collector.Collect(slices.Values(accepted)), collector.Collect(slices.Values(rejected))
+}
+
+// Distinct returns a new [Stream] containing the entries of s without duplicated keys.
+// The first entry of every key is kept.
+func Distinct[K comparable, T any](s *Stream[K, T]) *Stream[K, T] {
+	return derive(s, fromEntries(seq.DistinctFunc(s.entries(), (*Entry[K, T]).Key)), s.constructor)
+}
+
+// Sorted returns a new [Stream] containing the entries of s ordered by key through compare.
+//
+// The sort is stable, but all entries of s must be read before producing the first one.
+func Sorted[K any, T any](s *Stream[K, T], compare func(i K, j K) int) *Stream[K, T] {
+	return derive(s, fromEntries(seq.Sorted(s.entries(), func(i *Entry[K, T], j *Entry[K, T]) int {
+		return compare(i.Key(), j.Key())
+	})), s.constructor)
+}
+
+// Limit returns a new [Stream] containing at most the first n entries of s.
+func Limit[K any, T any](s *Stream[K, T], n int) *Stream[K, T] {
+	return derive(s, fromEntries(seq.Limit(s.entries(), n)), s.constructor)
+}
+
+// Skip returns a new [Stream] containing the entries of s except the first n.
+func Skip[K any, T any](s *Stream[K, T], n int) *Stream[K, T] {
+	return derive(s, fromEntries(seq.Skip(s.entries(), n)), s.constructor)
+}
+
+// Zip returns a new [Stream] containing the keys of s associated to the results of fun
+// for the elements of s and other in the same position.
+// The resulting stream ends when the shortest between s and other ends.
+//
+// The new stream has no constructor, so it must be collected through [CollectTo].
+func Zip[K any, T any, U any, R any](s *Stream[K, T], other *Stream[K, U], fun func(key K, i T, j U) R) *Stream[K, R] {
+	return derive(s, fromEntries(seq.Zip(s.entries(), other.entries(), func(i *Entry[K, T], j *Entry[K, U]) *Entry[K, R] {
+		return NewEntry(i.Key(), fun(i.Key(), i.Element(), j.Element()))
+	})), reflect.Value{})
+}
+
+// Chunk returns a new [list.Stream] containing slices of size consecutive entries of s.
+// The last slice can have less than size entries.
+//
+// The new stream has no constructor, so it must be collected through [list.CollectTo].
+//
+// This function panics if size is less than 1.
+func Chunk[K any, T any](s *Stream[K, T], size int) *list.Stream[[]*Entry[K, T]] {
+	return list.NewStreamFromSeq(seq.Chunk(s.entries(), size))
+}
@@ -1,9 +1,11 @@
 package table
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/potex02/structures/list"
 	"github.com/potex02/structures/util/wrapper"
 )
 
@@ -37,6 +39,33 @@ func TestNewStream(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestCollect(t *testing.T) {
+
+	var keys []wrapper.Int = []wrapper.Int{1, 2, 3, 4}
+	var elements []int = []int{10, 20, 30, 40}
+	var streams map[string]*Stream[wrapper.Int, int] = map[string]*Stream[wrapper.Int, int]{
+		"OpenHashTable":       NewOpenHashTableFromSlice(keys, elements).Stream(),
+		"PersistentHashTable": NewPersistentHashTableFromSlice(keys, elements).Stream(),
+		"PersistentTreeTable": NewPersistentTreeTableFromSlice(keys, elements).Stream(),
+		"TreeSubTable":        NewTreeTableFromSlice(keys, elements).TailTable(0, true).Stream(),
+		"ConcurrentHashTable": NewConcurrentHashTableFromSlice(keys, elements).Stream(),
+	}
+
+	for name, stream := range streams {
+		var result Table[wrapper.Int, int] = stream.Filter(func(key wrapper.Int, element int) bool {
+			return key%2 == 0
+		}).Collect()
+		if e, _ := result.Get(4); result.Len() != 2 || e != 40 {
+			t.Log(name, "result is", result)
+			t.Fail()
+		}
+	}
+	var multi MultiTable[wrapper.Int, int] = NewMultiOpenHashTableFromSlice(keys, elements).Stream().CollectMulti()
+	if multi.Len() != 4 || !multi.Contains(3, 30) {
+		t.Log("result is", multi)
+		t.Fail()
+	}
+}
 func TestMap(t *testing.T) {
 
 	var stream *Stream[wrapper.Int, int] = NewHashTableFromSlice[wrapper.Int, int](
@@ -349,3 +378,197 @@ func TestCountMulti(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestLazyStream(t *testing.T) {
+
+	var stream *Stream[wrapper.Int, int] = NewStreamFromSeq(func(yield func(wrapper.Int, int) bool) {
+		for i := 0; ; i++ {
+			if !yield(wrapper.Int(i), i*i) {
+				return
+			}
+		}
+	})
+
+	calls := 0
+	stream.Filter(func(key wrapper.Int, element int) bool {
+		calls++
+		return key%2 == 1
+	})
+	if stream.None(func(key wrapper.Int, element int) bool {
+		return element == 49
+	}) {
+		t.Log("result is true")
+		t.Fail()
+	}
+	if calls != 8 {
+		t.Log("filter is called", calls, "times")
+		t.Fail()
+	}
+}
+func TestMapTo(t *testing.T) {
+
+	var stream *Stream[wrapper.Int, int] = NewTreeTableFromSlice[wrapper.Int, int](
+		[]wrapper.Int{1, 2, -10},
+		[]int{12, -2, 30},
+	).Stream()
+
+	result := CollectTo(MapTo(stream, func(key wrapper.Int, element int) string {
+		return fmt.Sprint(key, ":", element)
+	}), EntriesToHashTable[wrapper.Int, string]())
+	if !result.Equal(NewHashTableFromSlice([]wrapper.Int{1, 2, -10}, []string{"1:12", "2:-2", "-10:30"})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	multi := CollectTo(FlatMap(stream, func(key wrapper.Int, element int) []int {
+		return []int{element, -element}
+	}), EntriesToMultiHashTable[wrapper.Int, int]())
+	if multi.Len() != 6 || !multi.ContainsKey(-10) || !multi.ContainsElement(-30) {
+		t.Log("result is", multi)
+		t.Fail()
+	}
+}
+func TestReduce(t *testing.T) {
+
+	var stream *Stream[wrapper.Int, int] = NewTreeTableFromSlice[wrapper.Int, int](
+		[]wrapper.Int{1, 2, -10},
+		[]int{12, -2, 30},
+	).Stream()
+
+	if result, ok := Reduce(stream, func(result int, element int) int {
+		return result + element
+	}); !ok || result != 40 {
+		t.Log("result is", result, ok)
+		t.Fail()
+	}
+	if result := Fold(stream, 0, func(result int, key wrapper.Int, element int) int {
+		return result + int(key)*element
+	}); result != -292 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestGroupBy(t *testing.T) {
+
+	var stream *Stream[wrapper.Int, int] = NewHashTableFromSlice[wrapper.Int, int](
+		[]wrapper.Int{1, 2, -10, 20},
+		[]int{12, -2, 30, 20},
+	).Stream()
+
+	result := GroupBy(stream, func(key wrapper.Int, element int) bool {
+		return key > 0
+	}, EntriesToHashTable[wrapper.Int, int]())
+	if len(result) != 2 || !result[true].Equal(NewHashTableFromSlice([]wrapper.Int{1, 2, 20}, []int{12, -2, 20})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	accepted, rejected := Partition(stream, func(key wrapper.Int, element int) bool {
+		return element > 0
+	}, EntriesToMultiHashTable[wrapper.Int, int]())
+	if accepted.Len() != 3 || rejected.Len() != 1 || !rejected.ContainsKey(2) {
+		t.Log("result is", accepted, rejected)
+		t.Fail()
+	}
+}
+func TestDistinct(t *testing.T) {
+
+	var stream *Stream[wrapper.Int, int] = NewMultiTreeTableFromSlice[wrapper.Int, int](
+		[]wrapper.Int{3, 3, 1, 2, 2, 2},
+		[]int{1, 2, 3, 4, 5, 6},
+	).Stream()
+
+	if result := Distinct(stream).CollectMulti(); !result.Equal(NewMultiTreeTableFromSlice([]wrapper.Int{1, 2, 3}, []int{3, 4, 1})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	result := Limit(Skip(Sorted(stream, func(i wrapper.Int, j wrapper.Int) int {
+		return j.Compare(i)
+	}), 1), 3).CollectMulti()
+	if !result.Equal(NewMultiTreeTableFromSlice([]wrapper.Int{3, 2, 2}, []int{2, 4, 5})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestZip(t *testing.T) {
+
+	var stream *Stream[wrapper.Int, int] = NewTreeTableFromSlice[wrapper.Int, int](
+		[]wrapper.Int{1, 2, 3},
+		[]int{12, -2, 30},
+	).Stream()
+	var other *Stream[wrapper.Int, string] = NewTreeTableFromSlice[wrapper.Int, string](
+		[]wrapper.Int{4, 5},
+		[]string{"a", "b"},
+	).Stream()
+
+	result := CollectTo(Zip(stream, other, func(key wrapper.Int, i int, j string) string {
+		return fmt.Sprint(j, i)
+	}), EntriesToTreeTable[wrapper.Int, string]())
+	if !result.Equal(NewHashTableFromSlice([]wrapper.Int{1, 2}, []string{"a12", "b-2"})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestChunk(t *testing.T) {
+
+	var stream *Stream[wrapper.Int, int] = NewTreeTableFromSlice[wrapper.Int, int](
+		[]wrapper.Int{1, 2, 3},
+		[]int{12, -2, 30},
+	).Stream()
+
+	result := list.CollectTo(Chunk(stream, 2), list.ToArrayList[[]*Entry[wrapper.Int, int]]())
+	if last, _ := result.Get(1); result.Len() != 2 || len(last) != 1 || last[0].Key() != 3 || last[0].Element() != 30 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
+func TestParallel(t *testing.T) {
+
+	var table *HashTable[wrapper.Int, int] = NewHashTable[wrapper.Int, int]()
+
+	for i := 0; i != 10000; i++ {
+		table.Put(wrapper.Int(i), i*2)
+	}
+	stream := table.Stream().Parallel(4, false).Filter(func(key wrapper.Int, element int) bool {
+		return key%2 == 0
+	})
+	result := CollectTo(MapTo(stream, func(key wrapper.Int, element int) wrapper.Int {
+		return key - wrapper.Int(element)
+	}), EntriesToTreeTable[wrapper.Int, wrapper.Int]())
+	if e, _ := result.Get(10); result.Len() != 5000 || e != -10 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := stream.Count(func(key wrapper.Int, element int) bool {
+		return element < 100
+	}); result != 25 {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if !stream.All(func(key wrapper.Int, element int) bool {
+		return element == int(key)*2
+	}) || !stream.Any(func(key wrapper.Int, element int) bool {
+		return key == 9998
+	}) {
+		t.Log("all or any is wrong")
+		t.Fail()
+	}
+	multi := CollectTo(FlatMap(stream, func(key wrapper.Int, element int) []int {
+		return []int{element, -element}
+	}), EntriesToMultiHashTable[wrapper.Int, int]())
+	if multi.Len() != 10000 {
+		t.Log("length is", multi.Len())
+		t.Fail()
+	}
+	ordered := NewTreeTableFromSlice(
+		[]wrapper.Int{5, 3, 1, 4, 2},
+		[]int{50, 30, 10, 40, 20},
+	).Stream().Parallel(4, true).Map(func(key wrapper.Int, element int) int {
+		return element + 1
+	})
+	keys := []wrapper.Int{}
+	for i := range ordered.RangeIter() {
+		keys = append(keys, i)
+	}
+	if !reflect.DeepEqual(keys, []wrapper.Int{1, 2, 3, 4, 5}) {
+		t.Log("keys are", keys)
+		t.Fail()
+	}
+}
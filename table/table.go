@@ -2,17 +2,26 @@
 package table
 
 import (
+	"fmt"
+	"reflect"
+
 	"github.com/potex02/structures"
 	"github.com/potex02/structures/list"
 	"github.com/potex02/structures/util"
 )
 
-// BaseTable is the base interface for both [Table] and [MultiTable].
-// A basetable contains all the methods of [structures.Structure].
-//
-// It provides methods for a generic dynamic table can have unique or duplicate keys.
-type BaseTable[K util.Comparer, T any] interface {
-	structures.Structure[T]
+// ReadOnlyBaseTable provides the methods to read a generic table with unique or duplicate keys without modifying it.
+// It is the base interface for both [ReadOnlyTable] and [ReadOnlyMultiTable].
+type ReadOnlyBaseTable[K any, T any] interface {
+	fmt.Stringer
+	util.Equaler
+	util.Hasher
+	// Len returns the numbers of elements in the table.
+	Len() int
+	// IsEmpty returns a bool which indicates if the table is empty or not.
+	IsEmpty() bool
+	// ToSlice returns a slice which contains all elements of the table.
+	ToSlice() []T
 	// ContainsKey returns true if the key is present in the table.
 	ContainsKey(key K) bool
 	// ContainsElement returns true if the element e is present in the table.
@@ -21,15 +30,32 @@ type BaseTable[K util.Comparer, T any] interface {
 	Keys() list.List[K]
 	// Elements returns a [list.List] which contains all elements of the table.
 	Elements() list.List[T]
-	// PutSlice adds the elements of e at the table.
-	// It panics if key and e have different lengths.
-	PutSlice(key []K, e []T)
 	// Each executes fun for all elements of the table.
 	//
 	// This method should be used to remove elements. Use Iter insted.
 	Each(fun func(key K, element T))
 	// Stream returns a [Stream] rapresenting the table.
 	Stream() *Stream[K, T]
+	// RangeIter returns a function that allows to iterate the table using the range keyword.
+	//
+	//	for i, j := range table.RangeIter() {
+	//		// Code
+	//	}
+	//
+	// Unlike [BaseTable.Iter], it doesn't allow to remove elements during the iteration.
+	RangeIter() func(yield func(K, T) bool)
+}
+
+// BaseTable is the base interface for both [Table] and [MultiTable].
+// A basetable contains all the methods of [structures.Structure] and of [ReadOnlyBaseTable].
+//
+// It provides methods for a generic dynamic table can have unique or duplicate keys.
+type BaseTable[K any, T any] interface {
+	structures.Structure[T]
+	ReadOnlyBaseTable[K, T]
+	// PutSlice adds the elements of e at the table.
+	// It panics if key and e have different lengths.
+	PutSlice(key []K, e []T)
 	// Iter returns an [Iterator] which permits to iterate a [BaseTable].
 	//
 	//	for i := table.Iter(); !i.End(); i = i.Next() {
@@ -38,29 +64,19 @@ type BaseTable[K util.Comparer, T any] interface {
 	//		// Code
 	//	}
 	Iter() Iterator[K, T]
-	// RangeIter returns a function that allows to iterate a [BaseTable] using the range keyword.
-	//
-	//	for i := range table.RangeIter() {
-	//		// Code
-	//	}
-	//
-	// Unlike [BaseTable.Iter], it doesn't allow to remove elements during the iteration.
-	RangeIter() func(yield func(K, T) bool)
 }
 
 // Table provides all methods to use a generic dynamic table.
-// A table contains all the methods of [BaseTable].
+// A table contains all the methods of [BaseTable] and of [ReadOnlyTable].
 //
-// The check on the equality of the keys is done with the Compare method.
+// The check on the equality of the keys is done with the Compare method or, for the tables created with a comparison function, with that function.
 //
 // The check on the equality of the elements is done with the Equal method if T implements [util.Equaler],
 // otherwise it is done with [reflect.DeepEqual].
-type Table[K util.Comparer, T any] interface {
+type Table[K any, T any] interface {
 	BaseTable[K, T]
+	ReadOnlyTable[K, T]
 	util.Copier[Table[K, T]]
-	// Get returns the element associated at the key.
-	// The method returns false if the key is not found.
-	Get(key K) (T, bool)
 	// Put set the element e at the key and returns the overwritten value, if present.
 	// If the element is not present, the method returns false.
 	Put(key K, e T) (T, bool)
@@ -69,20 +85,42 @@ type Table[K util.Comparer, T any] interface {
 	Remove(key K) (T, bool)
 }
 
-// MultiTable provides all methods to use a generic dynamic table with duplicate keys.
-// A multitable contains all the methods of [BaseTable].
+// ReadOnlyTable provides the methods to read a generic table with unique keys without modifying it.
+// It is satisfied by every [Table], by the persistent tables and by the views returned by [Unmodifiable].
 //
-// The check on the equality of the keys is done with the Compare method.
+// The check on the equality of the elements is done with the Equal method if T implements [util.Equaler],
+// otherwise it is done with [reflect.DeepEqual].
+type ReadOnlyTable[K any, T any] interface {
+	ReadOnlyBaseTable[K, T]
+	// Get returns the element associated at the key.
+	// The method returns false if the key is not found.
+	Get(key K) (T, bool)
+}
+
+// ReadOnlyMultiTable provides the methods to read a generic table with duplicate keys without modifying it.
+// It is satisfied by every [MultiTable] and by the views returned by [UnmodifiableMulti].
 //
 // The check on the equality of the elements is done with the Equal method if T implements [util.Equaler],
 // otherwise it is done with [reflect.DeepEqual].
-type MultiTable[K util.Comparer, T any] interface {
-	BaseTable[K, T]
-	util.Copier[MultiTable[K, T]]
+type ReadOnlyMultiTable[K any, T any] interface {
+	ReadOnlyBaseTable[K, T]
 	// Contains returns true if the key is present in the table associated with the element e.
 	Contains(key K, e T) bool
 	// Get returns a slice cotaining the elements associated at the key.
 	Get(key K) []T
+}
+
+// MultiTable provides all methods to use a generic dynamic table with duplicate keys.
+// A multitable contains all the methods of [BaseTable] and of [ReadOnlyMultiTable].
+//
+// The check on the equality of the keys is done with the Compare method or, for the tables created with a comparison function, with that function.
+//
+// The check on the equality of the elements is done with the Equal method if T implements [util.Equaler],
+// otherwise it is done with [reflect.DeepEqual].
+type MultiTable[K any, T any] interface {
+	BaseTable[K, T]
+	ReadOnlyMultiTable[K, T]
+	util.Copier[MultiTable[K, T]]
 	// Put add the elements of e at the key.
 	Put(key K, e ...T)
 	// Replace replace all elements associated at the key with e and returns the slice of overwritten values.
@@ -95,3 +133,41 @@ type MultiTable[K util.Comparer, T any] interface {
 	// RemoveKey remove all elements associated at the key and returns the slice of removed values.
 	RemoveKey(key K) []T
 }
+
+func equalTables[K any, T any](t ReadOnlyTable[K, T], table ReadOnlyTable[K, T]) bool {
+	if t.Len() != table.Len() {
+		return false
+	}
+	for i, j := range t.RangeIter() {
+		other, found := table.Get(i)
+		if !found || !util.EqualFunction(j)(other) {
+			return false
+		}
+	}
+	return true
+}
+
+func compareTables[K any, T any](t ReadOnlyBaseTable[K, T], table ReadOnlyBaseTable[K, T]) int {
+	if t.Len() < table.Len() {
+		return -1
+	}
+	if t.Len() > table.Len() {
+		return 1
+	}
+	return 0
+}
+
+func tableString[K any, T any](name string, t ReadOnlyBaseTable[K, T]) string {
+	check := []string{reflect.TypeOf(new(K)).String(), reflect.TypeOf(new(T)).String()}
+	result := fmt.Sprintf("%v[%v, %v][", name, check[0][1:], check[1][1:])
+	first := true
+	t.Each(func(key K, element T) {
+		if !first {
+			result += ", "
+		}
+		result += fmt.Sprintf("%v: %v", key, element)
+		first = false
+	})
+	result += "]"
+	return result
+}
@@ -0,0 +1,63 @@
+package table
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestBinary(t *testing.T) {
+
+	var keys []wrapper.String = []wrapper.String{"b", "a", "c", "a"}
+	var elements []int = []int{2, 1, 3, 4}
+	var tests []binaryTest = []binaryTest{
+		{"HashTable", NewHashTableFromSlice(keys, elements), NewHashTableFromSlice(keys[:1], elements[:1]), &HashTable[wrapper.String, int]{}},
+		{"OpenHashTable", NewOpenHashTableFromSlice(keys, elements), NewOpenHashTableFromSlice(keys[:1], elements[:1]), &OpenHashTable[wrapper.String, int]{}},
+		{"ConcurrentHashTable", NewConcurrentHashTableFromSlice(keys, elements), NewConcurrentHashTableFromSlice(keys[:1], elements[:1]), &ConcurrentHashTable[wrapper.String, int]{}},
+		{"PersistentHashTable", NewPersistentHashTableFromSlice(keys, elements), NewPersistentHashTableFromSlice(keys[:1], elements[:1]), &PersistentHashTable[wrapper.String, int]{}},
+		{"TreeTable", NewTreeTableFromSlice(keys, elements), NewTreeTableFromSlice(keys[:1], elements[:1]), &TreeTable[wrapper.String, int]{}},
+		{"PersistentTreeTable", NewPersistentTreeTableFromSlice(keys, elements), NewPersistentTreeTableFromSlice(keys[:1], elements[:1]), &PersistentTreeTable[wrapper.String, int]{}},
+		{"TreeSubTable", NewTreeTableFromSlice(keys, elements).HeadTable("b", true), NewTreeTableFromSlice(keys[2:3], elements[2:3]).HeadTable("b", true), &TreeTable[wrapper.String, int]{}},
+		{"MultiHashTable", NewMultiHashTableFromSlice(keys, elements), NewMultiHashTableFromSlice(keys[:1], elements[:1]), &MultiHashTable[wrapper.String, int]{}},
+		{"MultiOpenHashTable", NewMultiOpenHashTableFromSlice(keys, elements), NewMultiOpenHashTableFromSlice(keys[:1], elements[:1]), &MultiOpenHashTable[wrapper.String, int]{}},
+		{"MultiTreeTable", NewMultiTreeTableFromSlice(keys, elements), NewMultiTreeTableFromSlice(keys[:1], elements[:1]), &MultiTreeTable[wrapper.String, int]{}},
+	}
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
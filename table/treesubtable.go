@@ -0,0 +1,365 @@
+package table
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/bound"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = NewTreeTable[wrapper.Int, int]().TailTable(0, true)
+var _ Table[wrapper.Int, int] = NewTreeTable[wrapper.Int, int]().TailTable(0, true)
+
+// TreeSubTable provides a view of the keys of a [TreeTable] between two bounds, with their associated elements.
+// It is returned by [TreeTable.SubTable], [TreeTable.HeadTable] and [TreeTable.TailTable].
+//
+// The view is not a copy: it shares its entries with the [TreeTable],
+// so the changes made on one of them, also after the creation of the view, are visible through the other.
+// The lookups are done directly on the tree of the [TreeTable] and run in O(log n) time.
+//
+// The keys out of the range of the view are ignored by the methods which put elements.
+//
+// It implements the interface [Table].
+type TreeSubTable[K any, T any] struct {
+	// contains filtered or unexported fields
+	table  *TreeTable[K, T]
+	bounds bound.Range[*Entry[K, T], K]
+}
+
+// Len returns the length of t.
+//
+// This method runs in O(log n) time.
+func (t *TreeSubTable[K, T]) Len() int {
+	return t.bounds.Len()
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *TreeSubTable[K, T]) IsEmpty() bool {
+	return t.bounds.First() == nil
+}
+
+// ContainsKey returns true if the key is present on t.
+func (t *TreeSubTable[K, T]) ContainsKey(key K) bool {
+	return t.bounds.Find(key) != nil
+}
+
+// ContainsElement returns true if the element e is present on t.
+func (t *TreeSubTable[K, T]) ContainsElement(e T) bool {
+	fun := util.EqualFunction(e)
+	for i := range t.bounds.RangeIter() {
+		if fun(i.Element()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns a [list.List] which contains all keys of t.
+func (t *TreeSubTable[K, T]) Keys() list.List[K] {
+	list := list.NewArrayList[K]()
+	for i := range t.bounds.RangeIter() {
+		list.Add(i.Key())
+	}
+	return list
+}
+
+// Elements returns a [list.List] which contains all elements of t.
+func (t *TreeSubTable[K, T]) Elements() list.List[T] {
+	list := list.NewArrayList[T]()
+	for i := range t.bounds.RangeIter() {
+		list.Add(i.Element())
+	}
+	return list
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *TreeSubTable[K, T]) ToSlice() []T {
+	slice := make([]T, 0)
+	for i := range t.bounds.RangeIter() {
+		slice = append(slice, i.Element())
+	}
+	return slice
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+func (t *TreeSubTable[K, T]) Get(key K) (T, bool) {
+	_, result, ok := entryOf(t.bounds.Find(key))
+	return result, ok
+}
+
+// Put set the element e at the key and returns the overwritten value, if present.
+// If the element is not present, the method returns false.
+//
+// If the key is out of the range of t, it is ignored and the method returns false.
+func (t *TreeSubTable[K, T]) Put(key K, e T) (T, bool) {
+	if !t.bounds.InRange(key) {
+
+		var result T
+
+		return result, false
+	}
+	return t.table.Put(key, e)
+}
+
+// PutSlice adds the elements of e at t.
+// It panics if key and e have different lengths.
+//
+// The keys out of the range of t are ignored with their elements.
+func (t *TreeSubTable[K, T]) PutSlice(key []K, e []T) {
+	if len(key) != len(e) {
+		panic("Different lengths for keys and elements")
+	}
+	for i := 0; i != len(key); i++ {
+		t.Put(key[i], e[i])
+	}
+}
+
+// Remove removes the key from t and returns the value associated at the key.
+// It returns false if the the key does not exists.
+func (t *TreeSubTable[K, T]) Remove(key K) (T, bool) {
+	if !t.bounds.InRange(key) {
+
+		var result T
+
+		return result, false
+	}
+	return t.table.Remove(key)
+}
+
+// First returns the min key of t and its associated element.
+// The method returns false if t is empty.
+func (t *TreeSubTable[K, T]) First() (K, T, bool) {
+	return entryOf(t.bounds.First())
+}
+
+// Last returns the max key of t and its associated element.
+// The method returns false if t is empty.
+func (t *TreeSubTable[K, T]) Last() (K, T, bool) {
+	return entryOf(t.bounds.Last())
+}
+
+// PollFirst removes the min key from t and returns the removed key and its associated element.
+// The method returns false if t is empty.
+func (t *TreeSubTable[K, T]) PollFirst() (K, T, bool) {
+	key, element, ok := t.First()
+	if ok {
+		t.table.Remove(key)
+	}
+	return key, element, ok
+}
+
+// PollLast removes the max key from t and returns the removed key and its associated element.
+// The method returns false if t is empty.
+func (t *TreeSubTable[K, T]) PollLast() (K, T, bool) {
+	key, element, ok := t.Last()
+	if ok {
+		t.table.Remove(key)
+	}
+	return key, element, ok
+}
+
+// Floor returns the greatest key of t less than or equal to key and its associated element.
+// The method returns false if there is no such key.
+func (t *TreeSubTable[K, T]) Floor(key K) (K, T, bool) {
+	return entryOf(t.bounds.Floor(key))
+}
+
+// Ceiling returns the least key of t greater than or equal to key and its associated element.
+// The method returns false if there is no such key.
+func (t *TreeSubTable[K, T]) Ceiling(key K) (K, T, bool) {
+	return entryOf(t.bounds.Ceiling(key))
+}
+
+// Lower returns the greatest key of t strictly less than key and its associated element.
+// The method returns false if there is no such key.
+func (t *TreeSubTable[K, T]) Lower(key K) (K, T, bool) {
+	return entryOf(t.bounds.Lower(key))
+}
+
+// Higher returns the least key of t strictly greater than key and its associated element.
+// The method returns false if there is no such key.
+func (t *TreeSubTable[K, T]) Higher(key K) (K, T, bool) {
+	return entryOf(t.bounds.Higher(key))
+}
+
+// SubTable returns a [TreeSubTable] containing the keys of t between from and to, with their associated elements.
+// fromInclusive and toInclusive indicate if from and to are part of the range.
+//
+// The range of the result is the intersection between the range of t and the passed one.
+func (t *TreeSubTable[K, T]) SubTable(from K, fromInclusive bool, to K, toInclusive bool) *TreeSubTable[K, T] {
+	return &TreeSubTable[K, T]{table: t.table, bounds: t.bounds.From(from, fromInclusive).To(to, toInclusive)}
+}
+
+// HeadTable returns a [TreeSubTable] containing the keys of t less than to,
+// or equal to it if inclusive is true, with their associated elements.
+func (t *TreeSubTable[K, T]) HeadTable(to K, inclusive bool) *TreeSubTable[K, T] {
+	return &TreeSubTable[K, T]{table: t.table, bounds: t.bounds.To(to, inclusive)}
+}
+
+// TailTable returns a [TreeSubTable] containing the keys of t greater than from,
+// or equal to it if inclusive is true, with their associated elements.
+func (t *TreeSubTable[K, T]) TailTable(from K, inclusive bool) *TreeSubTable[K, T] {
+	return &TreeSubTable[K, T]{table: t.table, bounds: t.bounds.From(from, inclusive)}
+}
+
+// Each executes fun for all elements of t.
+//
+// This method should be used to remove elements. Use Iter insted.
+func (t *TreeSubTable[K, T]) Each(fun func(key K, element T)) {
+	for i := range t.bounds.RangeIter() {
+		fun(i.Key(), i.Element())
+	}
+}
+
+// Stream returns a [Stream] rapresenting t.
+//
+// The Collect methods of the stream return a new [TreeTable] with the same order of t.
+func (t *TreeSubTable[K, T]) Stream() *Stream[K, T] {
+	return NewStream[K, T](t, reflect.ValueOf(func() *TreeTable[K, T] {
+		return NewTreeTableFunc[K, T](t.table.compare)
+	}))
+}
+
+// Clear removes all keys of t from the [TreeTable].
+// The keys out of the range of t are kept.
+func (t *TreeSubTable[K, T]) Clear() {
+	for node := t.bounds.First(); node != nil; node = t.bounds.First() {
+		t.table.objects.Remove(node.Element())
+	}
+}
+
+// Iter returns an [Iterator] which permits to iterate a [TreeSubTable].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		key := i.Key()
+//		element := i.Element()
+//		// Code
+//	}
+func (t *TreeSubTable[K, T]) Iter() Iterator[K, T] {
+	return NewTreeSubTableIterator(t)
+}
+
+// RangeIter returns a function that allows to iterate a [TreeSubTable] using the range keyword.
+//
+//	for i := range t.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [TreeSubTable.Iter], it doesn't allow to remove elements during the iteration.
+func (t *TreeSubTable[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		for i := range t.bounds.RangeIter() {
+			if !yield(i.Key(), i.Element()) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns true if t and st are both [Table] and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [HashTable],
+// but the elements of t and the elements of st are equals, this method returns anyway true.
+func (t *TreeSubTable[K, T]) Equal(st any) bool {
+	table, ok := st.(Table[K, T])
+	if ok && t != nil && table != nil {
+		return equalTables[K, T](t, table)
+	}
+	return false
+}
+
+// Compare returns 0 if t and st have the same length,
+// -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [Table] or if one between t and st is nil.
+func (t *TreeSubTable[K, T]) Compare(st any) int {
+	table, ok := st.(Table[K, T])
+	if ok && t != nil && table != nil {
+		return compareTables[K, T](t, table)
+	}
+	return -2
+}
+
+// Hash returns the hash code of t, which is the same of a [TreeTable] containing the entries of t.
+func (t *TreeSubTable[K, T]) Hash() uint64 {
+	return t.bounds.Hash()
+}
+
+// Copy returns a table containing a copy of the elements of t.
+// The result of this method is of type [Table], but the effective table which is created is a [TreeTable]
+// with the same order of t, which does not share its entries with t.
+//
+// This method uses [util.Copy] to make copies of the elements.
+func (t *TreeSubTable[K, T]) Copy() Table[K, T] {
+	result := NewTreeTableFunc[K, T](t.table.compare)
+	for i := range t.bounds.RangeIter() {
+		result.Put(i.Key(), util.Copy(i.Element()))
+	}
+	return result
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *TreeSubTable[K, T]) String() string {
+	return tableString[K, T]("TreeSubTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, element] pairs.
+// In both cases, the entries are in ascending order of the keys.
+func (t *TreeSubTable[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(t.RangeIter())
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from data.
+//
+// data can be both a JSON object and an array of [key, element] pairs.
+//
+// The method returns an error if t is the zero value or if one of the keys is out of the range of t.
+func (t *TreeSubTable[K, T]) UnmarshalJSON(data []byte) error {
+	return decodeEntries(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its entries as key and element pairs in ascending order of the keys.
+func (t *TreeSubTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+//
+// The method returns an error if t is the zero value or if one of the keys is out of the range of t.
+func (t *TreeSubTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [TreeSubTable.MarshalBinary].
+func (t *TreeSubTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [TreeSubTable.UnmarshalBinary].
+func (t *TreeSubTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *TreeSubTable[K, T]) decode(key []K, c []T) error {
+	if t.table == nil {
+		return errors.New("Cannot decode a TreeSubTable which is not associated at a TreeTable")
+	}
+	for _, i := range key {
+		if !t.bounds.InRange(i) {
+			return errors.New("Cannot decode a key out of the range of the TreeSubTable")
+		}
+	}
+	t.Clear()
+	t.table.PutSlice(key, c)
+	return nil
+}
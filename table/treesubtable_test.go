@@ -0,0 +1,181 @@
+package table
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestTreeSubTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice(
+		[]wrapper.Int{10, 20, 30, 40, 50},
+		[]string{"a", "b", "c", "d", "e"},
+	)
+	var sub Table[wrapper.Int, string] = table.SubTable(20, true, 40, false)
+
+	if sub.Len() != 2 {
+		t.Log("length is", sub.Len())
+		t.Fail()
+	}
+	table.Put(25, "f")
+	table.Put(45, "g")
+	if e, ok := sub.Get(25); !ok || e != "f" || sub.ContainsKey(40) || sub.Len() != 3 {
+		t.Log("sub is", sub)
+		t.Fail()
+	}
+	if !sub.ContainsElement("c") || sub.ContainsElement("d") {
+		t.Log("sub is", sub)
+		t.Fail()
+	}
+	expected := NewTreeTableFromSlice([]wrapper.Int{20, 25, 30}, []string{"b", "f", "c"})
+	if !sub.Equal(expected) || sub.Hash() != expected.Hash() {
+		t.Log("sub is", sub)
+		t.Fail()
+	}
+	if copy := sub.Copy(); !reflect.DeepEqual(copy.Keys().ToSlice(), []wrapper.Int{20, 25, 30}) {
+		t.Log("copy is", copy)
+		t.Fail()
+	}
+	if sub.String() != "TreeSubTable[wrapper.Int, string][20: b, 25: f, 30: c]" {
+		t.Log("string is", sub.String())
+		t.Fail()
+	}
+}
+func TestPutRemoveTreeSubTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice(
+		[]wrapper.Int{10, 20, 30},
+		[]string{"a", "b", "c"},
+	)
+	var sub *TreeSubTable[wrapper.Int, string] = table.HeadTable(20, true)
+
+	if e, ok := sub.Put(15, "d"); ok || !table.ContainsKey(15) {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if _, ok := sub.Remove(30); ok || !table.ContainsKey(30) {
+		t.Log("removed 30 from table")
+		t.Fail()
+	}
+	if e, ok := sub.Remove(10); !ok || e != "a" || table.ContainsKey(10) {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if _, ok := sub.Put(40, "e"); ok || table.ContainsKey(40) {
+		t.Log("found 40 in table")
+		t.Fail()
+	}
+	sub.PutSlice([]wrapper.Int{5, 40}, []string{"e", "f"})
+	if e, _ := table.Get(5); e != "e" || table.ContainsKey(40) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestNavigationTreeSubTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice(
+		[]wrapper.Int{10, 20, 30, 40, 50},
+		[]string{"a", "b", "c", "d", "e"},
+	)
+	var sub *TreeSubTable[wrapper.Int, string] = table.SubTable(10, false, 50, false)
+
+	if key, e, ok := sub.First(); !ok || key != 20 || e != "b" {
+		t.Log("first is", key, e)
+		t.Fail()
+	}
+	if key, e, ok := sub.Last(); !ok || key != 40 || e != "d" {
+		t.Log("last is", key, e)
+		t.Fail()
+	}
+	if key, _, ok := sub.Floor(60); !ok || key != 40 {
+		t.Log("floor is", key)
+		t.Fail()
+	}
+	if key, _, ok := sub.Higher(40); ok {
+		t.Log("higher is", key)
+		t.Fail()
+	}
+	if slice := sub.TailTable(30, true).Keys().ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{30, 40}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if key, _, ok := sub.PollLast(); !ok || key != 40 || table.ContainsKey(40) {
+		t.Log("last is", key)
+		t.Fail()
+	}
+	sub.Clear()
+	if !reflect.DeepEqual(table.Keys().ToSlice(), []wrapper.Int{10, 50}) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestIterTreeSubTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice(
+		[]wrapper.Int{10, 20, 30, 40, 50},
+		[]string{"a", "b", "c", "d", "e"},
+	)
+	var sub *TreeSubTable[wrapper.Int, string] = table.TailTable(20, true)
+
+	j := 0
+	for i := sub.Iter(); !i.End(); {
+		if index := i.(IndexedIterator[wrapper.Int, string]).Index(); index != j {
+			t.Log("index of", i.Key(), "is", index)
+			t.Fail()
+		}
+		if i.Key()%20 == 0 {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+			j++
+		}
+	}
+	if !reflect.DeepEqual(table.Keys().ToSlice(), []wrapper.Int{10, 30, 50}) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestJSONTreeSubTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice(
+		[]wrapper.Int{10, 20, 30},
+		[]string{"a", "b", "c"},
+	)
+	var sub *TreeSubTable[wrapper.Int, string] = table.TailTable(20, true)
+
+	data, err := json.Marshal(sub)
+	if err != nil || string(data) != `{"20":"b","30":"c"}` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`{"25":"d"}`), sub); err != nil || !reflect.DeepEqual(table.Keys().ToSlice(), []wrapper.Int{10, 25}) {
+		t.Log("table is", table, "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`{"5":"e"}`), sub); err == nil || table.Len() != 2 {
+		t.Log("table is", table, "err is", err)
+		t.Fail()
+	}
+	var zero TreeSubTable[wrapper.Int, string]
+	if err := json.Unmarshal(data, &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestBinaryTreeSubTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice([]wrapper.Int{10, 20, 30}, []string{"a", "b", "c"})
+
+	data, err := table.HeadTable(20, true).MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := table.TailTable(25, true).UnmarshalBinary(data); err == nil || table.Len() != 3 {
+		t.Log("table is", table, "err is", err)
+		t.Fail()
+	}
+}
@@ -1,12 +1,15 @@
 package table
 
 import (
+	"errors"
 	"fmt"
 	"hash/fnv"
 	"math/rand"
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/bound"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/list"
 	"github.com/potex02/structures/tree"
 	"github.com/potex02/structures/util"
@@ -17,24 +20,48 @@ var _ structures.Structure[int] = NewTreeTable[wrapper.Int, int]()
 var _ BaseTable[wrapper.Int, int] = NewTreeTable[wrapper.Int, int]()
 var _ Table[wrapper.Int, int] = NewTreeTable[wrapper.Int, int]()
 
-// TreeTable provides a generic table implemented through a [tree.BinaryTree].
+// TreeTable provides a generic table implemented through a [tree.RedBlackTree].
 // It maintains the order of the keys.
 //
+// The order is determined by the Compare method if the table is created with [NewTreeTable],
+// otherwise it is determined by the comparison function passed to [NewTreeTableFunc].
+//
 // It implements the interface [Table].
-type TreeTable[K util.Comparer, T any] struct {
+type TreeTable[K any, T any] struct {
 	// contains filtered or unexported fields
-	objects *tree.BinaryTree[*Entry[K, T]]
+	objects *tree.RedBlackTree[*Entry[K, T]]
+	compare func(i K, j K) int
 }
 
 // NewTreeTable returns a new empty [TreeTable].
 func NewTreeTable[K util.Comparer, T any]() *TreeTable[K, T] {
-	return &TreeTable[K, T]{objects: tree.NewBinaryTree[*Entry[K, T]]()}
+	return NewTreeTableFunc[K, T](util.Compare[K])
 }
 
 // NewTreeTableFromSlice returns a new [TreeTable] containing the elements of slice c.
 // It panics if key and c have different lengths.
 func NewTreeTableFromSlice[K util.Comparer, T any](key []K, c []T) *TreeTable[K, T] {
-	table := NewTreeTable[K, T]()
+	return NewTreeTableFromSliceFunc(util.Compare[K], key, c)
+}
+
+// NewTreeTableFunc returns a new empty [TreeTable], whose keys are ordered by the compare function.
+//
+// compare must return a negative number if i is placed before j, a positive number if i is placed after j
+// and zero if they are equals.
+func NewTreeTableFunc[K any, T any](compare func(i K, j K) int) *TreeTable[K, T] {
+	return &TreeTable[K, T]{
+		objects: tree.NewRedBlackTreeFunc(func(i *Entry[K, T], j *Entry[K, T]) int {
+			return compare(i.Key(), j.Key())
+		}),
+		compare: compare,
+	}
+}
+
+// NewTreeTableFromSliceFunc returns a new [TreeTable] containing the elements of slice c,
+// whose keys are ordered by the compare function.
+// It panics if key and c have different lengths.
+func NewTreeTableFromSliceFunc[K any, T any](compare func(i K, j K) int, key []K, c []T) *TreeTable[K, T] {
+	table := NewTreeTableFunc[K, T](compare)
 	if len(c) != 0 {
 		table.PutSlice(key, c)
 	}
@@ -91,13 +118,11 @@ func (t *TreeTable[K, T]) Get(key K) (T, bool) {
 
 	var result T
 
-	return result, t.objects.Any(t.objects.Root(), func(i *tree.Node[*Entry[K, T]]) bool {
-		if key.Compare(i.Element().Key()) == 0 {
-			result = i.Element().Element()
-			return true
-		}
-		return false
-	})
+	node := t.objects.Find(NewEntry(key, result))
+	if node == nil {
+		return result, false
+	}
+	return node.Element().Element(), true
 }
 
 // Put set the element e at the key and returns the overwritten value, if present.
@@ -106,18 +131,14 @@ func (t *TreeTable[K, T]) Put(key K, e T) (T, bool) {
 
 	var result T
 
-	found := t.objects.Any(t.objects.Root(), func(i *tree.Node[*Entry[K, T]]) bool {
-		if check := key.Compare(i.Element().Key()); check == 0 {
-			result = i.Element().Element()
-			i.Element().SetElement(e)
-			return true
-		}
-		return false
-	})
-	if !found {
+	node := t.objects.Find(NewEntry(key, result))
+	if node == nil {
 		t.objects.Add(NewEntry(key, e))
+		return result, false
 	}
-	return result, found
+	result = node.Element().Element()
+	node.Element().SetElement(e)
+	return result, true
 }
 
 // PutSlice adds the elements of e at t.
@@ -137,14 +158,116 @@ func (t *TreeTable[K, T]) Remove(key K) (T, bool) {
 
 	var result T
 
-	return result, t.objects.Any(t.objects.Root(), func(i *tree.Node[*Entry[K, T]]) bool {
-		if check := key.Compare(i.Element().Key()); check == 0 {
-			result = i.Element().Element()
-			t.objects.Remove(i.Element())
-			return true
-		}
-		return false
-	})
+	node := t.objects.Find(NewEntry(key, result))
+	if node == nil {
+		return result, false
+	}
+	result = node.Element().Element()
+	t.objects.Remove(node.Element())
+	return result, true
+}
+
+// First returns the min key of t and its associated element.
+// The method returns false if t is empty.
+func (t *TreeTable[K, T]) First() (K, T, bool) {
+	if t.IsEmpty() {
+		return entryOf[K, T](nil)
+	}
+	return entryOf(t.objects.Root().Min())
+}
+
+// Last returns the max key of t and its associated element.
+// The method returns false if t is empty.
+func (t *TreeTable[K, T]) Last() (K, T, bool) {
+	if t.IsEmpty() {
+		return entryOf[K, T](nil)
+	}
+	return entryOf(t.objects.Root().Max())
+}
+
+// PollFirst removes the min key from t and returns the removed key and its associated element.
+// The method returns false if t is empty.
+func (t *TreeTable[K, T]) PollFirst() (K, T, bool) {
+	key, element, ok := t.First()
+	if ok {
+		t.Remove(key)
+	}
+	return key, element, ok
+}
+
+// PollLast removes the max key from t and returns the removed key and its associated element.
+// The method returns false if t is empty.
+func (t *TreeTable[K, T]) PollLast() (K, T, bool) {
+	key, element, ok := t.Last()
+	if ok {
+		t.Remove(key)
+	}
+	return key, element, ok
+}
+
+// Floor returns the greatest key of t less than or equal to key and its associated element.
+// The method returns false if there is no such key.
+func (t *TreeTable[K, T]) Floor(key K) (K, T, bool) {
+	return entryOf(t.objects.Floor(NewEntry(key, *new(T))))
+}
+
+// Ceiling returns the least key of t greater than or equal to key and its associated element.
+// The method returns false if there is no such key.
+func (t *TreeTable[K, T]) Ceiling(key K) (K, T, bool) {
+	return entryOf(t.objects.Ceiling(NewEntry(key, *new(T))))
+}
+
+// Lower returns the greatest key of t strictly less than key and its associated element.
+// The method returns false if there is no such key.
+func (t *TreeTable[K, T]) Lower(key K) (K, T, bool) {
+	return entryOf(t.objects.Lower(NewEntry(key, *new(T))))
+}
+
+// Higher returns the least key of t strictly greater than key and its associated element.
+// The method returns false if there is no such key.
+func (t *TreeTable[K, T]) Higher(key K) (K, T, bool) {
+	return entryOf(t.objects.Higher(NewEntry(key, *new(T))))
+}
+
+// SubTable returns a [TreeSubTable] containing the keys of t between from and to, with their associated elements.
+// fromInclusive and toInclusive indicate if from and to are part of the range.
+//
+// The result is a view of t, so the modifications of one table are reflected on the other,
+// also for the keys added at t after the creation of the view.
+// The view does not copy the entries, so this method runs in O(1) time.
+func (t *TreeTable[K, T]) SubTable(from K, fromInclusive bool, to K, toInclusive bool) *TreeSubTable[K, T] {
+	return &TreeSubTable[K, T]{table: t, bounds: t.bounds().From(from, fromInclusive).To(to, toInclusive)}
+}
+
+// HeadTable returns a [TreeSubTable] containing the keys of t less than to,
+// or equal to it if inclusive is true, with their associated elements.
+//
+// The result is a view of t, so the modifications of one table are reflected on the other.
+func (t *TreeTable[K, T]) HeadTable(to K, inclusive bool) *TreeSubTable[K, T] {
+	return &TreeSubTable[K, T]{table: t, bounds: t.bounds().To(to, inclusive)}
+}
+
+// TailTable returns a [TreeSubTable] containing the keys of t greater than from,
+// or equal to it if inclusive is true, with their associated elements.
+//
+// The result is a view of t, so the modifications of one table are reflected on the other.
+func (t *TreeTable[K, T]) TailTable(from K, inclusive bool) *TreeSubTable[K, T] {
+	return &TreeSubTable[K, T]{table: t, bounds: t.bounds().From(from, inclusive)}
+}
+
+// Rank returns the number of keys of t strictly less than key.
+//
+// This method runs in O(log n) time.
+func (t *TreeTable[K, T]) Rank(key K) int {
+	return t.objects.Rank(NewEntry(key, *new(T)))
+}
+
+// Select returns the k-th smallest key of t, starting from 0, and its associated element.
+// The method returns false if k is out of bounds.
+//
+// This method runs in O(log n) time.
+func (t *TreeTable[K, T]) Select(k int) (K, T, bool) {
+	return entryOf(t.objects.Select(k))
 }
 
 // Each executes fun for all elements of t.
@@ -158,7 +281,9 @@ func (t *TreeTable[K, T]) Each(fun func(key K, element T)) {
 
 // Stream returns a [Stream] rapresenting t.
 func (t *TreeTable[K, T]) Stream() *Stream[K, T] {
-	return NewStream[K, T](t, reflect.ValueOf(NewTreeTable[K, T]))
+	return NewStream[K, T](t, reflect.ValueOf(func() *TreeTable[K, T] {
+		return NewTreeTableFunc[K, T](t.compare)
+	}))
 }
 
 // Clear removes all element from t.
@@ -202,20 +327,7 @@ func (t *TreeTable[K, T]) RangeIter() func(yield func(K, T) bool) {
 func (t *TreeTable[K, T]) Equal(st any) bool {
 	table, ok := st.(Table[K, T])
 	if ok && t != nil && table != nil {
-		if t.Len() != table.Len() {
-			return false
-		}
-		for i := t.Keys().Iter(); !i.End(); i = i.Next() {
-			e1, _ := t.Get(i.Element())
-			other, found := table.Get(i.Element())
-			if !found {
-				return false
-			}
-			if !util.EqualFunction(e1)(other) {
-				return false
-			}
-		}
-		return true
+		return equalTables[K, T](t, table)
 	}
 	return false
 }
@@ -227,13 +339,7 @@ func (t *TreeTable[K, T]) Equal(st any) bool {
 func (t *TreeTable[K, T]) Compare(st any) int {
 	table, ok := st.(Table[K, T])
 	if ok && t != nil && table != nil {
-		if t.Len() < table.Len() {
-			return -1
-		}
-		if t.Len() > table.Len() {
-			return 1
-		}
-		return 0
+		return compareTables[K, T](t, table)
 	}
 	return -2
 }
@@ -254,7 +360,7 @@ func (t *TreeTable[K, T]) Hash() uint64 {
 func (t *TreeTable[K, T]) Copy() Table[K, T] {
 	slice := t.objects.ToSlice()
 	rand.Shuffle(len(slice), func(i, j int) { slice[i], slice[j] = slice[j], slice[i] })
-	result := NewTreeTable[K, T]()
+	result := NewTreeTableFunc[K, T](t.compare)
 	for _, i := range slice {
 		result.Put(i.Key(), util.Copy(i.Element()))
 	}
@@ -263,16 +369,77 @@ func (t *TreeTable[K, T]) Copy() Table[K, T] {
 
 // String returns a rapresentation of t in the form of a string.
 func (t *TreeTable[K, T]) String() string {
-	check := []string{reflect.TypeOf(new(K)).String(), reflect.TypeOf(new(T)).String()}
-	result := fmt.Sprintf("TreeTable[%v, %v][", check[0][1:], check[1][1:])
-	first := true
-	t.objects.Each(t.objects.Root(), func(i *tree.Node[*Entry[K, T]]) {
-		if !first {
-			result += ", "
+	return tableString[K, T]("TreeTable", t)
+}
+
+// MarshalJSON returns the JSON encoding of t.
+//
+// t is encoded as a JSON object if the keys are strings or integers, otherwise it is encoded as an array of [key, element] pairs.
+// In both cases, the entries are in ascending order of the keys.
+func (t *TreeTable[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(t.RangeIter())
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from data.
+//
+// data can be both a JSON object and an array of [key, element] pairs.
+//
+// If t is the zero value, the keys are ordered by their Compare method.
+// In this case, the method returns an error if K does not implement [util.Comparer].
+func (t *TreeTable[K, T]) UnmarshalJSON(data []byte) error {
+	return decodeEntries(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its entries as key and element pairs in ascending order of the keys.
+func (t *TreeTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+//
+// If t is the zero value, the keys are ordered by their Compare method.
+// In this case, the method returns an error if K does not implement [util.Comparer].
+func (t *TreeTable[K, T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinaryEntries(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [TreeTable.MarshalBinary].
+func (t *TreeTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [TreeTable.UnmarshalBinary].
+func (t *TreeTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the entries of t with the keys key and the elements c.
+func (t *TreeTable[K, T]) decode(key []K, c []T) error {
+	if t.objects == nil {
+		compare := codec.Compare[K]()
+		if compare == nil {
+			return errors.New("Cannot decode a TreeTable whose keys do not implement util.Comparer")
 		}
-		result += fmt.Sprintf("%v: %v", i.Element().Key(), i.Element().Element())
-		first = false
+		*t = *NewTreeTableFunc[K, T](compare)
+	}
+	t.Clear()
+	t.PutSlice(key, c)
+	return nil
+}
+
+func (t *TreeTable[K, T]) bounds() bound.Range[*Entry[K, T], K] {
+	return bound.New(t.objects, t.compare, (*Entry[K, T]).Key, func(key K) *Entry[K, T] {
+		return NewEntry(key, *new(T))
 	})
-	result += "]"
-	return result
+}
+
+func entryOf[K any, T any](node *tree.Node[*Entry[K, T]]) (K, T, bool) {
+	if node == nil {
+
+		var key K
+		var element T
+
+		return key, element, false
+	}
+	return node.Element().Key(), node.Element().Element(), true
 }
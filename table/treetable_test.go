@@ -1,6 +1,9 @@
 package table
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/potex02/structures"
@@ -44,6 +47,35 @@ func TestNewTreeTableFromSlice(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestNewTreeTableFunc(t *testing.T) {
+
+	var table *TreeTable[string, float32] = NewTreeTableFromSliceFunc(
+		strings.Compare,
+		[]string{"Hello", "Ciao", "Hola"},
+		[]float32{1.2, 5.6, -3},
+	)
+
+	if table.Len() != 3 {
+		t.Log("length is not 3")
+		t.Fail()
+	}
+	if !reflect.DeepEqual(table.Keys().ToSlice(), []string{"Ciao", "Hello", "Hola"}) {
+		t.Log("keys are", table.Keys())
+		t.Fail()
+	}
+	if e, ok := table.Put("Ciao", 0.5); !ok || e != 5.6 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := table.Remove("Hola"); !ok || e != -3 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := table.Get("Ciao"); !ok || e != 0.5 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+}
 func TestContainsKeyTreeTable(t *testing.T) {
 
 	var table Table[wrapper.String, float32] = NewTreeTableFromSlice(
@@ -239,3 +271,153 @@ func TestCompareTreeTable(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestNavigationTreeTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice(
+		[]wrapper.Int{10, 20, 30, 40},
+		[]string{"a", "b", "c", "d"},
+	)
+
+	if key, e, ok := table.Floor(25); !ok || key != 20 || e != "b" {
+		t.Log("floor is", key, e)
+		t.Fail()
+	}
+	if key, e, ok := table.Ceiling(30); !ok || key != 30 || e != "c" {
+		t.Log("ceiling is", key, e)
+		t.Fail()
+	}
+	if key, _, ok := table.Lower(10); ok {
+		t.Log("lower is", key)
+		t.Fail()
+	}
+	if key, e, ok := table.Higher(30); !ok || key != 40 || e != "d" {
+		t.Log("higher is", key, e)
+		t.Fail()
+	}
+	if key, e, ok := table.PollFirst(); !ok || key != 10 || e != "a" {
+		t.Log("first is", key, e)
+		t.Fail()
+	}
+	if key, e, ok := table.PollLast(); !ok || key != 40 || e != "d" {
+		t.Log("last is", key, e)
+		t.Fail()
+	}
+	if table.Len() != 2 || table.ContainsKey(10) || table.ContainsKey(40) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestSubTableTreeTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice(
+		[]wrapper.Int{10, 20, 30, 40, 50},
+		[]string{"a", "b", "c", "d", "e"},
+	)
+
+	if slice := table.SubTable(20, true, 40, false).Elements().ToSlice(); !reflect.DeepEqual(slice, []string{"b", "c"}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if slice := table.HeadTable(30, false).Elements().ToSlice(); !reflect.DeepEqual(slice, []string{"a", "b"}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if slice := table.TailTable(30, true).Keys().ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{30, 40, 50}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	sub := table.TailTable(45, true)
+	sub.Put(50, "f")
+	if e, _ := table.Get(50); e != "f" {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	table.Put(60, "g")
+	if e, ok := sub.Get(60); !ok || e != "g" {
+		t.Log("element is", e)
+		t.Fail()
+	}
+}
+func TestRankTreeTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice(
+		[]wrapper.Int{30, 10, 20},
+		[]string{"c", "a", "b"},
+	)
+
+	if rank := table.Rank(25); rank != 2 {
+		t.Log("rank is", rank)
+		t.Fail()
+	}
+	if key, e, ok := table.Select(0); !ok || key != 10 || e != "a" {
+		t.Log("entry is", key, e)
+		t.Fail()
+	}
+	if _, _, ok := table.Select(3); ok {
+		t.Log("found entry 3")
+		t.Fail()
+	}
+	j := 0
+	for i := table.Iter(); !i.End(); {
+		if index := i.(IndexedIterator[wrapper.Int, string]).Index(); index != j {
+			t.Log("index of", i.Key(), "is", index)
+			t.Fail()
+		}
+		if i.Key() == 10 {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+			j++
+		}
+	}
+}
+func TestJSONTreeTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice([]wrapper.Int{3, 1, 2}, []string{"c", "a", "b"})
+	var pairs *TreeTable[[2]int, int] = NewTreeTableFunc[[2]int, int](func(i [2]int, j [2]int) int {
+		return (i[0]-j[0])*10 + i[1] - j[1]
+	})
+
+	data, err := json.Marshal(table)
+	if err != nil || string(data) != `{"1":"a","2":"b","3":"c"}` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result TreeTable[wrapper.Int, string]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(table) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	pairs.Put([2]int{1, 2}, 3)
+	pairs.Put([2]int{0, 1}, 1)
+	data, err = json.Marshal(pairs)
+	if err != nil || string(data) != `[[[0,1],1],[[1,2],3]]` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	pairs.Clear()
+	if err := json.Unmarshal(data, pairs); err != nil || pairs.Len() != 2 {
+		t.Log("pairs are", pairs, "err is", err)
+		t.Fail()
+	}
+	var zero TreeTable[[2]int, int]
+	if err := json.Unmarshal(data, &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestBinaryTreeTable(t *testing.T) {
+
+	var table *TreeTable[wrapper.Int, string] = NewTreeTableFromSlice([]wrapper.Int{3, 1, 2}, []string{"c", "a", "b"})
+	var pairs TreeTable[[2]int, int]
+
+	data, err := table.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := pairs.UnmarshalBinary(data); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
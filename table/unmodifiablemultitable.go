@@ -0,0 +1,202 @@
+package table
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = UnmodifiableMulti[wrapper.Int, int](NewMultiHashTable[wrapper.Int, int]())
+var _ MultiTable[wrapper.Int, int] = UnmodifiableMulti[wrapper.Int, int](NewMultiHashTable[wrapper.Int, int]())
+
+// UnmodifiableMultiTable provides a read-only view of a [MultiTable].
+//
+// The view is not a copy: all the changes made on the wrapped table are visible through it.
+// The methods which modify the table panic with [structures.ErrUnmodifiable].
+//
+// It implements the interface [MultiTable].
+type UnmodifiableMultiTable[K any, T any] struct {
+	// contains filtered or unexported fields
+	table MultiTable[K, T]
+}
+
+// UnmodifiableMulti returns a new [UnmodifiableMultiTable] associated at the table t.
+//
+// If t is already an [UnmodifiableMultiTable], it is returned unchanged.
+func UnmodifiableMulti[K any, T any](t MultiTable[K, T]) *UnmodifiableMultiTable[K, T] {
+	if table, ok := t.(*UnmodifiableMultiTable[K, T]); ok {
+		return table
+	}
+	return &UnmodifiableMultiTable[K, T]{table: t}
+}
+
+// Len returns the length of t.
+func (t *UnmodifiableMultiTable[K, T]) Len() int {
+	return t.table.Len()
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *UnmodifiableMultiTable[K, T]) IsEmpty() bool {
+	return t.table.IsEmpty()
+}
+
+// ContainsKey returns true if the key is present in t.
+func (t *UnmodifiableMultiTable[K, T]) ContainsKey(key K) bool {
+	return t.table.ContainsKey(key)
+}
+
+// ContainsElement returns true if the element e is present in t.
+func (t *UnmodifiableMultiTable[K, T]) ContainsElement(e T) bool {
+	return t.table.ContainsElement(e)
+}
+
+// Contains returns true if the key is present in t associated with the element e.
+func (t *UnmodifiableMultiTable[K, T]) Contains(key K, e T) bool {
+	return t.table.Contains(key, e)
+}
+
+// Keys returns a [list.List] which contains all keys of t.
+func (t *UnmodifiableMultiTable[K, T]) Keys() list.List[K] {
+	return t.table.Keys()
+}
+
+// Elements returns a [list.List] which contains all elements of t.
+func (t *UnmodifiableMultiTable[K, T]) Elements() list.List[T] {
+	return t.table.Elements()
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *UnmodifiableMultiTable[K, T]) ToSlice() []T {
+	return t.table.ToSlice()
+}
+
+// Get returns a slice cotaining the elements associated at the key.
+func (t *UnmodifiableMultiTable[K, T]) Get(key K) []T {
+	return t.table.Get(key)
+}
+
+// Put always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableMultiTable[K, T]) Put(key K, e ...T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// PutSlice always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableMultiTable[K, T]) PutSlice(key []K, e []T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Replace always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableMultiTable[K, T]) Replace(key K, e ...T) []T {
+	panic(structures.ErrUnmodifiable)
+}
+
+// ReplaceSlice always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableMultiTable[K, T]) ReplaceSlice(key []K, e []T) []T {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Remove always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableMultiTable[K, T]) Remove(key K, e T) bool {
+	panic(structures.ErrUnmodifiable)
+}
+
+// RemoveKey always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableMultiTable[K, T]) RemoveKey(key K) []T {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Each executes fun for all elements of t.
+func (t *UnmodifiableMultiTable[K, T]) Each(fun func(key K, element T)) {
+	t.table.Each(fun)
+}
+
+// Stream returns a [Stream] rapresenting t.
+func (t *UnmodifiableMultiTable[K, T]) Stream() *Stream[K, T] {
+	return t.table.Stream()
+}
+
+// Clear always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableMultiTable[K, T]) Clear() {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Iter returns an [Iterator] which permits to iterate t.
+// The Remove method of the iterator panics with [structures.ErrUnmodifiable].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		key := i.Key()
+//		element := i.Element()
+//		// Code
+//	}
+func (t *UnmodifiableMultiTable[K, T]) Iter() Iterator[K, T] {
+	return NewUnmodifiableTableIterator(t.table.Iter())
+}
+
+// RangeIter returns a function that allows to iterate t using the range keyword.
+//
+//	for i, j := range t.RangeIter() {
+//		// Code
+//	}
+func (t *UnmodifiableMultiTable[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return t.table.RangeIter()
+}
+
+// Equal returns true if the wrapped table is equal to st.
+// In any other case, it returns false.
+func (t *UnmodifiableMultiTable[K, T]) Equal(st any) bool {
+	return t.table.Equal(st)
+}
+
+// Compare returns the comparison between the wrapped table and st.
+func (t *UnmodifiableMultiTable[K, T]) Compare(st any) int {
+	return t.table.Compare(st)
+}
+
+// Hash returns the hash code of t.
+func (t *UnmodifiableMultiTable[K, T]) Hash() uint64 {
+	return t.table.Hash()
+}
+
+// Copy returns a modifiable copy of the wrapped table.
+func (t *UnmodifiableMultiTable[K, T]) Copy() MultiTable[K, T] {
+	return t.table.Copy()
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *UnmodifiableMultiTable[K, T]) String() string {
+	return fmt.Sprintf("Unmodifiable%v", t.table)
+}
+
+// MarshalJSON returns the JSON encoding of the wrapped table.
+func (t *UnmodifiableMultiTable[K, T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.table)
+}
+
+// UnmarshalJSON always returns [structures.ErrUnmodifiable].
+func (t *UnmodifiableMultiTable[K, T]) UnmarshalJSON(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// MarshalBinary returns the binary encoding of t, which is the same of a multitable with its entries.
+func (t *UnmodifiableMultiTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.table.Len(), t.table.RangeIter())
+}
+
+// UnmarshalBinary always returns [structures.ErrUnmodifiable].
+func (t *UnmodifiableMultiTable[K, T]) UnmarshalBinary(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// GobEncode returns the binary encoding of t as [UnmodifiableMultiTable.MarshalBinary].
+func (t *UnmodifiableMultiTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [UnmodifiableMultiTable.UnmarshalBinary].
+func (t *UnmodifiableMultiTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
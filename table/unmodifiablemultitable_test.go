@@ -0,0 +1,96 @@
+package table
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewUnmodifiableMultiTable(t *testing.T) {
+
+	var wrapped *MultiTreeTable[wrapper.Int, int] = NewMultiTreeTableFromSlice([]wrapper.Int{1, 1, 2}, []int{10, 11, 20})
+	var table ReadOnlyMultiTable[wrapper.Int, int] = UnmodifiableMulti[wrapper.Int, int](wrapped)
+
+	if table.Len() != 3 || table.IsEmpty() {
+		t.Log("length is", table.Len())
+		t.Fail()
+	}
+	if table.String() != "UnmodifiableMultiTreeTable[wrapper.Int, int][1: 10, 1: 11, 2: 20]" {
+		t.Log("table is", table)
+		t.Fail()
+	}
+	if view := UnmodifiableMulti[wrapper.Int, int](wrapped); UnmodifiableMulti[wrapper.Int, int](view) != view {
+		t.Log("table has been wrapped twice")
+		t.Fail()
+	}
+	if !table.Equal(wrapped) || !wrapped.Equal(table) || table.Hash() != wrapped.Hash() {
+		t.Log("tables are not equals")
+		t.Fail()
+	}
+	wrapped.Put(2, 21)
+	if e := table.Get(2); !slices.Equal(e, []int{20, 21}) || !table.Contains(2, 21) || !table.ContainsElement(21) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+	result := UnmodifiableMulti[wrapper.Int, int](wrapped).Copy()
+	result.Put(3, 30)
+	if table.Len() != 4 || result.Len() != 5 {
+		t.Log("copy is", result)
+		t.Fail()
+	}
+}
+func TestModifyUnmodifiableMultiTable(t *testing.T) {
+
+	var table *UnmodifiableMultiTable[wrapper.Int, int] = UnmodifiableMulti[wrapper.Int, int](NewMultiHashTableFromSlice([]wrapper.Int{1, 1}, []int{10, 11}))
+
+	for _, i := range []func(){
+		func() { table.Put(3, 30) },
+		func() { table.PutSlice([]wrapper.Int{3}, []int{30}) },
+		func() { table.Replace(1, 12) },
+		func() { table.ReplaceSlice([]wrapper.Int{1}, []int{12}) },
+		func() { table.Remove(1, 10) },
+		func() { table.RemoveKey(1) },
+		func() { table.Clear() },
+		func() { table.Iter().Remove() },
+	} {
+		if !checkUnmodifiable(i) {
+			t.Log("table has been modified")
+			t.Fail()
+		}
+	}
+	if table.Len() != 2 {
+		t.Log("table is", table)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte("{}"), table); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestIterUnmodifiableMultiTable(t *testing.T) {
+
+	var table *UnmodifiableMultiTable[wrapper.Int, int] = UnmodifiableMulti[wrapper.Int, int](NewMultiTreeTableFromSlice([]wrapper.Int{1, 1, 2}, []int{10, 11, 20}))
+
+	keys := make([]wrapper.Int, 0)
+	for i := table.Iter(); !i.End(); i = i.Next() {
+		keys = append(keys, i.Key())
+	}
+	if !slices.Equal(keys, []wrapper.Int{1, 1, 2}) {
+		t.Log("keys are", keys)
+		t.Fail()
+	}
+	result := NewMultiHashTable[wrapper.Int, int]()
+	data, err := table.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !result.Equal(table) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+}
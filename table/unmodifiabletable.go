@@ -0,0 +1,186 @@
+package table
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = Unmodifiable[wrapper.Int, int](NewHashTable[wrapper.Int, int]())
+var _ Table[wrapper.Int, int] = Unmodifiable[wrapper.Int, int](NewHashTable[wrapper.Int, int]())
+
+// UnmodifiableTable provides a read-only view of a [Table].
+//
+// The view is not a copy: all the changes made on the wrapped table are visible through it.
+// The methods which modify the table panic with [structures.ErrUnmodifiable].
+//
+// It implements the interface [Table].
+type UnmodifiableTable[K any, T any] struct {
+	// contains filtered or unexported fields
+	table Table[K, T]
+}
+
+// Unmodifiable returns a new [UnmodifiableTable] associated at the table t.
+//
+// If t is already an [UnmodifiableTable], it is returned unchanged.
+func Unmodifiable[K any, T any](t Table[K, T]) *UnmodifiableTable[K, T] {
+	if table, ok := t.(*UnmodifiableTable[K, T]); ok {
+		return table
+	}
+	return &UnmodifiableTable[K, T]{table: t}
+}
+
+// Len returns the length of t.
+func (t *UnmodifiableTable[K, T]) Len() int {
+	return t.table.Len()
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *UnmodifiableTable[K, T]) IsEmpty() bool {
+	return t.table.IsEmpty()
+}
+
+// ContainsKey returns true if the key is present in t.
+func (t *UnmodifiableTable[K, T]) ContainsKey(key K) bool {
+	return t.table.ContainsKey(key)
+}
+
+// ContainsElement returns true if the element e is present in t.
+func (t *UnmodifiableTable[K, T]) ContainsElement(e T) bool {
+	return t.table.ContainsElement(e)
+}
+
+// Keys returns a [list.List] which contains all keys of t.
+func (t *UnmodifiableTable[K, T]) Keys() list.List[K] {
+	return t.table.Keys()
+}
+
+// Elements returns a [list.List] which contains all elements of t.
+func (t *UnmodifiableTable[K, T]) Elements() list.List[T] {
+	return t.table.Elements()
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *UnmodifiableTable[K, T]) ToSlice() []T {
+	return t.table.ToSlice()
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+func (t *UnmodifiableTable[K, T]) Get(key K) (T, bool) {
+	return t.table.Get(key)
+}
+
+// Put always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableTable[K, T]) Put(key K, e T) (T, bool) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// PutSlice always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableTable[K, T]) PutSlice(key []K, e []T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Remove always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableTable[K, T]) Remove(key K) (T, bool) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Each executes fun for all elements of t.
+func (t *UnmodifiableTable[K, T]) Each(fun func(key K, element T)) {
+	t.table.Each(fun)
+}
+
+// Stream returns a [Stream] rapresenting t.
+func (t *UnmodifiableTable[K, T]) Stream() *Stream[K, T] {
+	return t.table.Stream()
+}
+
+// Clear always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableTable[K, T]) Clear() {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Iter returns an [Iterator] which permits to iterate t.
+// The Remove method of the iterator panics with [structures.ErrUnmodifiable].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		key := i.Key()
+//		element := i.Element()
+//		// Code
+//	}
+func (t *UnmodifiableTable[K, T]) Iter() Iterator[K, T] {
+	return NewUnmodifiableTableIterator(t.table.Iter())
+}
+
+// RangeIter returns a function that allows to iterate t using the range keyword.
+//
+//	for i, j := range t.RangeIter() {
+//		// Code
+//	}
+func (t *UnmodifiableTable[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return t.table.RangeIter()
+}
+
+// Equal returns true if t and st are both tables and their elements are equals.
+// In any other case, it returns false.
+func (t *UnmodifiableTable[K, T]) Equal(st any) bool {
+	return t.table.Equal(st)
+}
+
+// Compare returns 0 if t and st have the same length,
+// -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [Table] or if one between t and st is nil.
+func (t *UnmodifiableTable[K, T]) Compare(st any) int {
+	return t.table.Compare(st)
+}
+
+// Hash returns the hash code of t.
+func (t *UnmodifiableTable[K, T]) Hash() uint64 {
+	return t.table.Hash()
+}
+
+// Copy returns a modifiable copy of the wrapped table.
+func (t *UnmodifiableTable[K, T]) Copy() Table[K, T] {
+	return t.table.Copy()
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *UnmodifiableTable[K, T]) String() string {
+	return fmt.Sprintf("Unmodifiable%v", t.table)
+}
+
+// MarshalJSON returns the JSON encoding of the wrapped table.
+func (t *UnmodifiableTable[K, T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.table)
+}
+
+// UnmarshalJSON always returns [structures.ErrUnmodifiable].
+func (t *UnmodifiableTable[K, T]) UnmarshalJSON(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// MarshalBinary returns the binary encoding of t, which is the same of a table with its entries.
+func (t *UnmodifiableTable[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.table.Len(), t.table.RangeIter())
+}
+
+// UnmarshalBinary always returns [structures.ErrUnmodifiable].
+func (t *UnmodifiableTable[K, T]) UnmarshalBinary(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// GobEncode returns the binary encoding of t as [UnmodifiableTable.MarshalBinary].
+func (t *UnmodifiableTable[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [UnmodifiableTable.UnmarshalBinary].
+func (t *UnmodifiableTable[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
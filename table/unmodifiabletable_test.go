@@ -0,0 +1,140 @@
+package table
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func checkUnmodifiable(fun func()) (result bool) {
+	defer func() {
+		err, ok := recover().(error)
+		result = ok && errors.Is(err, structures.ErrUnmodifiable)
+	}()
+	fun()
+	return false
+}
+func TestNewUnmodifiableTable(t *testing.T) {
+
+	var wrapped *TreeTable[wrapper.Int, int] = NewTreeTableFromSlice([]wrapper.Int{1, 2}, []int{10, 20})
+	var table Table[wrapper.Int, int] = Unmodifiable[wrapper.Int, int](wrapped)
+
+	if table.Len() != 2 || table.IsEmpty() {
+		t.Log("length is", table.Len())
+		t.Fail()
+	}
+	if table.String() != "UnmodifiableTreeTable[wrapper.Int, int][1: 10, 2: 20]" {
+		t.Log("table is", table)
+		t.Fail()
+	}
+	if Unmodifiable(table) != table {
+		t.Log("table has been wrapped twice")
+		t.Fail()
+	}
+	if !table.Equal(wrapped) || !wrapped.Equal(table) || table.Compare(wrapped) != 0 || table.Hash() != wrapped.Hash() {
+		t.Log("tables are not equals")
+		t.Fail()
+	}
+	wrapped.Put(3, 30)
+	if e, ok := table.Get(3); !ok || e != 30 || !table.ContainsKey(3) || !table.ContainsElement(30) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+	if !slices.Equal(table.Keys().ToSlice(), []wrapper.Int{1, 2, 3}) || !slices.Equal(table.Elements().ToSlice(), []int{10, 20, 30}) {
+		t.Log("table is", table)
+		t.Fail()
+	}
+	result := table.Copy()
+	result.Put(4, 40)
+	if table.Len() != 3 || result.Len() != 4 {
+		t.Log("copy is", result)
+		t.Fail()
+	}
+}
+func TestModifyUnmodifiableTable(t *testing.T) {
+
+	var table *UnmodifiableTable[wrapper.Int, int] = Unmodifiable[wrapper.Int, int](NewHashTableFromSlice([]wrapper.Int{1, 2}, []int{10, 20}))
+
+	for _, i := range []func(){
+		func() { table.Put(3, 30) },
+		func() { table.PutSlice([]wrapper.Int{3}, []int{30}) },
+		func() { table.Remove(1) },
+		func() { table.Clear() },
+		func() { table.Iter().Remove() },
+	} {
+		if !checkUnmodifiable(i) {
+			t.Log("table has been modified")
+			t.Fail()
+		}
+	}
+	if table.Len() != 2 {
+		t.Log("table is", table)
+		t.Fail()
+	}
+}
+func TestIterUnmodifiableTable(t *testing.T) {
+
+	var table *UnmodifiableTable[wrapper.Int, int] = Unmodifiable[wrapper.Int, int](NewTreeTableFromSlice([]wrapper.Int{1, 2, 3}, []int{10, 20, 30}))
+
+	keys := make([]wrapper.Int, 0)
+	for i := table.Iter(); !i.End(); i = i.Next() {
+		if int(i.Key())*10 != i.Element() {
+			t.Log("key is", i.Key(), "element is", i.Element())
+			t.Fail()
+		}
+		keys = append(keys, i.Key())
+	}
+	if !slices.Equal(keys, []wrapper.Int{1, 2, 3}) {
+		t.Log("keys are", keys)
+		t.Fail()
+	}
+	elements := make([]int, 0)
+	for _, i := range table.RangeIter() {
+		elements = append(elements, i)
+	}
+	if !slices.Equal(elements, []int{10, 20, 30}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+	if !Unmodifiable[wrapper.Int, int](NewHashTable[wrapper.Int, int]()).Iter().End() {
+		t.Log("iterator is not ended")
+		t.Fail()
+	}
+}
+func TestJSONUnmodifiableTable(t *testing.T) {
+
+	var table *UnmodifiableTable[wrapper.Int, int] = Unmodifiable[wrapper.Int, int](NewTreeTableFromSlice([]wrapper.Int{1, 2}, []int{10, 20}))
+
+	data, err := json.Marshal(table)
+	if err != nil || string(data) != `{"1":10,"2":20}` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal(data, table); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestBinaryUnmodifiableTable(t *testing.T) {
+
+	var table *UnmodifiableTable[wrapper.Int, int] = Unmodifiable[wrapper.Int, int](NewTreeTableFromSlice([]wrapper.Int{1, 2}, []int{10, 20}))
+	var result *TreeTable[wrapper.Int, int] = NewTreeTable[wrapper.Int, int]()
+
+	data, err := table.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !result.Equal(table) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if err := table.UnmarshalBinary(data); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
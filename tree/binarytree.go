@@ -1,11 +1,13 @@
 package tree
 
 import (
+	"errors"
 	"fmt"
 	"hash/fnv"
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/util"
 	"github.com/potex02/structures/util/wrapper"
 )
@@ -15,13 +17,15 @@ var _ Tree[wrapper.Int] = NewBinaryTree[wrapper.Int]()
 
 // BinaryTree provides a generic binary search tree.
 //
-// the type T of the tree must implement [util.Comparer].
+// The order of the elements is determined by the Compare method if the tree is created with [NewBinaryTree],
+// otherwise it is determined by the comparison function passed to [NewBinaryTreeFunc].
 //
 // It implements the interface [Tree].
-type BinaryTree[T util.Comparer] struct {
+type BinaryTree[T any] struct {
 	// contains filtered or unexported fields
-	root *Node[T]
-	len  int
+	root    *Node[T]
+	len     int
+	compare func(i T, j T) int
 }
 
 // NewBinaryTree returns a new [BinaryTree] containing the elements c.
@@ -33,7 +37,24 @@ func NewBinaryTree[T util.Comparer](c ...T) *BinaryTree[T] {
 
 // NewBinaryTreeFromSlice returns a new [BinaryTree] containing the elements of slice c.
 func NewBinaryTreeFromSlice[T util.Comparer](c []T) *BinaryTree[T] {
-	tree := &BinaryTree[T]{root: nil, len: 0}
+	return NewBinaryTreeFromSliceFunc(util.Compare[T], c)
+}
+
+// NewBinaryTreeFunc returns a new [BinaryTree] containing the elements c,
+// whose order is determined by the compare function.
+//
+// compare must return a negative number if i is placed before j, a positive number if i is placed after j
+// and zero if they are equals.
+//
+// if no extra argument is passed, it will be created an empty [BinaryTree].
+func NewBinaryTreeFunc[T any](compare func(i T, j T) int, c ...T) *BinaryTree[T] {
+	return NewBinaryTreeFromSliceFunc(compare, c)
+}
+
+// NewBinaryTreeFromSliceFunc returns a new [BinaryTree] containing the elements of slice c,
+// whose order is determined by the compare function.
+func NewBinaryTreeFromSliceFunc[T any](compare func(i T, j T) int, c []T) *BinaryTree[T] {
+	tree := &BinaryTree[T]{root: nil, len: 0, compare: compare}
 	if len(c) != 0 {
 		tree.AddSlice(c)
 	}
@@ -85,7 +106,7 @@ func (t *BinaryTree[T]) AddSlice(e []T) {
 // In that case, the method returns true.
 func (t *BinaryTree[T]) Remove(e T) bool {
 	return t.Any(t.root, func(i *Node[T]) bool {
-		if e.Compare(i.Element()) == 0 {
+		if t.compare(e, i.Element()) == 0 {
 			t.remove(i)
 			return true
 		}
@@ -125,7 +146,7 @@ func (t *BinaryTree[T]) Each(node *Node[T], fun func(i *Node[T])) {
 // node is the root node of the subtree,
 // fun is the function to be executed.
 func (t *BinaryTree[T]) Map(node *Node[T], fun func(i *Node[T]) T) *BinaryTree[T] {
-	result := NewBinaryTree[T]()
+	result := NewBinaryTreeFunc(t.compare)
 	t.Each(t.root, func(i *Node[T]) {
 		result.add(fun(i))
 	})
@@ -137,7 +158,7 @@ func (t *BinaryTree[T]) Map(node *Node[T], fun func(i *Node[T]) T) *BinaryTree[T
 // node is the root node of the subtree,
 // fun is the function to be executed.
 func (t *BinaryTree[T]) Filter(node *Node[T], fun func(i *Node[T]) bool) *BinaryTree[T] {
-	result := NewBinaryTree[T]()
+	result := NewBinaryTreeFunc(t.compare)
 	t.Each(t.root, func(i *Node[T]) {
 		if fun(i) {
 			result.add(i.Element())
@@ -151,7 +172,7 @@ func (t *BinaryTree[T]) Filter(node *Node[T], fun func(i *Node[T]) bool) *Binary
 // node is the root node of the subtree,
 // fun is the function to be executed.
 func (t *BinaryTree[T]) FilterMap(node *Node[T], fun func(i *Node[T]) (T, bool)) *BinaryTree[T] {
-	result := NewBinaryTree[T]()
+	result := NewBinaryTreeFunc(t.compare)
 	t.Each(t.root, func(i *Node[T]) {
 		if element, ok := fun(i); ok {
 			result.add(element)
@@ -294,7 +315,7 @@ func (t *BinaryTree[T]) Compare(st any) int {
 		result := 0
 		t.All(t.root, func(i *Node[T]) bool {
 			j++
-			result = i.Element().Compare(others[j-1])
+			result = t.compare(i.Element(), others[j-1])
 			return result == 0
 		})
 		return result
@@ -319,11 +340,61 @@ func (t *BinaryTree[T]) String() string {
 	return fmt.Sprintf("BinaryTree[%v]%v", check[1:], objects)
 }
 
+// MarshalJSON returns the JSON encoding of t, which is an array containing its elements in ascending order.
+func (t *BinaryTree[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(t.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from the JSON array data.
+// The elements are added in the order of the array.
+//
+// If t is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (t *BinaryTree[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its elements in ascending order.
+func (t *BinaryTree[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(t.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+// The elements are added in the order in which they are decoded.
+//
+// If t is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (t *BinaryTree[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [BinaryTree.MarshalBinary].
+func (t *BinaryTree[T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [BinaryTree.UnmarshalBinary].
+func (t *BinaryTree[T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of t with objects.
+func (t *BinaryTree[T]) decode(objects []T) error {
+	if t.compare == nil {
+		if t.compare = codec.Compare[T](); t.compare == nil {
+			return errors.New("Cannot decode a BinaryTree whose elements do not implement util.Comparer")
+		}
+	}
+	t.Clear()
+	t.AddSlice(objects)
+	return nil
+}
+
 func (t *BinaryTree[T]) contains(node *Node[T], e T) bool {
 	if node == nil {
 		return false
 	}
-	check := e.Compare(node.Element())
+	check := t.compare(e, node.Element())
 	if check == 0 {
 		return true
 	}
@@ -363,7 +434,7 @@ func (t *BinaryTree[T]) addRight(parent *Node[T], e T) {
 }
 
 func (t *BinaryTree[T]) checkNext(parent *Node[T], e T) {
-	compare := e.Compare(parent.Element())
+	compare := t.compare(e, parent.Element())
 	if compare < 0 {
 		t.addLeft(parent, e)
 		return
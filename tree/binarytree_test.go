@@ -1,6 +1,7 @@
 package tree
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -147,3 +148,23 @@ func TestCompareBinaryTree(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONBinaryTree(t *testing.T) {
+
+	var tree *BinaryTree[wrapper.Int] = NewBinaryTree[wrapper.Int](2, 1, 3)
+
+	data, err := json.Marshal(tree)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result BinaryTree[wrapper.Int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(tree) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	var zero BinaryTree[[]int]
+	if err := json.Unmarshal([]byte("[[1]]"), &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
@@ -1,11 +1,13 @@
 package tree
 
 import (
+	"github.com/potex02/structures"
 	"github.com/potex02/structures/util/wrapper"
 )
 
 var _ Iterator[wrapper.Int] = NewTreeIterator[wrapper.Int](NewBinaryTree[wrapper.Int]())
 var _ Iterator[int] = NewTreeIterator[int](NewNAryTree[int](3))
+var _ Iterator[wrapper.Int] = NewUnmodifiableTreeIterator[wrapper.Int](NewBinaryTree[wrapper.Int]().Iter())
 var _ Iterator[wrapper.Int] = &endIterator[wrapper.Int]{}
 
 // Iterator provides the methods to iterate over a [Tree].
@@ -130,6 +132,44 @@ func (i *TreeIterator[T]) setNext(next *TreeIterator[T]) {
 	i.next = next
 }
 
+// UnmodifiableTreeIterator is an iterator of an [UnmodifiableTree].
+type UnmodifiableTreeIterator[T any] struct {
+	// contains filtered or unexported fields
+	iterator Iterator[T]
+}
+
+// NewUnmodifiableTreeIterator returns a new [UnmodifiableTreeIterator] associated at the iterator parameter.
+func NewUnmodifiableTreeIterator[T any](iterator Iterator[T]) Iterator[T] {
+	if iterator.End() {
+		return &endIterator[T]{}
+	}
+	return &UnmodifiableTreeIterator[T]{iterator: iterator}
+}
+
+// Elements returns the element of i.
+func (i *UnmodifiableTreeIterator[T]) Element() T {
+	return i.iterator.Element()
+}
+
+// Remove always panics with [structures.ErrUnmodifiable].
+func (i *UnmodifiableTreeIterator[T]) Remove() Iterator[T] {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Next returns the iterator of the next element.
+func (i *UnmodifiableTreeIterator[T]) Next() Iterator[T] {
+	i.iterator = i.iterator.Next()
+	if i.iterator.End() {
+		return &endIterator[T]{}
+	}
+	return i
+}
+
+// End checks if the iteration is finished.
+func (i *UnmodifiableTreeIterator[T]) End() bool {
+	return false
+}
+
 type endIterator[T any] struct{}
 
 func (i *endIterator[T]) Element() T {
@@ -1,11 +1,14 @@
 package tree
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"hash/fnv"
 	"reflect"
 
 	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
 	"github.com/potex02/structures/util"
 	"github.com/potex02/structures/util/wrapper"
 )
@@ -332,6 +335,78 @@ func (t *NAryTree[T]) String() string {
 	return fmt.Sprintf("%v-AryTree[%v]%v", t.n, check[1:], objects)
 }
 
+// MarshalJSON returns the JSON encoding of t, which is an array containing its elements level by level.
+// Decoding the array with [NAryTree.UnmarshalJSON] rebuilds a tree with the same shape.
+func (t *NAryTree[T]) MarshalJSON() ([]byte, error) {
+	objects := make([]T, 0, t.len)
+	if t.root != nil {
+		nodes := []*Node[T]{t.root}
+		for len(nodes) != 0 {
+			node := nodes[0]
+			nodes = nodes[1:]
+			objects = append(objects, node.Element())
+			for child := node.Left(); child != nil; child = child.Right() {
+				nodes = append(nodes, child)
+			}
+		}
+	}
+	return json.Marshal(objects)
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from the JSON array data.
+// The elements are added in the order of the array.
+//
+// The method returns an error if t is the zero value, since the max number of children for a node is unknown.
+func (t *NAryTree[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its elements level by level.
+// Decoding it with [NAryTree.UnmarshalBinary] rebuilds a tree with the same shape.
+func (t *NAryTree[T]) MarshalBinary() ([]byte, error) {
+	objects := make([]T, 0, t.len)
+	if t.root != nil {
+		nodes := []*Node[T]{t.root}
+		for len(nodes) != 0 {
+			node := nodes[0]
+			nodes = nodes[1:]
+			objects = append(objects, node.Element())
+			for child := node.Left(); child != nil; child = child.Right() {
+				nodes = append(nodes, child)
+			}
+		}
+	}
+	return codec.MarshalBinarySlice(objects)
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+// The elements are added in the order in which they are decoded.
+//
+// The method returns an error if t is the zero value, since the max number of children for a node is unknown.
+func (t *NAryTree[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [NAryTree.MarshalBinary].
+func (t *NAryTree[T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [NAryTree.UnmarshalBinary].
+func (t *NAryTree[T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of t with objects.
+func (t *NAryTree[T]) decode(objects []T) error {
+	if t.n == 0 {
+		return errors.New("Cannot decode a NAryTree without the max number of children")
+	}
+	t.Clear()
+	t.AddSlice(objects)
+	return nil
+}
+
 func (t *NAryTree[T]) add(e T) {
 	t.len++
 	if t.root == nil {
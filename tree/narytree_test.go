@@ -1,6 +1,7 @@
 package tree
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -146,3 +147,43 @@ func TestCompareNAryTree(t *testing.T) {
 		t.Fail()
 	}
 }
+func TestJSONNAryTree(t *testing.T) {
+
+	var tree *NAryTree[int] = NewNAryTree(3, 1, 2, 3, 4, 5, 6, 7)
+
+	data, err := json.Marshal(tree)
+	if err != nil || string(data) != "[1,2,3,4,5,6,7]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	result := NewNAryTree[int](3)
+	if err := json.Unmarshal(data, result); err != nil || !result.Equal(tree) || !reflect.DeepEqual(result.ToSlice(), tree.ToSlice()) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	var zero NAryTree[int]
+	if err := json.Unmarshal(data, &zero); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestBinaryNAryTree(t *testing.T) {
+
+	var tree *NAryTree[int] = NewNAryTree(3, 1, 2, 3, 4, 5, 6, 7)
+	var result *NAryTree[int] = NewNAryTree[int](3)
+	var zero NAryTree[int]
+
+	data, err := tree.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !reflect.DeepEqual(result.ToSlice(), tree.ToSlice()) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if err := zero.UnmarshalBinary(data); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
@@ -10,15 +10,18 @@ import (
 // Node is a component of a tree structure.
 type Node[T any] struct {
 	// contains filtered or unexported fields
-	element T
-	parent  *Node[T]
-	left    *Node[T]
-	right   *Node[T]
+	element  T
+	parent   *Node[T]
+	left     *Node[T]
+	right    *Node[T]
+	red      bool
+	size     int
+	balanced bool
 }
 
 // NewNode returns a new [Node].
 func NewNode[T any](element T, parent *Node[T], left *Node[T], right *Node[T]) *Node[T] {
-	return &Node[T]{element: element, parent: parent, left: left, right: right}
+	return &Node[T]{element: element, parent: parent, left: left, right: right, size: 1}
 }
 
 // Element returns the element of n.
@@ -26,8 +29,11 @@ func (n *Node[T]) Element() T {
 	return n.element
 }
 
-// Element sets the element of n.
+// SetElement sets the element of n.
+//
+// This method panics if n is a node of a [RedBlackTree].
 func (n *Node[T]) SetElement(element T) {
+	n.check()
 	n.element = element
 }
 
@@ -36,8 +42,11 @@ func (n *Node[T]) Parent() *Node[T] {
 	return n.parent
 }
 
-// SetParent sets the the parent [Node] of n.
+// SetParent sets the parent [Node] of n.
+//
+// This method panics if n is a node of a [RedBlackTree].
 func (n *Node[T]) SetParent(parent *Node[T]) {
+	n.check()
 	n.parent = parent
 }
 
@@ -46,8 +55,11 @@ func (n *Node[T]) Left() *Node[T] {
 	return n.left
 }
 
-// SetLeft sets the the left [Node] of n.
+// SetLeft sets the left [Node] of n.
+//
+// This method panics if n is a node of a [RedBlackTree].
 func (n *Node[T]) SetLeft(left *Node[T]) {
+	n.check()
 	n.left = left
 }
 
@@ -56,11 +68,39 @@ func (n *Node[T]) Right() *Node[T] {
 	return n.right
 }
 
-// SetRight sets the the right [Node] of n.
+// SetRight sets the right [Node] of n.
+//
+// This method panics if n is a node of a [RedBlackTree].
 func (n *Node[T]) SetRight(right *Node[T]) {
+	n.check()
 	n.right = right
 }
 
+// Size returns the number of nodes in the subtree with n as root.
+// The method returns 0 if n is nil.
+//
+// The size is kept up to date only by [RedBlackTree].
+func (n *Node[T]) Size() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// Index returns the position of the element of n in the order of its tree,
+// that is the number of nodes which come before n.
+//
+// This method runs in O(log n) time and it is valid only for the nodes of a [RedBlackTree].
+func (n *Node[T]) Index() int {
+	result := n.left.Size()
+	for node := n; node.parent != nil; node = node.parent {
+		if node == node.parent.right {
+			result += node.parent.left.Size() + 1
+		}
+	}
+	return result
+}
+
 // Max returns the node with the max element in the subtree
 // with node as root.
 func (n *Node[T]) Max() *Node[T] {
@@ -79,6 +119,39 @@ func (n *Node[T]) Min() *Node[T] {
 	return n.Left().Min()
 }
 
+// Successor returns the node with the next element in the tree of n.
+// The method returns nil if n contains the max element of the tree.
+func (n *Node[T]) Successor() *Node[T] {
+	if n.right != nil {
+		return n.right.Min()
+	}
+	node := n
+	for node.parent != nil && node == node.parent.right {
+		node = node.parent
+	}
+	return node.parent
+}
+
+// Predecessor returns the node with the previous element in the tree of n.
+// The method returns nil if n contains the min element of the tree.
+func (n *Node[T]) Predecessor() *Node[T] {
+	if n.left != nil {
+		return n.left.Max()
+	}
+	node := n
+	for node.parent != nil && node == node.parent.left {
+		node = node.parent
+	}
+	return node.parent
+}
+
+// check panics if n is a node of a [RedBlackTree], whose links are managed only by the tree.
+func (n *Node[T]) check() {
+	if n.balanced {
+		panic("Cannot modify a node of a RedBlackTree")
+	}
+}
+
 // Hash returns the hash code of n.
 func (n *Node[T]) Hash() uint64 {
 	h := fnv.New64()
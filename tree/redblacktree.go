@@ -0,0 +1,709 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[wrapper.Int] = NewRedBlackTree[wrapper.Int]()
+var _ Tree[wrapper.Int] = NewRedBlackTree[wrapper.Int]()
+
+// RedBlackTree provides a generic self-balancing binary search tree.
+//
+// Unlike [BinaryTree], the height of the tree is always O(log n), whatever the insertion order is.
+// Every node keeps the size of its subtree, so [RedBlackTree.Rank] and [RedBlackTree.Select] run in O(log n) time too.
+//
+// The order of the elements is determined by the Compare method if the tree is created with [NewRedBlackTree],
+// otherwise it is determined by the comparison function passed to [NewRedBlackTreeFunc].
+//
+// It implements the interface [Tree].
+type RedBlackTree[T any] struct {
+	// contains filtered or unexported fields
+	root    *Node[T]
+	len     int
+	compare func(i T, j T) int
+}
+
+// NewRedBlackTree returns a new [RedBlackTree] containing the elements c.
+//
+// if no argument is passed, it will be created an empty [RedBlackTree].
+func NewRedBlackTree[T util.Comparer](c ...T) *RedBlackTree[T] {
+	return NewRedBlackTreeFromSlice[T](c)
+}
+
+// NewRedBlackTreeFromSlice returns a new [RedBlackTree] containing the elements of slice c.
+func NewRedBlackTreeFromSlice[T util.Comparer](c []T) *RedBlackTree[T] {
+	return NewRedBlackTreeFromSliceFunc(util.Compare[T], c)
+}
+
+// NewRedBlackTreeFunc returns a new [RedBlackTree] containing the elements c,
+// whose order is determined by the compare function.
+//
+// compare must return a negative number if i is placed before j, a positive number if i is placed after j
+// and zero if they are equals.
+//
+// if no extra argument is passed, it will be created an empty [RedBlackTree].
+func NewRedBlackTreeFunc[T any](compare func(i T, j T) int, c ...T) *RedBlackTree[T] {
+	return NewRedBlackTreeFromSliceFunc(compare, c)
+}
+
+// NewRedBlackTreeFromSliceFunc returns a new [RedBlackTree] containing the elements of slice c,
+// whose order is determined by the compare function.
+func NewRedBlackTreeFromSliceFunc[T any](compare func(i T, j T) int, c []T) *RedBlackTree[T] {
+	tree := &RedBlackTree[T]{root: nil, len: 0, compare: compare}
+	if len(c) != 0 {
+		tree.AddSlice(c)
+	}
+	return tree
+}
+
+// Len returns the length of t.
+func (t *RedBlackTree[T]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *RedBlackTree[T]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// Root returns the root [Node] of t.
+//
+// The nodes of t can be read, but their setters panic, since they would break the balance and the sizes kept by t.
+func (t *RedBlackTree[T]) Root() *Node[T] {
+	return t.root
+}
+
+// Contains returns if e is present in t.
+func (t *RedBlackTree[T]) Contains(e T) bool {
+	return t.Find(e) != nil
+}
+
+// Find returns the [Node] containing an element equal to e.
+// The method returns nil if e is not present in t.
+func (t *RedBlackTree[T]) Find(e T) *Node[T] {
+	node := t.root
+	for node != nil {
+		check := t.compare(e, node.Element())
+		if check == 0 {
+			return node
+		}
+		if check < 0 {
+			node = node.Left()
+		} else {
+			node = node.Right()
+		}
+	}
+	return nil
+}
+
+// Floor returns the [Node] containing the greatest element less than or equal to e.
+// The method returns nil if there is no such element.
+func (t *RedBlackTree[T]) Floor(e T) *Node[T] {
+	return t.search(e, true, func(check int) bool {
+		return check >= 0
+	})
+}
+
+// Ceiling returns the [Node] containing the least element greater than or equal to e.
+// The method returns nil if there is no such element.
+func (t *RedBlackTree[T]) Ceiling(e T) *Node[T] {
+	return t.search(e, false, func(check int) bool {
+		return check > 0
+	})
+}
+
+// Lower returns the [Node] containing the greatest element strictly less than e.
+// The method returns nil if there is no such element.
+func (t *RedBlackTree[T]) Lower(e T) *Node[T] {
+	return t.search(e, true, func(check int) bool {
+		return check > 0
+	})
+}
+
+// Higher returns the [Node] containing the least element strictly greater than e.
+// The method returns nil if there is no such element.
+func (t *RedBlackTree[T]) Higher(e T) *Node[T] {
+	return t.search(e, false, func(check int) bool {
+		return check >= 0
+	})
+}
+
+// Rank returns the number of elements of t strictly less than e.
+//
+// This method runs in O(log n) time.
+func (t *RedBlackTree[T]) Rank(e T) int {
+	result := 0
+	node := t.root
+	for node != nil {
+		if t.compare(e, node.Element()) <= 0 {
+			node = node.Left()
+		} else {
+			result += node.Left().Size() + 1
+			node = node.Right()
+		}
+	}
+	return result
+}
+
+// Select returns the [Node] containing the k-th smallest element of t, starting from 0.
+// The method returns nil if k is out of bounds.
+//
+// This method runs in O(log n) time.
+func (t *RedBlackTree[T]) Select(k int) *Node[T] {
+	if k < 0 || k >= t.len {
+		return nil
+	}
+	node := t.root
+	for node != nil {
+		left := node.Left().Size()
+		switch {
+		case k < left:
+			node = node.Left()
+		case k > left:
+			k -= left + 1
+			node = node.Right()
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *RedBlackTree[T]) ToSlice() []T {
+	slice := make([]T, 0, t.len)
+	t.Each(t.root, func(i *Node[T]) {
+		slice = append(slice, i.Element())
+	})
+	return slice
+}
+
+// Add adds the elements e at t.
+func (t *RedBlackTree[T]) Add(e ...T) {
+	t.AddSlice(e)
+}
+
+// AddSlice adds the elements of e at t.
+func (t *RedBlackTree[T]) AddSlice(e []T) {
+	for _, i := range e {
+		t.add(i)
+	}
+}
+
+// Remove removes the element e if present.
+// In that case, the method returns true.
+func (t *RedBlackTree[T]) Remove(e T) bool {
+	node := t.Find(e)
+	if node == nil {
+		return false
+	}
+	t.remove(node)
+	return true
+}
+
+// RemoveFunc removes the first element that satisfies fun, if present.
+// In that case, the method returns true.
+func (t *RedBlackTree[T]) RemoveFunc(e T, fun func(i T, other *Node[T]) bool) bool {
+	return t.Any(t.root, func(i *Node[T]) bool {
+		if fun(e, i) {
+			t.remove(i)
+			return true
+		}
+		return false
+	})
+}
+
+// RemoveNode removes node from t and returns the node containing the element which followed it.
+// The method returns nil if node contained the max element of t.
+//
+// node must be a node of t. Unlike [RedBlackTree.Remove], it removes exactly node also if t contains
+// other elements equal to its one.
+func (t *RedBlackTree[T]) RemoveNode(node *Node[T]) *Node[T] {
+	if node.Left() != nil && node.Right() != nil {
+		t.remove(node)
+		return node
+	}
+	next := node.Successor()
+	t.remove(node)
+	return next
+}
+
+// Each executes fun for all elements of a subtree.
+//
+// node is the root node of the subtree,
+// fun is the function to be executed.
+//
+// fun must not add or remove elements of t. Use Iter to remove elements during the iteration.
+func (t *RedBlackTree[T]) Each(node *Node[T], fun func(i *Node[T])) {
+	if node == nil {
+		return
+	}
+	t.Each(node.Left(), fun)
+	fun(node)
+	t.Each(node.Right(), fun)
+}
+
+// Map executes fun for all elements of a subtree and returns a [RedBlackTree] containing the resulting elements.
+//
+// node is the root node of the subtree,
+// fun is the function to be executed.
+func (t *RedBlackTree[T]) Map(node *Node[T], fun func(i *Node[T]) T) *RedBlackTree[T] {
+	result := NewRedBlackTreeFunc(t.compare)
+	t.Each(node, func(i *Node[T]) {
+		result.add(fun(i))
+	})
+	return result
+}
+
+// Filter returns a [RedBlackTree] containing the elements of a subtree that satisfy fun.
+//
+// node is the root node of the subtree,
+// fun is the function to be executed.
+func (t *RedBlackTree[T]) Filter(node *Node[T], fun func(i *Node[T]) bool) *RedBlackTree[T] {
+	result := NewRedBlackTreeFunc(t.compare)
+	t.Each(node, func(i *Node[T]) {
+		if fun(i) {
+			result.add(i.Element())
+		}
+	})
+	return result
+}
+
+// FilterMap executes fun for all elements of a subtree and returns a [RedBlackTree] containing the resulting elements that satisfy fun.
+//
+// node is the root node of the subtree,
+// fun is the function to be executed.
+func (t *RedBlackTree[T]) FilterMap(node *Node[T], fun func(i *Node[T]) (T, bool)) *RedBlackTree[T] {
+	result := NewRedBlackTreeFunc(t.compare)
+	t.Each(node, func(i *Node[T]) {
+		if element, ok := fun(i); ok {
+			result.add(element)
+		}
+	})
+	return result
+}
+
+// Any returns true if at least one element of a subtree satisfies fun.
+//
+// node is the root node of the subtree,
+// fun is the function to be executed.
+func (t *RedBlackTree[T]) Any(node *Node[T], fun func(i *Node[T]) bool) bool {
+	if node == nil {
+		return false
+	}
+	return t.Any(node.Left(), fun) || fun(node) || t.Any(node.Right(), fun)
+}
+
+// All returns true if all elements of a subtree satisfy fun.
+//
+// node is the root node of the subtree,
+// fun is the function to be executed.
+func (t *RedBlackTree[T]) All(node *Node[T], fun func(i *Node[T]) bool) bool {
+	if node == nil {
+		return true
+	}
+	return t.All(node.Left(), fun) && fun(node) && t.All(node.Right(), fun)
+}
+
+// None returns true if none of the elements of a subtree satisfies fun.
+//
+// node is the root node of the subtree,
+// fun is the function to be executed.
+func (t *RedBlackTree[T]) None(node *Node[T], fun func(i *Node[T]) bool) bool {
+	if node == nil {
+		return true
+	}
+	return t.None(node.Left(), fun) && !fun(node) && t.None(node.Right(), fun)
+}
+
+// Count returns the number of elements of a subtree that satisfy fun.
+//
+// node is the root node of the subtree,
+// fun is the function to be executed.
+func (t *RedBlackTree[T]) Count(node *Node[T], fun func(i *Node[T]) bool) int {
+	result := 0
+	if node == nil {
+		return result
+	}
+	if fun(node) {
+		result = 1
+	}
+	return t.Count(node.Left(), fun) + result + t.Count(node.Right(), fun)
+}
+
+// Clear removes all element from t.
+func (t *RedBlackTree[T]) Clear() {
+	t.root = nil
+	t.len = 0
+}
+
+// Iter returns an [Iterator] which permits to iterate a [RedBlackTree].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		element := i.Element()
+//		// Code
+//	}
+func (t *RedBlackTree[T]) Iter() Iterator[T] {
+	return NewTreeIterator[T](t)
+}
+
+// RangeIter returns a function that allows to iterate a [RedBlackTree] using the range keyword.
+//
+//	for i := range t.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [RedBlackTree.Iter], it doesn't allow to remove elements during the iteration.
+func (t *RedBlackTree[T]) RangeIter() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		if t.root == nil {
+			return
+		}
+		for node := t.root.Min(); node != nil; node = node.Successor() {
+			if !yield(node.Element()) {
+				return
+			}
+		}
+	}
+}
+
+// Equal returns true if t and st are both [RedBlackTree] and their elements are equals.
+// In any other case, it returns false.
+func (t *RedBlackTree[T]) Equal(st any) bool {
+	tree, ok := st.(*RedBlackTree[T])
+	if ok && t != nil && tree != nil {
+		if t.Len() != tree.Len() {
+			return false
+		}
+		others := tree.ToSlice()
+		j := 0
+		return t.All(t.root, func(i *Node[T]) bool {
+			j++
+			return util.EqualFunction(i.Element())(others[j-1])
+		})
+	}
+	return false
+}
+
+// Compare returns -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [RedBlackTree] or if one between t and st is nil.
+//
+// If t and st have the same length, the result is the comparison
+// between the first different element of the two trees.
+// If they are all equals, the result is 0.
+func (t *RedBlackTree[T]) Compare(st any) int {
+	tree, ok := st.(*RedBlackTree[T])
+	if ok && t != nil && tree != nil {
+		if t.Len() < tree.Len() {
+			return -1
+		}
+		if t.Len() > tree.Len() {
+			return 1
+		}
+		others := tree.ToSlice()
+		j := 0
+		result := 0
+		t.All(t.root, func(i *Node[T]) bool {
+			j++
+			result = t.compare(i.Element(), others[j-1])
+			return result == 0
+		})
+		return result
+	}
+	return -2
+}
+
+// Hash returns the hash code of t.
+func (t *RedBlackTree[T]) Hash() uint64 {
+	h := fnv.New64()
+	t.Each(t.Root(), func(i *Node[T]) {
+		h.Write([]byte(fmt.Sprintf("%v", i.Hash())))
+	})
+	return h.Sum64()
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *RedBlackTree[T]) String() string {
+	check := reflect.TypeOf(new(T)).String()
+	return fmt.Sprintf("RedBlackTree[%v]%v", check[1:], t.ToSlice())
+}
+
+// MarshalJSON returns the JSON encoding of t, which is an array containing its elements in ascending order.
+func (t *RedBlackTree[T]) MarshalJSON() ([]byte, error) {
+	return codec.MarshalSlice(t.ToSlice())
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from the JSON array data.
+//
+// If t is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (t *RedBlackTree[T]) UnmarshalJSON(data []byte) error {
+	return codec.DecodeJSON(data, t.decode)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its elements in ascending order.
+func (t *RedBlackTree[T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinarySlice(t.ToSlice())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+//
+// If t is the zero value, the elements are ordered by their Compare method.
+// In this case, the method returns an error if T does not implement [util.Comparer].
+func (t *RedBlackTree[T]) UnmarshalBinary(data []byte) error {
+	return codec.DecodeBinary(data, t.decode)
+}
+
+// GobEncode returns the binary encoding of t as [RedBlackTree.MarshalBinary].
+func (t *RedBlackTree[T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [RedBlackTree.UnmarshalBinary].
+func (t *RedBlackTree[T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// decode replaces the elements of t with objects.
+func (t *RedBlackTree[T]) decode(objects []T) error {
+	if t.compare == nil {
+		if t.compare = codec.Compare[T](); t.compare == nil {
+			return errors.New("Cannot decode a RedBlackTree whose elements do not implement util.Comparer")
+		}
+	}
+	t.Clear()
+	t.AddSlice(objects)
+	return nil
+}
+
+func (t *RedBlackTree[T]) add(e T) {
+	var parent *Node[T]
+	left := false
+	for current := t.root; current != nil; {
+		parent = current
+		current.size++
+		left = t.compare(e, current.Element()) < 0
+		if left {
+			current = current.Left()
+		} else {
+			current = current.Right()
+		}
+	}
+	node := &Node[T]{element: e, parent: parent, red: true, size: 1, balanced: true}
+	switch {
+	case parent == nil:
+		t.root = node
+	case left:
+		parent.left = node
+	default:
+		parent.right = node
+	}
+	t.len++
+	t.fixAdd(node)
+}
+
+func (t *RedBlackTree[T]) fixAdd(node *Node[T]) {
+	for isRed(node.Parent()) {
+		parent := node.Parent()
+		grandparent := parent.Parent()
+		if parent == grandparent.Left() {
+			uncle := grandparent.Right()
+			if isRed(uncle) {
+				parent.red = false
+				uncle.red = false
+				grandparent.red = true
+				node = grandparent
+				continue
+			}
+			if node == parent.Right() {
+				node = parent
+				t.rotateLeft(node)
+				parent = node.Parent()
+			}
+			parent.red = false
+			grandparent.red = true
+			t.rotateRight(grandparent)
+		} else {
+			uncle := grandparent.Left()
+			if isRed(uncle) {
+				parent.red = false
+				uncle.red = false
+				grandparent.red = true
+				node = grandparent
+				continue
+			}
+			if node == parent.Left() {
+				node = parent
+				t.rotateRight(node)
+				parent = node.Parent()
+			}
+			parent.red = false
+			grandparent.red = true
+			t.rotateLeft(grandparent)
+		}
+	}
+	t.root.red = false
+}
+
+func (t *RedBlackTree[T]) remove(node *Node[T]) {
+	t.len--
+	if node.Left() != nil && node.Right() != nil {
+		min := node.Right().Min()
+		node.element = min.Element()
+		node = min
+	}
+	child := node.Left()
+	if child == nil {
+		child = node.Right()
+	}
+	parent := node.Parent()
+	for i := parent; i != nil; i = i.Parent() {
+		i.size--
+	}
+	t.replace(node, child)
+	if !node.red {
+		if isRed(child) {
+			child.red = false
+		} else {
+			t.fixRemove(child, parent)
+		}
+	}
+	node.parent = nil
+	node.left = nil
+	node.right = nil
+}
+
+func (t *RedBlackTree[T]) fixRemove(node *Node[T], parent *Node[T]) {
+	for node != t.root && !isRed(node) {
+		if node == parent.Left() {
+			sibling := parent.Right()
+			if isRed(sibling) {
+				sibling.red = false
+				parent.red = true
+				t.rotateLeft(parent)
+				sibling = parent.Right()
+			}
+			if !isRed(sibling.Left()) && !isRed(sibling.Right()) {
+				sibling.red = true
+				node = parent
+				parent = node.Parent()
+				continue
+			}
+			if !isRed(sibling.Right()) {
+				sibling.Left().red = false
+				sibling.red = true
+				t.rotateRight(sibling)
+				sibling = parent.Right()
+			}
+			sibling.red = parent.red
+			parent.red = false
+			sibling.Right().red = false
+			t.rotateLeft(parent)
+		} else {
+			sibling := parent.Left()
+			if isRed(sibling) {
+				sibling.red = false
+				parent.red = true
+				t.rotateRight(parent)
+				sibling = parent.Left()
+			}
+			if !isRed(sibling.Left()) && !isRed(sibling.Right()) {
+				sibling.red = true
+				node = parent
+				parent = node.Parent()
+				continue
+			}
+			if !isRed(sibling.Left()) {
+				sibling.Right().red = false
+				sibling.red = true
+				t.rotateLeft(sibling)
+				sibling = parent.Left()
+			}
+			sibling.red = parent.red
+			parent.red = false
+			sibling.Left().red = false
+			t.rotateRight(parent)
+		}
+		node = t.root
+	}
+	if node != nil {
+		node.red = false
+	}
+}
+
+func (t *RedBlackTree[T]) rotateLeft(node *Node[T]) {
+	right := node.Right()
+	node.right = right.Left()
+	if right.Left() != nil {
+		right.Left().parent = node
+	}
+	t.replace(node, right)
+	right.left = node
+	node.parent = right
+	right.size = node.size
+	node.size = node.Left().Size() + node.Right().Size() + 1
+}
+
+func (t *RedBlackTree[T]) rotateRight(node *Node[T]) {
+	left := node.Left()
+	node.left = left.Right()
+	if left.Right() != nil {
+		left.Right().parent = node
+	}
+	t.replace(node, left)
+	left.right = node
+	node.parent = left
+	left.size = node.size
+	node.size = node.Left().Size() + node.Right().Size() + 1
+}
+
+func (t *RedBlackTree[T]) replace(node *Node[T], other *Node[T]) {
+	parent := node.Parent()
+	switch {
+	case parent == nil:
+		t.root = other
+	case node == parent.Left():
+		parent.left = other
+	default:
+		parent.right = other
+	}
+	if other != nil {
+		other.parent = parent
+	}
+}
+
+// search walks the path from the root to a leaf, going to the right subtree when right returns true.
+// If floor is true, it returns the last node from which it has gone right, otherwise the last node from which it has gone left.
+func (t *RedBlackTree[T]) search(e T, floor bool, right func(check int) bool) *Node[T] {
+
+	var result *Node[T]
+
+	node := t.root
+	for node != nil {
+		if right(t.compare(e, node.Element())) {
+			if floor {
+				result = node
+			}
+			node = node.Right()
+		} else {
+			if !floor {
+				result = node
+			}
+			node = node.Left()
+		}
+	}
+	return result
+}
+
+func isRed[T any](node *Node[T]) bool {
+	return node != nil && node.red
+}
@@ -0,0 +1,364 @@
+package tree
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewRedBlackTree(t *testing.T) {
+
+	var tree structures.Structure[wrapper.Int] = NewRedBlackTree[wrapper.Int]()
+
+	if tree == nil {
+		t.Log("tree is nil")
+		t.Fail()
+	}
+	if tree.Len() != 0 {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+}
+func TestNewRedBlackTreeFromSlice(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTreeFromSlice[wrapper.Int]([]wrapper.Int{1, 8, -3, 5})
+
+	if tree == nil {
+		t.Log("tree is nil")
+		t.Fail()
+	}
+	if tree.Len() != 4 {
+		t.Log("length is not 4")
+		t.Fail()
+	}
+	if !reflect.DeepEqual(tree.ToSlice(), []wrapper.Int{-3, 1, 5, 8}) {
+		t.Log("tree is", tree)
+		t.Fail()
+	}
+}
+func TestNewRedBlackTreeFunc(t *testing.T) {
+
+	var tree *RedBlackTree[int] = NewRedBlackTreeFunc(func(i int, j int) int { return j - i }, 1, 8, -3, 5)
+
+	if !reflect.DeepEqual(tree.ToSlice(), []int{8, 5, 1, -3}) {
+		t.Log("tree is", tree)
+		t.Fail()
+	}
+	if !tree.Contains(5) || tree.Contains(4) {
+		t.Log("tree is", tree)
+		t.Fail()
+	}
+}
+func TestContainsRedBlackTree(t *testing.T) {
+
+	var tree Tree[wrapper.Int] = NewRedBlackTree[wrapper.Int](1, 8, -3, 5)
+
+	if tree.Contains(-1) {
+		t.Log("found -1 in tree")
+		t.Fail()
+	}
+	if !tree.Contains(1) {
+		t.Log("not found 1 in tree")
+		t.Fail()
+	}
+}
+func TestAddRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int](-3, 1, 5, 8)
+
+	tree.Add(-2, 6, 1)
+	if slice := tree.ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{-3, -2, 1, 1, 5, 6, 8}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if !checkRedBlackTree(tree) {
+		t.Log("tree is not balanced")
+		t.Fail()
+	}
+}
+func TestRemoveRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Float32] = NewRedBlackTree[wrapper.Float32](12.5, 7, -7.6, 3.4, 9, 0.9, 50, -120)
+
+	if ok := tree.Remove(9); !ok {
+		t.Log("not found 9 in tree")
+		t.Fail()
+	}
+	if ok := tree.Remove(-9); ok {
+		t.Log("found -9 in tree")
+		t.Fail()
+	}
+	if slice := tree.ToSlice(); !reflect.DeepEqual(slice, []wrapper.Float32{-120, -7.6, 0.9, 3.4, 7, 12.5, 50}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if !checkRedBlackTree(tree) {
+		t.Log("tree is not balanced")
+		t.Fail()
+	}
+}
+func TestRemoveNodeRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int](5, 3, 8, 1, 4, 7, 9, 4)
+
+	node := tree.Find(3)
+	if next := tree.RemoveNode(node); next == nil || next.Element() != 4 {
+		t.Log("next is", next)
+		t.Fail()
+	}
+	for node := tree.Root().Min(); node != nil; {
+		if node.Element() == 4 {
+			node = tree.RemoveNode(node)
+			continue
+		}
+		node = node.Successor()
+	}
+	if slice := tree.ToSlice(); !reflect.DeepEqual(slice, []wrapper.Int{1, 5, 7, 8, 9}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+	if next := tree.RemoveNode(tree.Find(9)); next != nil {
+		t.Log("next is", next.Element())
+		t.Fail()
+	}
+	if !checkRedBlackTree(tree) {
+		t.Log("tree is not balanced")
+		t.Fail()
+	}
+}
+func TestBalanceRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int]()
+
+	for i := 0; i != 1024; i++ {
+		tree.Add(wrapper.Int(i))
+	}
+	if height := heightRedBlackTree(tree.Root()); height > 20 {
+		t.Log("height is", height)
+		t.Fail()
+	}
+	for i := 0; i != 1024; i += 2 {
+		if !tree.Remove(wrapper.Int(i)) {
+			t.Log("not found", i, "in tree")
+			t.Fail()
+		}
+		if !checkRedBlackTree(tree) {
+			t.Log("tree is not balanced after removing", i)
+			t.FailNow()
+		}
+	}
+	if tree.Len() != 512 {
+		t.Log("length is", tree.Len())
+		t.Fail()
+	}
+	if min := tree.Root().Min().Element(); min != 1 {
+		t.Log("min is", min)
+		t.Fail()
+	}
+}
+func TestNodeRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int](2, 1, 3)
+
+	defer func() {
+		if r := recover(); r == nil || !checkRedBlackTree(tree) || tree.Root().Left().Element() != 1 {
+			t.Log("tree is", tree)
+			t.Fail()
+		}
+	}()
+	tree.Root().SetLeft(nil)
+}
+func TestFloorRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int](10, 20, 20, 30, 40)
+
+	if node := tree.Floor(25); node == nil || node.Element() != 20 {
+		t.Log("floor is", node)
+		t.Fail()
+	}
+	if node := tree.Floor(5); node != nil {
+		t.Log("floor is", node.Element())
+		t.Fail()
+	}
+	if node := tree.Ceiling(20); node == nil || node.Element() != 20 || node.Predecessor().Element() != 10 {
+		t.Log("ceiling is", node)
+		t.Fail()
+	}
+	if node := tree.Lower(20); node == nil || node.Element() != 10 {
+		t.Log("lower is", node)
+		t.Fail()
+	}
+	if node := tree.Higher(20); node == nil || node.Element() != 30 {
+		t.Log("higher is", node)
+		t.Fail()
+	}
+	if node := tree.Higher(40); node != nil {
+		t.Log("higher is", node.Element())
+		t.Fail()
+	}
+}
+func TestRankRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int]()
+
+	for i := 0; i != 500; i++ {
+		tree.Add(wrapper.Int((i * 7919) % 500))
+	}
+	for i := 0; i < 500; i += 3 {
+		tree.Remove(wrapper.Int(i))
+	}
+	slice := tree.ToSlice()
+	if tree.Root().Size() != len(slice) {
+		t.Log("size is", tree.Root().Size())
+		t.Fail()
+	}
+	for k, e := range slice {
+		if node := tree.Select(k); node == nil || node.Element() != e || node.Index() != k {
+			t.Log("select", k, "is", node)
+			t.FailNow()
+		}
+		if rank := tree.Rank(e); rank != k {
+			t.Log("rank of", e, "is", rank)
+			t.FailNow()
+		}
+	}
+	if tree.Select(-1) != nil || tree.Select(len(slice)) != nil {
+		t.Log("select out of bounds is not nil")
+		t.Fail()
+	}
+	if rank := tree.Rank(1000); rank != len(slice) {
+		t.Log("rank is", rank)
+		t.Fail()
+	}
+}
+func TestIterRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Float32] = NewRedBlackTree[wrapper.Float32](12.5, 7, -7.6, 3.4, 9, 0.9, 50, -120)
+
+	slice := tree.ToSlice()
+	j := 0
+	for i := tree.Iter(); !i.End() && j != tree.Len(); i = i.Next() {
+		if !i.Element().Equal(slice[j]) {
+			t.Log("element is", i.Element())
+			t.Fail()
+		}
+		j++
+	}
+	for i := tree.Iter(); !i.End(); {
+		if i.Element() < 0 {
+			i = i.Remove()
+		} else {
+			i = i.Next()
+		}
+	}
+	if slice := tree.ToSlice(); !reflect.DeepEqual(slice, []wrapper.Float32{0.9, 3.4, 7, 9, 12.5, 50}) {
+		t.Log("slice is", slice)
+		t.Fail()
+	}
+}
+func TestRangeIterRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Float32] = NewRedBlackTree[wrapper.Float32](12.5, 7, -7.6, 3.4, 9, 0.9, 50, -120)
+
+	slice := tree.ToSlice()
+	j := 0
+	for i := range tree.RangeIter() {
+		if !i.Equal(slice[j]) {
+			t.Log("element is", i)
+			t.Fail()
+		}
+		j++
+	}
+	if j != len(slice) {
+		t.Log("iterated elements are", j)
+		t.Fail()
+	}
+}
+func TestEqualRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int](-3, 1, 5, 8)
+
+	if !tree.Equal(NewRedBlackTree[wrapper.Int](8, 5, -3, 1)) {
+		t.Log("trees are not equals")
+		t.Fail()
+	}
+	if tree.Equal(NewRedBlackTree[wrapper.Int](8)) {
+		t.Log("trees are equals")
+		t.Fail()
+	}
+	if tree.Equal(NewBinaryTree[wrapper.Int](-3, 1, 5, 8)) {
+		t.Log("trees are equals")
+		t.Fail()
+	}
+}
+func TestCompareRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int](-3, 1, 5, 8)
+
+	if tree.Compare(NewRedBlackTree[wrapper.Int](8, 5, -3, 1)) != 0 {
+		t.Log("compare is not 0")
+		t.Fail()
+	}
+	if tree.Compare(NewRedBlackTree[wrapper.Int](8)) != 1 {
+		t.Log("compare is not 1")
+		t.Fail()
+	}
+	if tree.Compare(NewRedBlackTree[wrapper.Int](12, 5, -3, 1)) != -1 {
+		t.Log("compare is not -1")
+		t.Fail()
+	}
+}
+func TestJSONRedBlackTree(t *testing.T) {
+
+	var tree *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int](2, 1, 3, 5, 4)
+
+	data, err := json.Marshal(tree)
+	if err != nil || string(data) != "[1,2,3,4,5]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result RedBlackTree[wrapper.Int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(tree) || !checkRedBlackTree[wrapper.Int](&result) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+}
+
+func checkRedBlackTree[T any](tree Tree[T]) bool {
+	if isRed(tree.Root()) {
+		return false
+	}
+	_, ok := blackHeightRedBlackTree(tree.Root())
+	return ok
+}
+
+func blackHeightRedBlackTree[T any](node *Node[T]) (int, bool) {
+	if node == nil {
+		return 1, true
+	}
+	if node.red && (isRed(node.Left()) || isRed(node.Right())) {
+		return 0, false
+	}
+	if node.Size() != node.Left().Size()+node.Right().Size()+1 {
+		return 0, false
+	}
+	left, okLeft := blackHeightRedBlackTree(node.Left())
+	right, okRight := blackHeightRedBlackTree(node.Right())
+	if !okLeft || !okRight || left != right {
+		return 0, false
+	}
+	if !node.red {
+		left++
+	}
+	return left, true
+}
+
+func heightRedBlackTree[T any](node *Node[T]) int {
+	if node == nil {
+		return 0
+	}
+	return 1 + max(heightRedBlackTree(node.Left()), heightRedBlackTree(node.Right()))
+}
@@ -1,10 +1,39 @@
 // package tree implements dynamic trees.
 package tree
 
-import "github.com/potex02/structures"
+import (
+	"fmt"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util"
+)
+
+// ReadOnlyTree provides the methods to read a generic tree without modifying it.
+// It is satisfied by every [Tree] and by the views returned by [Unmodifiable].
+//
+// Unlike [Tree], it doesn't give access to the nodes of the tree.
+type ReadOnlyTree[T any] interface {
+	fmt.Stringer
+	util.Equaler
+	util.Hasher
+	// Len returns the numbers of elements in the tree.
+	Len() int
+	// IsEmpty returns a bool which indicates if the tree is empty or not.
+	IsEmpty() bool
+	// ToSlice returns a slice which contains all elements of the tree.
+	ToSlice() []T
+	// Contains returns if e is present in the tree.
+	Contains(e T) bool
+	// RangeIter returns a function that allows to iterate the tree using the range keyword.
+	//
+	//	for i := range tree.RangeIter() {
+	//		// Code
+	//	}
+	RangeIter() func(yield func(T) bool)
+}
 
 // Tree provides all methods to use a generic dynamic tree.
-// A tree contains all the methods of [structures.Structure].
+// A tree contains all the methods of [structures.Structure] and of [ReadOnlyTree].
 //
 // A tree is implemented through the [Node] type.
 //
@@ -13,10 +42,9 @@ import "github.com/potex02/structures"
 //
 type Tree[T any] interface {
 	structures.Structure[T]
+	ReadOnlyTree[T]
 	// Root returns the root [Node] of the tree.
 	Root() *Node[T]
-	// Contains returns if e is present in the tree.
-	Contains(e T) bool
 	// Add adds the elements e at the tree.
 	Add(e ...T)
 	// AddSlice adds the elements of e at the tree.
@@ -0,0 +1,54 @@
+package tree
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestBinary(t *testing.T) {
+
+	var tests []binaryTest = []binaryTest{
+		{"BinaryTree", NewBinaryTree[wrapper.Int](2, 1, 3), NewBinaryTree[wrapper.Int](4), &BinaryTree[wrapper.Int]{}},
+		{"RedBlackTree", NewRedBlackTree[wrapper.Int](2, 1, 3, 5, 4), NewRedBlackTree[wrapper.Int](6), &RedBlackTree[wrapper.Int]{}},
+		{"NAryTree", NewNAryTree[wrapper.Int](3, 1, 2, 3, 4, 5, 6, 7), NewNAryTree[wrapper.Int](3, 8), NewNAryTree[wrapper.Int](3)},
+	}
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
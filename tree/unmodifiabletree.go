@@ -0,0 +1,143 @@
+package tree
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[wrapper.Int] = Unmodifiable[wrapper.Int](NewBinaryTree[wrapper.Int]())
+var _ ReadOnlyTree[wrapper.Int] = Unmodifiable[wrapper.Int](NewBinaryTree[wrapper.Int]())
+
+// UnmodifiableTree provides a read-only view of a [Tree].
+//
+// The view is not a copy: all the changes made on the wrapped tree are visible through it.
+// The methods which modify the tree panic with [structures.ErrUnmodifiable].
+//
+// The nodes of the wrapped tree are not exposed, since they could be used to modify it,
+// so the view doesn't implement the interface [Tree].
+//
+// It implements the interface [ReadOnlyTree].
+type UnmodifiableTree[T any] struct {
+	// contains filtered or unexported fields
+	tree Tree[T]
+}
+
+// Unmodifiable returns a new [UnmodifiableTree] associated at the tree t.
+func Unmodifiable[T any](t Tree[T]) *UnmodifiableTree[T] {
+	return &UnmodifiableTree[T]{tree: t}
+}
+
+// Len returns the length of t.
+func (t *UnmodifiableTree[T]) Len() int {
+	return t.tree.Len()
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *UnmodifiableTree[T]) IsEmpty() bool {
+	return t.tree.IsEmpty()
+}
+
+// Contains returns if e is present in t.
+func (t *UnmodifiableTree[T]) Contains(e T) bool {
+	return t.tree.Contains(e)
+}
+
+// ToSlice returns a slice which contains all elements of t.
+func (t *UnmodifiableTree[T]) ToSlice() []T {
+	return t.tree.ToSlice()
+}
+
+// Add always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableTree[T]) Add(e ...T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// AddSlice always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableTree[T]) AddSlice(e []T) {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Remove always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableTree[T]) Remove(e T) bool {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Clear always panics with [structures.ErrUnmodifiable].
+func (t *UnmodifiableTree[T]) Clear() {
+	panic(structures.ErrUnmodifiable)
+}
+
+// Iter returns an [Iterator] which permits to iterate t.
+// The Remove method of the iterator panics with [structures.ErrUnmodifiable].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		element := i.Element()
+//		// Code
+//	}
+func (t *UnmodifiableTree[T]) Iter() Iterator[T] {
+	return NewUnmodifiableTreeIterator(t.tree.Iter())
+}
+
+// RangeIter returns a function that allows to iterate t using the range keyword.
+//
+//	for i := range t.RangeIter() {
+//		// Code
+//	}
+func (t *UnmodifiableTree[T]) RangeIter() func(yield func(T) bool) {
+	return t.tree.RangeIter()
+}
+
+// Equal returns true if the wrapped tree is equal to st.
+// In any other case, it returns false.
+func (t *UnmodifiableTree[T]) Equal(st any) bool {
+	return t.tree.Equal(st)
+}
+
+// Compare returns the comparison between the wrapped tree and st.
+func (t *UnmodifiableTree[T]) Compare(st any) int {
+	return t.tree.Compare(st)
+}
+
+// Hash returns the hash code of t.
+func (t *UnmodifiableTree[T]) Hash() uint64 {
+	return t.tree.Hash()
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *UnmodifiableTree[T]) String() string {
+	return fmt.Sprintf("Unmodifiable%v", t.tree)
+}
+
+// MarshalJSON returns the JSON encoding of the wrapped tree.
+func (t *UnmodifiableTree[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.tree)
+}
+
+// UnmarshalJSON always returns [structures.ErrUnmodifiable].
+func (t *UnmodifiableTree[T]) UnmarshalJSON(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// MarshalBinary returns the binary encoding of the wrapped tree.
+func (t *UnmodifiableTree[T]) MarshalBinary() ([]byte, error) {
+	return codec.Marshal(t.tree)
+}
+
+// UnmarshalBinary always returns [structures.ErrUnmodifiable].
+func (t *UnmodifiableTree[T]) UnmarshalBinary(data []byte) error {
+	return structures.ErrUnmodifiable
+}
+
+// GobEncode returns the binary encoding of t as [UnmodifiableTree.MarshalBinary].
+func (t *UnmodifiableTree[T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [UnmodifiableTree.UnmarshalBinary].
+func (t *UnmodifiableTree[T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
@@ -0,0 +1,126 @@
+package tree
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func checkUnmodifiable(fun func()) (result bool) {
+	defer func() {
+		err, ok := recover().(error)
+		result = ok && errors.Is(err, structures.ErrUnmodifiable)
+	}()
+	fun()
+	return false
+}
+func TestNewUnmodifiableTree(t *testing.T) {
+
+	var wrapped *BinaryTree[wrapper.Int] = NewBinaryTree[wrapper.Int](2, 1, 3)
+	var tree ReadOnlyTree[wrapper.Int] = Unmodifiable[wrapper.Int](wrapped)
+
+	if tree.Len() != 3 || tree.IsEmpty() {
+		t.Log("length is", tree.Len())
+		t.Fail()
+	}
+	if tree.String() != "UnmodifiableBinaryTree[wrapper.Int][1 2 3]" {
+		t.Log("tree is", tree)
+		t.Fail()
+	}
+	if _, ok := tree.(Tree[wrapper.Int]); ok {
+		t.Log("tree exposes its nodes")
+		t.Fail()
+	}
+	if !tree.Equal(wrapped) || tree.Compare(wrapped) != 0 || tree.Hash() != wrapped.Hash() {
+		t.Log("trees are not equals")
+		t.Fail()
+	}
+	wrapped.Add(4)
+	if tree.Len() != 4 || !tree.Contains(4) {
+		t.Log("tree objects are", tree.ToSlice())
+		t.Fail()
+	}
+}
+func TestModifyUnmodifiableTree(t *testing.T) {
+
+	var tree *UnmodifiableTree[wrapper.Int] = Unmodifiable[wrapper.Int](NewRedBlackTree[wrapper.Int](1, 2, 3))
+
+	for _, i := range []func(){
+		func() { tree.Add(5) },
+		func() { tree.AddSlice([]wrapper.Int{5}) },
+		func() { tree.Remove(1) },
+		func() { tree.Clear() },
+		func() { tree.Iter().Remove() },
+	} {
+		if !checkUnmodifiable(i) {
+			t.Log("tree has been modified")
+			t.Fail()
+		}
+	}
+	if !slices.Equal(tree.ToSlice(), []wrapper.Int{1, 2, 3}) {
+		t.Log("tree objects are", tree.ToSlice())
+		t.Fail()
+	}
+}
+func TestIterUnmodifiableTree(t *testing.T) {
+
+	var tree *UnmodifiableTree[wrapper.Int] = Unmodifiable[wrapper.Int](NewBinaryTree[wrapper.Int](2, 1, 3))
+
+	elements := make([]wrapper.Int, 0)
+	for i := tree.Iter(); !i.End(); i = i.Next() {
+		elements = append(elements, i.Element())
+	}
+	if !slices.Equal(elements, []wrapper.Int{1, 2, 3}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+	elements = make([]wrapper.Int, 0)
+	for i := range tree.RangeIter() {
+		elements = append(elements, i)
+	}
+	if !slices.Equal(elements, []wrapper.Int{1, 2, 3}) {
+		t.Log("elements are", elements)
+		t.Fail()
+	}
+	if !Unmodifiable[wrapper.Int](NewBinaryTree[wrapper.Int]()).Iter().End() {
+		t.Log("iterator is not ended")
+		t.Fail()
+	}
+}
+func TestJSONUnmodifiableTree(t *testing.T) {
+
+	var tree *UnmodifiableTree[wrapper.Int] = Unmodifiable[wrapper.Int](NewRedBlackTree[wrapper.Int](2, 1))
+
+	data, err := json.Marshal(tree)
+	if err != nil || string(data) != "[1,2]" {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal(data, tree); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
+func TestBinaryUnmodifiableTree(t *testing.T) {
+
+	var tree *UnmodifiableTree[wrapper.Int] = Unmodifiable[wrapper.Int](NewRedBlackTree[wrapper.Int](2, 1))
+	var result *RedBlackTree[wrapper.Int] = NewRedBlackTree[wrapper.Int]()
+
+	data, err := tree.MarshalBinary()
+	if err != nil {
+		t.Log("err is", err)
+		t.Fail()
+	}
+	if err := result.UnmarshalBinary(data); err != nil || !slices.Equal(result.ToSlice(), tree.ToSlice()) {
+		t.Log("result is", result, "err is", err)
+		t.Fail()
+	}
+	if err := tree.UnmarshalBinary(data); !errors.Is(err, structures.ErrUnmodifiable) {
+		t.Log("err is", err)
+		t.Fail()
+	}
+}
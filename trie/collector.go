@@ -0,0 +1,32 @@
+package trie
+
+import (
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/table"
+)
+
+// EntriesToTrie returns a [structures.Collector] which collects the entries into a [Trie].
+// If more entries have the same key, only the last one is kept.
+func EntriesToTrie[K ~string, T any]() structures.Collector[*table.Entry[K, T], *Trie[K, T]] {
+	return structures.NewCollector(func() *Trie[K, T] {
+		return NewTrie[K, T]()
+	}, func(result *Trie[K, T], entry *table.Entry[K, T]) *Trie[K, T] {
+		result.Put(entry.Key(), entry.Element())
+		return result
+	}, func(result *Trie[K, T]) *Trie[K, T] {
+		return result
+	})
+}
+
+// EntriesToRadixTree returns a [structures.Collector] which collects the entries into a [RadixTree].
+// If more entries have the same key, only the last one is kept.
+func EntriesToRadixTree[K ~string, T any]() structures.Collector[*table.Entry[K, T], *RadixTree[K, T]] {
+	return structures.NewCollector(func() *RadixTree[K, T] {
+		return NewRadixTree[K, T]()
+	}, func(result *RadixTree[K, T], entry *table.Entry[K, T]) *RadixTree[K, T] {
+		result.Put(entry.Key(), entry.Element())
+		return result
+	}, func(result *RadixTree[K, T]) *RadixTree[K, T] {
+		return result
+	})
+}
@@ -0,0 +1,23 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/potex02/structures/table"
+)
+
+func TestEntriesToTrie(t *testing.T) {
+
+	var stream *table.Stream[string, int] = table.NewStreamFromSeq(func(yield func(string, int) bool) {
+		_ = yield("tea", 1) && yield("to", 2) && yield("to", 3)
+	})
+
+	if result := table.CollectTo(stream, EntriesToTrie[string, int]()); !result.Equal(NewTrieFromSlice([]string{"tea", "to"}, []int{1, 3})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	if result := table.CollectTo(stream, EntriesToRadixTree[string, int]()); !result.Equal(NewRadixTreeFromSlice([]string{"tea", "to"}, []int{1, 3})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+}
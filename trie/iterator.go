@@ -0,0 +1,184 @@
+package trie
+
+import (
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ table.Iterator[wrapper.String, int] = NewTrieIterator[wrapper.String, int](NewTrie[wrapper.String, int]())
+var _ table.Iterator[wrapper.String, int] = NewRadixTreeIterator[wrapper.String, int](NewRadixTree[wrapper.String, int]())
+var _ table.Iterator[wrapper.String, int] = &endIterator[wrapper.String, int]{}
+
+// TrieIterator is an iterator of a [Trie].
+//
+// The keys are taken when the iterator is created, so the keys added during the iteration are not iterated.
+type TrieIterator[K ~string, T any] struct {
+	// contains filtered or unexported fields
+	table *Trie[K, T]
+	keys  []K
+	index int
+}
+
+// NewTrieIterator returns a new [TrieIterator] associated at the table parameter.
+func NewTrieIterator[K ~string, T any](table *Trie[K, T]) table.Iterator[K, T] {
+	if table.IsEmpty() {
+		return &endIterator[K, T]{}
+	}
+	return &TrieIterator[K, T]{table: table, keys: table.Keys().ToSlice(), index: 0}
+}
+
+// Elements returns the element of the iterator.
+func (i *TrieIterator[K, T]) Element() T {
+	element, _ := i.table.Get(i.keys[i.index])
+	return element
+}
+
+// Index returns the key of the element the iterator.
+func (i *TrieIterator[K, T]) Key() K {
+	return i.keys[i.index]
+}
+
+// Remove removes the element from the table and returns the iterator of the next element.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+//
+// An example of the use of the method is the following:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i = i.Remove()
+//		}
+//		// Code
+//	}
+//
+// The following code, instead, can lead to undefined program behavior:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i.Remove()
+//		}
+//		// Code
+//	}
+func (i *TrieIterator[K, T]) Remove() table.Iterator[K, T] {
+	i.table.Remove(i.keys[i.index])
+	return i.Next()
+}
+
+// Next returns the iterator of the next element.
+func (i *TrieIterator[K, T]) Next() table.Iterator[K, T] {
+	i.index++
+	for i.index != len(i.keys) {
+		if i.table.ContainsKey(i.keys[i.index]) {
+			return i
+		}
+		i.index++
+	}
+	return &endIterator[K, T]{}
+}
+
+// End checks if the iteration is finished.
+func (i *TrieIterator[K, T]) End() bool {
+	return false
+}
+
+// RadixTreeIterator is an iterator of a [RadixTree].
+//
+// The keys are taken when the iterator is created, so the keys added during the iteration are not iterated.
+type RadixTreeIterator[K ~string, T any] struct {
+	// contains filtered or unexported fields
+	table *RadixTree[K, T]
+	keys  []K
+	index int
+}
+
+// NewRadixTreeIterator returns a new [RadixTreeIterator] associated at the table parameter.
+func NewRadixTreeIterator[K ~string, T any](table *RadixTree[K, T]) table.Iterator[K, T] {
+	if table.IsEmpty() {
+		return &endIterator[K, T]{}
+	}
+	return &RadixTreeIterator[K, T]{table: table, keys: table.Keys().ToSlice(), index: 0}
+}
+
+// Elements returns the element of the iterator.
+func (i *RadixTreeIterator[K, T]) Element() T {
+	element, _ := i.table.Get(i.keys[i.index])
+	return element
+}
+
+// Index returns the key of the element the iterator.
+func (i *RadixTreeIterator[K, T]) Key() K {
+	return i.keys[i.index]
+}
+
+// Remove removes the element from the table and returns the iterator of the next element.
+//
+// The result of this method must be assigned in most cases to himself.
+//
+//	i = i.Remove()
+//
+// An example of the use of the method is the following:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i = i.Remove()
+//		}
+//		// Code
+//	}
+//
+// The following code, instead, can lead to undefined program behavior:
+//
+//	for i := table.Iter(); !i.End(); i = i.Next() {
+//		// Code
+//		if /*some condition*/ {
+//			i.Remove()
+//		}
+//		// Code
+//	}
+func (i *RadixTreeIterator[K, T]) Remove() table.Iterator[K, T] {
+	i.table.Remove(i.keys[i.index])
+	return i.Next()
+}
+
+// Next returns the iterator of the next element.
+func (i *RadixTreeIterator[K, T]) Next() table.Iterator[K, T] {
+	i.index++
+	for i.index != len(i.keys) {
+		if i.table.ContainsKey(i.keys[i.index]) {
+			return i
+		}
+		i.index++
+	}
+	return &endIterator[K, T]{}
+}
+
+// End checks if the iteration is finished.
+func (i *RadixTreeIterator[K, T]) End() bool {
+	return false
+}
+
+type endIterator[K ~string, T any] struct{}
+
+func (i *endIterator[K, T]) Element() T {
+	return *new(T)
+}
+
+func (i *endIterator[K, T]) Key() K {
+	return *new(K)
+}
+
+func (i *endIterator[K, T]) Remove() table.Iterator[K, T] {
+	return i
+}
+
+func (i *endIterator[K, T]) Next() table.Iterator[K, T] {
+	return i
+}
+
+func (i *endIterator[K, T]) End() bool {
+	return true
+}
@@ -0,0 +1,120 @@
+// package trie implements tables whose keys are strings, stored in prefix trees.
+package trie
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+)
+
+// PrefixTable provides all methods to use a generic table whose keys are strings ordered lexicographically.
+// A prefixtable contains all the methods of [table.Table].
+//
+// The keys can be of type string, [wrapper.String] or any other type whose underlying type is string.
+// They are compared byte by byte, so the order of the keys is the same of the < operator of strings.
+//
+// The check on the equality of the elements is done with the Equal method if T implements [util.Equaler],
+// otherwise it is done with [reflect.DeepEqual].
+type PrefixTable[K ~string, T any] interface {
+	table.Table[K, T]
+	// PrefixIter returns a function that allows to iterate, in lexicographic order,
+	// the keys of the table which start with prefix and their elements.
+	//
+	//	for i, j := range table.PrefixIter(prefix) {
+	//		// Code
+	//	}
+	PrefixIter(prefix K) func(yield func(K, T) bool)
+	// KeysWithPrefix returns a [list.List] which contains, in lexicographic order, the keys of the table which start with prefix.
+	KeysWithPrefix(prefix K) list.List[K]
+	// LongestPrefixMatch returns the longest key of the table which is a prefix of key and its associated element.
+	// The method returns false if there is no such key.
+	LongestPrefixMatch(key K) (K, T, bool)
+}
+
+func equalTables[K ~string, T any](t PrefixTable[K, T], st any) bool {
+	other, ok := st.(table.Table[K, T])
+	if !ok || other == nil || t.Len() != other.Len() {
+		return false
+	}
+	for i, j := range t.RangeIter() {
+		e, found := other.Get(i)
+		if !found || !util.EqualFunction(j)(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func compareTables[K ~string, T any](t PrefixTable[K, T], st any) int {
+	other, ok := st.(table.Table[K, T])
+	if !ok || other == nil {
+		return -2
+	}
+	if t.Len() < other.Len() {
+		return -1
+	}
+	if t.Len() > other.Len() {
+		return 1
+	}
+	return 0
+}
+
+func hashTable[K ~string, T any](t PrefixTable[K, T]) uint64 {
+	h := fnv.New64()
+	for i, j := range t.RangeIter() {
+		h.Write([]byte(fmt.Sprintf("%v", table.NewEntry(i, j).Hash())))
+	}
+	return h.Sum64()
+}
+
+func tableString[K ~string, T any](name string, t PrefixTable[K, T]) string {
+	check := []string{reflect.TypeOf(new(K)).String(), reflect.TypeOf(new(T)).String()}
+	result := fmt.Sprintf("%v[%v, %v][", name, check[0][1:], check[1][1:])
+	first := true
+	for i, j := range t.RangeIter() {
+		if !first {
+			result += ", "
+		}
+		result += fmt.Sprintf("%v: %v", i, j)
+		first = false
+	}
+	result += "]"
+	return result
+}
+
+// marshalTable returns the JSON encoding of t, which is an object whose keys are in lexicographic order.
+func marshalTable[K ~string, T any](t PrefixTable[K, T]) ([]byte, error) {
+	objects := make(map[K]T, t.Len())
+	for i, j := range t.RangeIter() {
+		objects[i] = j
+	}
+	return json.Marshal(objects)
+}
+
+// unmarshalTable decodes the JSON object data and puts its entries in t, after removing the existing ones.
+func unmarshalTable[K ~string, T any](t PrefixTable[K, T], data []byte) error {
+	var objects map[K]T
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return err
+	}
+	t.Clear()
+	for i, j := range objects {
+		t.Put(i, j)
+	}
+	return nil
+}
+
+// unmarshalBinaryTable decodes the binary encoding data and puts its entries in t, after removing the existing ones.
+func unmarshalBinaryTable[K ~string, T any](t PrefixTable[K, T], data []byte) error {
+	return codec.DecodeBinaryEntries(data, func(keys []K, elements []T) error {
+		t.Clear()
+		t.PutSlice(keys, elements)
+		return nil
+	})
+}
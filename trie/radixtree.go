@@ -0,0 +1,436 @@
+package trie
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = NewRadixTree[wrapper.String, int]()
+var _ table.BaseTable[wrapper.String, int] = NewRadixTree[wrapper.String, int]()
+var _ table.Table[string, int] = NewRadixTree[string, int]()
+var _ PrefixTable[wrapper.String, int] = NewRadixTree[wrapper.String, int]()
+
+// RadixTree provides a generic table implemented through a compressed prefix tree, in which every node is a substring of the keys.
+// It maintains the lexicographic order of the keys.
+//
+// Get, Put and Remove run in O(k log s) time, where k is the length of the key and s is the number of distinct bytes following a prefix.
+// Unlike a [Trie], the chains of nodes with a single child are stored in a single node,
+// so the number of nodes is at most twice the number of keys.
+//
+// It implements the interface [PrefixTable].
+type RadixTree[K ~string, T any] struct {
+	// contains filtered or unexported fields
+	root *radixNode[T]
+	len  int
+}
+
+type radixNode[T any] struct {
+	children []*radixNode[T]
+	prefix   string
+	element  T
+	present  bool
+}
+
+// NewRadixTree returns a new empty [RadixTree].
+func NewRadixTree[K ~string, T any]() *RadixTree[K, T] {
+	return &RadixTree[K, T]{root: &radixNode[T]{}}
+}
+
+// NewRadixTreeFromSlice returns a new [RadixTree] containing the elements of slice c.
+// It panics if key and c have different lengths.
+func NewRadixTreeFromSlice[K ~string, T any](key []K, c []T) *RadixTree[K, T] {
+	trie := NewRadixTree[K, T]()
+	if len(c) != 0 {
+		trie.PutSlice(key, c)
+	}
+	return trie
+}
+
+// Len returns the length of t.
+func (t *RadixTree[K, T]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *RadixTree[K, T]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// ContainsKey returns true if the key is present on t.
+func (t *RadixTree[K, T]) ContainsKey(key K) bool {
+	node := t.get(string(key))
+	return node != nil && node.present
+}
+
+// ContainsElement returns true if the element e is present on t.
+func (t *RadixTree[K, T]) ContainsElement(e T) bool {
+	fun := util.EqualFunction(e)
+	for _, i := range t.RangeIter() {
+		if fun(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns a [list.List] which contains all keys of t in lexicographic order.
+func (t *RadixTree[K, T]) Keys() list.List[K] {
+	return t.KeysWithPrefix("")
+}
+
+// KeysWithPrefix returns a [list.List] which contains, in lexicographic order, the keys of t which start with prefix.
+func (t *RadixTree[K, T]) KeysWithPrefix(prefix K) list.List[K] {
+	list := list.NewArrayList[K]()
+	for i := range t.PrefixIter(prefix) {
+		list.Add(i)
+	}
+	return list
+}
+
+// Elements returns a [list.List] which contains all elements of t, in the lexicographic order of their keys.
+func (t *RadixTree[K, T]) Elements() list.List[T] {
+	return list.NewArrayListFromSlice(t.ToSlice())
+}
+
+// ToSlice returns a slice which contains all elements of t, in the lexicographic order of their keys.
+func (t *RadixTree[K, T]) ToSlice() []T {
+	slice := make([]T, 0, t.len)
+	for _, i := range t.RangeIter() {
+		slice = append(slice, i)
+	}
+	return slice
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+func (t *RadixTree[K, T]) Get(key K) (T, bool) {
+
+	var result T
+
+	node := t.get(string(key))
+	if node == nil || !node.present {
+		return result, false
+	}
+	return node.element, true
+}
+
+// Put set the element e at the key and returns the overwritten value, if present.
+// If the element is not present, the method returns false.
+func (t *RadixTree[K, T]) Put(key K, e T) (T, bool) {
+
+	var result T
+
+	node, rest := t.root, string(key)
+	for rest != "" {
+		index, found := node.search(rest[0])
+		if !found {
+			node.children = slices.Insert(node.children, index, &radixNode[T]{prefix: rest, element: e, present: true})
+			t.len++
+			return result, false
+		}
+		child := node.children[index]
+		length := commonPrefix(child.prefix, rest)
+		if length != len(child.prefix) {
+			// The key diverges in the middle of the prefix of child, so it is split in two nodes.
+			middle := &radixNode[T]{prefix: child.prefix[:length], children: []*radixNode[T]{child}}
+			child.prefix = child.prefix[length:]
+			node.children[index] = middle
+			child = middle
+		}
+		node, rest = child, rest[length:]
+	}
+	result, ok := node.element, node.present
+	if !ok {
+		t.len++
+	}
+	node.element, node.present = e, true
+	return result, ok
+}
+
+// PutSlice adds the elements of e at t.
+// It panics if key and e have different lengths.
+func (t *RadixTree[K, T]) PutSlice(key []K, e []T) {
+	if len(key) != len(e) {
+		panic("Different lengths for keys and elements")
+	}
+	for i := 0; i != len(key); i++ {
+		t.Put(key[i], e[i])
+	}
+}
+
+// Remove removes the key from t and returns the value associated at the key.
+// It returns false if the the key does not exists.
+//
+// The nodes which are no longer used by any key are removed.
+func (t *RadixTree[K, T]) Remove(key K) (T, bool) {
+
+	var result T
+
+	var parent *radixNode[T]
+	node, rest := t.root, string(key)
+	for rest != "" {
+		index, found := node.search(rest[0])
+		if !found || !strings.HasPrefix(rest, node.children[index].prefix) {
+			return result, false
+		}
+		parent, node, rest = node, node.children[index], rest[len(node.children[index].prefix):]
+	}
+	if !node.present {
+		return result, false
+	}
+	result = node.element
+	node.element, node.present = *new(T), false
+	t.len--
+	if parent == nil {
+		return result, true
+	}
+	switch len(node.children) {
+	case 0:
+		index, _ := parent.search(node.prefix[0])
+		parent.children = slices.Delete(parent.children, index, index+1)
+		if parent != t.root && !parent.present && len(parent.children) == 1 {
+			parent.merge()
+		}
+	case 1:
+		node.merge()
+	}
+	return result, true
+}
+
+// LongestPrefixMatch returns the longest key of t which is a prefix of key and its associated element.
+// The method returns false if there is no such key.
+func (t *RadixTree[K, T]) LongestPrefixMatch(key K) (K, T, bool) {
+
+	var result T
+
+	node, length, found := t.root, 0, t.root.present
+	result = t.root.element
+	for i := 0; i != len(key); {
+		index, ok := node.search(key[i])
+		if !ok || !strings.HasPrefix(string(key[i:]), node.children[index].prefix) {
+			break
+		}
+		node = node.children[index]
+		i += len(node.prefix)
+		if node.present {
+			length, result, found = i, node.element, true
+		}
+	}
+	if !found {
+		return "", result, false
+	}
+	return key[:length], result, true
+}
+
+// Each executes fun for all elements of t, in the lexicographic order of their keys.
+//
+// This method should be used to remove elements. Use Iter insted.
+func (t *RadixTree[K, T]) Each(fun func(key K, element T)) {
+	for i, j := range t.RangeIter() {
+		fun(i, j)
+	}
+}
+
+// Stream returns a [table.Stream] rapresenting t.
+func (t *RadixTree[K, T]) Stream() *table.Stream[K, T] {
+	return table.NewStream[K, T](t, reflect.ValueOf(NewRadixTree[K, T]))
+}
+
+// Clear removes all element from t.
+func (t *RadixTree[K, T]) Clear() {
+	t.root = &radixNode[T]{}
+	t.len = 0
+}
+
+// Iter returns a [table.Iterator] which permits to iterate a [RadixTree].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		key := i.Key()
+//		element := i.Element()
+//		// Code
+//	}
+func (t *RadixTree[K, T]) Iter() table.Iterator[K, T] {
+	return NewRadixTreeIterator(t)
+}
+
+// RangeIter returns a function that allows to iterate a [RadixTree] using the range keyword.
+// The keys are iterated in lexicographic order.
+//
+//	for i, j := range t.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [RadixTree.Iter], it doesn't allow to remove elements during the iteration.
+func (t *RadixTree[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return t.PrefixIter("")
+}
+
+// PrefixIter returns a function that allows to iterate, in lexicographic order,
+// the keys of t which start with prefix and their elements.
+// The prefix can end in the middle of a node.
+//
+//	for i, j := range t.PrefixIter(prefix) {
+//		// Code
+//	}
+//
+// It doesn't allow to remove elements during the iteration.
+func (t *RadixTree[K, T]) PrefixIter(prefix K) func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		node, key := t.find(string(prefix))
+		if node != nil {
+			node.each([]byte(key), func(key []byte, element T) bool {
+				return yield(K(key), element)
+			})
+		}
+	}
+}
+
+// Equal returns true if t and st are both [table.Table] and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [Trie],
+// but the elements of t and the elements of st are equals, this method returns anyway true.
+func (t *RadixTree[K, T]) Equal(st any) bool {
+	return t != nil && equalTables[K, T](t, st)
+}
+
+// Compare returns 0 if t and st have the same length,
+// -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [table.Table] or if one between t and st is nil.
+func (t *RadixTree[K, T]) Compare(st any) int {
+	if t == nil {
+		return -2
+	}
+	return compareTables[K, T](t, st)
+}
+
+// Hash returns the hash code of t.
+func (t *RadixTree[K, T]) Hash() uint64 {
+	return hashTable[K, T](t)
+}
+
+// Copy returns a table containing a copy of the elements of t.
+// The result of this method is of type [table.Table], but the effective table which is created is a [RadixTree].
+//
+// This method uses [util.Copy] to make copies of the elements.
+func (t *RadixTree[K, T]) Copy() table.Table[K, T] {
+	key := t.Keys().ToSlice()
+	rand.Shuffle(len(key), func(i, j int) { key[i], key[j] = key[j], key[i] })
+	result := NewRadixTree[K, T]()
+	for _, i := range key {
+		e, _ := t.Get(i)
+		result.Put(i, util.Copy(e))
+	}
+	return result
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *RadixTree[K, T]) String() string {
+	return tableString[K, T]("RadixTree", t)
+}
+
+// MarshalJSON returns the JSON encoding of t, which is an object whose keys are in lexicographic order.
+func (t *RadixTree[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalTable[K, T](t)
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from the JSON object data.
+func (t *RadixTree[K, T]) UnmarshalJSON(data []byte) error {
+	return unmarshalTable[K, T](t, data)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its entries as key and element pairs in lexicographic order of the keys.
+func (t *RadixTree[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+func (t *RadixTree[K, T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryTable[K, T](t, data)
+}
+
+// GobEncode returns the binary encoding of t as [RadixTree.MarshalBinary].
+func (t *RadixTree[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [RadixTree.UnmarshalBinary].
+func (t *RadixTree[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+func (t *RadixTree[K, T]) get(key string) *radixNode[T] {
+	node := t.root
+	for key != "" {
+		index, found := node.search(key[0])
+		if !found || !strings.HasPrefix(key, node.children[index].prefix) {
+			return nil
+		}
+		node, key = node.children[index], key[len(node.children[index].prefix):]
+	}
+	return node
+}
+
+// find returns the first node whose keys start with prefix and the key of that node.
+func (t *RadixTree[K, T]) find(prefix string) (*radixNode[T], string) {
+	node, key := t.root, ""
+	for len(key) < len(prefix) {
+		rest := prefix[len(key):]
+		index, found := node.search(rest[0])
+		if !found {
+			return nil, ""
+		}
+		node = node.children[index]
+		if !strings.HasPrefix(rest, node.prefix) && !strings.HasPrefix(node.prefix, rest) {
+			return nil, ""
+		}
+		key += node.prefix
+	}
+	return node, key
+}
+
+// search returns the position of the child of n whose prefix starts with b and true if it exists,
+// otherwise the position where it should be inserted and false.
+func (n *radixNode[T]) search(b byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, b, func(i *radixNode[T], j byte) int {
+		return int(i.prefix[0]) - int(j)
+	})
+}
+
+// merge joins n with its only child.
+func (n *radixNode[T]) merge() {
+	child := n.children[0]
+	n.prefix += child.prefix
+	n.children, n.element, n.present = child.children, child.element, child.present
+}
+
+// each executes fun for the entries in the subtree of n, whose prefix is key, until fun returns false.
+func (n *radixNode[T]) each(key []byte, fun func(key []byte, element T) bool) bool {
+	if n.present && !fun(key, n.element) {
+		return false
+	}
+	for _, i := range n.children {
+		if !i.each(append(key, i.prefix...), fun) {
+			return false
+		}
+	}
+	return true
+}
+
+func commonPrefix(a string, b string) int {
+	i := 0
+	for i != len(a) && i != len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,378 @@
+package trie
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/internal/codec"
+	"github.com/potex02/structures/list"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+var _ structures.Structure[int] = NewTrie[wrapper.String, int]()
+var _ table.BaseTable[wrapper.String, int] = NewTrie[wrapper.String, int]()
+var _ table.Table[string, int] = NewTrie[string, int]()
+var _ PrefixTable[wrapper.String, int] = NewTrie[wrapper.String, int]()
+
+// Trie provides a generic table implemented through a prefix tree, in which every node is a byte of the keys.
+// It maintains the lexicographic order of the keys.
+//
+// Get, Put and Remove run in O(k log s) time, where k is the length of the key and s is the number of distinct bytes following a prefix.
+// The keys sharing a prefix share also its nodes, but every byte of the keys needs a node.
+// A [RadixTree] stores the chains of nodes with a single child in a single node.
+//
+// It implements the interface [PrefixTable].
+type Trie[K ~string, T any] struct {
+	// contains filtered or unexported fields
+	root *trieNode[T]
+	len  int
+}
+
+type trieNode[T any] struct {
+	children []*trieNode[T]
+	label    byte
+	element  T
+	present  bool
+}
+
+// NewTrie returns a new empty [Trie].
+func NewTrie[K ~string, T any]() *Trie[K, T] {
+	return &Trie[K, T]{root: &trieNode[T]{}}
+}
+
+// NewTrieFromSlice returns a new [Trie] containing the elements of slice c.
+// It panics if key and c have different lengths.
+func NewTrieFromSlice[K ~string, T any](key []K, c []T) *Trie[K, T] {
+	trie := NewTrie[K, T]()
+	if len(c) != 0 {
+		trie.PutSlice(key, c)
+	}
+	return trie
+}
+
+// Len returns the length of t.
+func (t *Trie[K, T]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns a bool which indicates if t is empty or not.
+func (t *Trie[K, T]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// ContainsKey returns true if the key is present on t.
+func (t *Trie[K, T]) ContainsKey(key K) bool {
+	node := t.find(string(key))
+	return node != nil && node.present
+}
+
+// ContainsElement returns true if the element e is present on t.
+func (t *Trie[K, T]) ContainsElement(e T) bool {
+	fun := util.EqualFunction(e)
+	for _, i := range t.RangeIter() {
+		if fun(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns a [list.List] which contains all keys of t in lexicographic order.
+func (t *Trie[K, T]) Keys() list.List[K] {
+	return t.KeysWithPrefix("")
+}
+
+// KeysWithPrefix returns a [list.List] which contains, in lexicographic order, the keys of t which start with prefix.
+func (t *Trie[K, T]) KeysWithPrefix(prefix K) list.List[K] {
+	list := list.NewArrayList[K]()
+	for i := range t.PrefixIter(prefix) {
+		list.Add(i)
+	}
+	return list
+}
+
+// Elements returns a [list.List] which contains all elements of t, in the lexicographic order of their keys.
+func (t *Trie[K, T]) Elements() list.List[T] {
+	return list.NewArrayListFromSlice(t.ToSlice())
+}
+
+// ToSlice returns a slice which contains all elements of t, in the lexicographic order of their keys.
+func (t *Trie[K, T]) ToSlice() []T {
+	slice := make([]T, 0, t.len)
+	for _, i := range t.RangeIter() {
+		slice = append(slice, i)
+	}
+	return slice
+}
+
+// Get returns the element associated at the key.
+// The method returns false if the key is not found.
+func (t *Trie[K, T]) Get(key K) (T, bool) {
+
+	var result T
+
+	node := t.find(string(key))
+	if node == nil || !node.present {
+		return result, false
+	}
+	return node.element, true
+}
+
+// Put set the element e at the key and returns the overwritten value, if present.
+// If the element is not present, the method returns false.
+func (t *Trie[K, T]) Put(key K, e T) (T, bool) {
+	node := t.root
+	for i := 0; i != len(key); i++ {
+		index, found := node.search(key[i])
+		if !found {
+			node.children = slices.Insert(node.children, index, &trieNode[T]{label: key[i]})
+		}
+		node = node.children[index]
+	}
+	result, ok := node.element, node.present
+	if !ok {
+		t.len++
+	}
+	node.element, node.present = e, true
+	return result, ok
+}
+
+// PutSlice adds the elements of e at t.
+// It panics if key and e have different lengths.
+func (t *Trie[K, T]) PutSlice(key []K, e []T) {
+	if len(key) != len(e) {
+		panic("Different lengths for keys and elements")
+	}
+	for i := 0; i != len(key); i++ {
+		t.Put(key[i], e[i])
+	}
+}
+
+// Remove removes the key from t and returns the value associated at the key.
+// It returns false if the the key does not exists.
+//
+// The nodes which are no longer used by any key are removed.
+func (t *Trie[K, T]) Remove(key K) (T, bool) {
+
+	var result T
+
+	path := make([]*trieNode[T], 1, len(key)+1)
+	path[0] = t.root
+	for i := 0; i != len(key); i++ {
+		index, found := path[i].search(key[i])
+		if !found {
+			return result, false
+		}
+		path = append(path, path[i].children[index])
+	}
+	node := path[len(path)-1]
+	if !node.present {
+		return result, false
+	}
+	result = node.element
+	node.element, node.present = *new(T), false
+	t.len--
+	for i := len(path) - 1; i != 0 && !path[i].present && len(path[i].children) == 0; i-- {
+		index, _ := path[i-1].search(path[i].label)
+		path[i-1].children = slices.Delete(path[i-1].children, index, index+1)
+	}
+	return result, true
+}
+
+// LongestPrefixMatch returns the longest key of t which is a prefix of key and its associated element.
+// The method returns false if there is no such key.
+func (t *Trie[K, T]) LongestPrefixMatch(key K) (K, T, bool) {
+
+	var result T
+
+	node, length, found := t.root, 0, t.root.present
+	result = t.root.element
+	for i := 0; i != len(key); i++ {
+		index, ok := node.search(key[i])
+		if !ok {
+			break
+		}
+		node = node.children[index]
+		if node.present {
+			length, result, found = i+1, node.element, true
+		}
+	}
+	if !found {
+		return "", result, false
+	}
+	return key[:length], result, true
+}
+
+// Each executes fun for all elements of t, in the lexicographic order of their keys.
+//
+// This method should be used to remove elements. Use Iter insted.
+func (t *Trie[K, T]) Each(fun func(key K, element T)) {
+	for i, j := range t.RangeIter() {
+		fun(i, j)
+	}
+}
+
+// Stream returns a [table.Stream] rapresenting t.
+func (t *Trie[K, T]) Stream() *table.Stream[K, T] {
+	return table.NewStream[K, T](t, reflect.ValueOf(NewTrie[K, T]))
+}
+
+// Clear removes all element from t.
+func (t *Trie[K, T]) Clear() {
+	t.root = &trieNode[T]{}
+	t.len = 0
+}
+
+// Iter returns a [table.Iterator] which permits to iterate a [Trie].
+//
+//	for i := t.Iter(); !i.End(); i = i.Next() {
+//		key := i.Key()
+//		element := i.Element()
+//		// Code
+//	}
+func (t *Trie[K, T]) Iter() table.Iterator[K, T] {
+	return NewTrieIterator(t)
+}
+
+// RangeIter returns a function that allows to iterate a [Trie] using the range keyword.
+// The keys are iterated in lexicographic order.
+//
+//	for i, j := range t.RangeIter() {
+//		// Code
+//	}
+//
+// Unlike [Trie.Iter], it doesn't allow to remove elements during the iteration.
+func (t *Trie[K, T]) RangeIter() func(yield func(K, T) bool) {
+	return t.PrefixIter("")
+}
+
+// PrefixIter returns a function that allows to iterate, in lexicographic order,
+// the keys of t which start with prefix and their elements.
+//
+//	for i, j := range t.PrefixIter(prefix) {
+//		// Code
+//	}
+//
+// It doesn't allow to remove elements during the iteration.
+func (t *Trie[K, T]) PrefixIter(prefix K) func(yield func(K, T) bool) {
+	return func(yield func(K, T) bool) {
+		node := t.find(string(prefix))
+		if node != nil {
+			node.each([]byte(prefix), func(key []byte, element T) bool {
+				return yield(K(key), element)
+			})
+		}
+	}
+}
+
+// Equal returns true if t and st are both [table.Table] and their keys and elements are equals.
+// In any other case, it returns false.
+//
+// Equal does not take into account the effective type of st. This means that if st is a [RadixTree],
+// but the elements of t and the elements of st are equals, this method returns anyway true.
+func (t *Trie[K, T]) Equal(st any) bool {
+	return t != nil && equalTables[K, T](t, st)
+}
+
+// Compare returns 0 if t and st have the same length,
+// -1 if t is shorten than st,
+// 1 if t is longer than st,
+// -2 if st is not a [table.Table] or if one between t and st is nil.
+func (t *Trie[K, T]) Compare(st any) int {
+	if t == nil {
+		return -2
+	}
+	return compareTables[K, T](t, st)
+}
+
+// Hash returns the hash code of t.
+func (t *Trie[K, T]) Hash() uint64 {
+	return hashTable[K, T](t)
+}
+
+// Copy returns a table containing a copy of the elements of t.
+// The result of this method is of type [table.Table], but the effective table which is created is a [Trie].
+//
+// This method uses [util.Copy] to make copies of the elements.
+func (t *Trie[K, T]) Copy() table.Table[K, T] {
+	key := t.Keys().ToSlice()
+	rand.Shuffle(len(key), func(i, j int) { key[i], key[j] = key[j], key[i] })
+	result := NewTrie[K, T]()
+	for _, i := range key {
+		e, _ := t.Get(i)
+		result.Put(i, util.Copy(e))
+	}
+	return result
+}
+
+// String returns a rapresentation of t in the form of a string.
+func (t *Trie[K, T]) String() string {
+	return tableString[K, T]("Trie", t)
+}
+
+// MarshalJSON returns the JSON encoding of t, which is an object whose keys are in lexicographic order.
+func (t *Trie[K, T]) MarshalJSON() ([]byte, error) {
+	return marshalTable[K, T](t)
+}
+
+// UnmarshalJSON replaces the elements of t with the ones decoded from the JSON object data.
+func (t *Trie[K, T]) UnmarshalJSON(data []byte) error {
+	return unmarshalTable[K, T](t, data)
+}
+
+// MarshalBinary returns the binary encoding of t, which contains its entries as key and element pairs in lexicographic order of the keys.
+func (t *Trie[K, T]) MarshalBinary() ([]byte, error) {
+	return codec.MarshalBinaryEntries(t.Len(), t.RangeIter())
+}
+
+// UnmarshalBinary replaces the elements of t with the ones decoded from the binary encoding data.
+func (t *Trie[K, T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryTable[K, T](t, data)
+}
+
+// GobEncode returns the binary encoding of t as [Trie.MarshalBinary].
+func (t *Trie[K, T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode decodes data into t as [Trie.UnmarshalBinary].
+func (t *Trie[K, T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+func (t *Trie[K, T]) find(key string) *trieNode[T] {
+	node := t.root
+	for i := 0; node != nil && i != len(key); i++ {
+		index, found := node.search(key[i])
+		if !found {
+			return nil
+		}
+		node = node.children[index]
+	}
+	return node
+}
+
+// search returns the position of the child of n whose label is b and true if it exists,
+// otherwise the position where it should be inserted and false.
+func (n *trieNode[T]) search(b byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, b, func(i *trieNode[T], j byte) int {
+		return int(i.label) - int(j)
+	})
+}
+
+// each executes fun for the entries in the subtree of n, whose prefix is key, until fun returns false.
+func (n *trieNode[T]) each(key []byte, fun func(key []byte, element T) bool) bool {
+	if n.present && !fun(key, n.element) {
+		return false
+	}
+	for _, i := range n.children {
+		if !i.each(append(key, i.label), fun) {
+			return false
+		}
+	}
+	return true
+}
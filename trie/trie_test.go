@@ -0,0 +1,403 @@
+package trie
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/potex02/structures"
+	"github.com/potex02/structures/table"
+	"github.com/potex02/structures/util"
+	"github.com/potex02/structures/util/wrapper"
+)
+
+func TestNewTrie(t *testing.T) {
+
+	var trie structures.Structure[int] = NewTrie[wrapper.String, int]()
+
+	if trie == nil {
+		t.Log("trie is nil")
+		t.Fail()
+	}
+	if trie.Len() != 0 {
+		t.Log("length is not 0")
+		t.Fail()
+	}
+}
+func TestNewTrieFromSlice(t *testing.T) {
+
+	var trie *Trie[string, float32] = NewTrieFromSlice(
+		[]string{"Hello", "Ciao", "Hola"},
+		[]float32{1.2, 5.6, -3},
+	)
+
+	if trie == nil {
+		t.Log("trie is nil")
+		t.Fail()
+	}
+	if trie.Len() != 3 {
+		t.Log("length is not 3")
+		t.Fail()
+	}
+	if !reflect.DeepEqual(trie.Keys().ToSlice(), []string{"Ciao", "Hello", "Hola"}) {
+		t.Log("keys are", trie.Keys())
+		t.Fail()
+	}
+	if e, _ := trie.Get("Ciao"); e != 5.6 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+}
+func TestContainsKeyTrie(t *testing.T) {
+
+	var trie *Trie[wrapper.String, int] = NewTrieFromSlice([]wrapper.String{"tea", "ten", "to"}, []int{1, 2, 3})
+
+	if !trie.ContainsKey("ten") {
+		t.Log("ten not found")
+		t.Fail()
+	}
+	if trie.ContainsKey("te") {
+		t.Log("te found")
+		t.Fail()
+	}
+	if trie.ContainsKey("tent") {
+		t.Log("tent found")
+		t.Fail()
+	}
+	if trie.ContainsKey("") {
+		t.Log("empty key found")
+		t.Fail()
+	}
+}
+func TestContainsElementTrie(t *testing.T) {
+
+	var trie *Trie[wrapper.String, int] = NewTrieFromSlice([]wrapper.String{"tea", "ten", "to"}, []int{1, 2, 3})
+
+	if !trie.ContainsElement(2) {
+		t.Log("2 not found")
+		t.Fail()
+	}
+	if trie.ContainsElement(4) {
+		t.Log("4 found")
+		t.Fail()
+	}
+}
+func TestGetTrie(t *testing.T) {
+
+	var trie *Trie[string, int] = NewTrieFromSlice([]string{"", "tea", "ten"}, []int{0, 1, 2})
+
+	if e, ok := trie.Get(""); !ok || e != 0 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := trie.Get("tea"); !ok || e != 1 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := trie.Get("te"); ok || e != 0 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := trie.Get("x"); ok || e != 0 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+}
+func TestPutTrie(t *testing.T) {
+
+	var trie *Trie[wrapper.String, int] = NewTrie[wrapper.String, int]()
+
+	for i, j := range []wrapper.String{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom", "r"} {
+		if _, ok := trie.Put(j, i); ok {
+			t.Log("key", j, "already present")
+			t.Fail()
+		}
+	}
+	if trie.Len() != 9 {
+		t.Log("length is", trie.Len())
+		t.Fail()
+	}
+	if e, ok := trie.Put("rom", 10); !ok || e != 7 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(trie.Keys().ToSlice(), []wrapper.String{"r", "rom", "romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"}) {
+		t.Log("keys are", trie.Keys())
+		t.Fail()
+	}
+	if !reflect.DeepEqual(trie.ToSlice(), []int{8, 10, 0, 1, 2, 3, 4, 5, 6}) {
+		t.Log("elements are", trie.ToSlice())
+		t.Fail()
+	}
+}
+func TestRemoveTrie(t *testing.T) {
+
+	var trie *Trie[string, int] = NewTrieFromSlice([]string{"", "tea", "team", "ten", "to"}, []int{0, 1, 2, 3, 4})
+
+	if e, ok := trie.Remove("te"); ok || e != 0 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := trie.Remove("tea"); !ok || e != 1 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := trie.Remove("tea"); ok || e != 0 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := trie.Get("team"); !ok || e != 2 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := trie.Remove("ten"); !ok || e != 3 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if e, ok := trie.Remove(""); !ok || e != 0 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(trie.Keys().ToSlice(), []string{"team", "to"}) {
+		t.Log("keys are", trie.Keys())
+		t.Fail()
+	}
+	trie.Remove("team")
+	trie.Remove("to")
+	if !trie.IsEmpty() || len(trie.root.children) != 0 {
+		t.Log("trie is", trie)
+		t.Fail()
+	}
+	trie.Put("tea", 5)
+	if e, ok := trie.Get("tea"); !ok || e != 5 {
+		t.Log("element is", e)
+		t.Fail()
+	}
+}
+func TestPrefixIterTrie(t *testing.T) {
+
+	var trie *Trie[wrapper.String, int] = NewTrieFromSlice([]wrapper.String{"tea", "team", "ten", "to", "inn"}, []int{1, 2, 3, 4, 5})
+	var keys []wrapper.String
+	var elements []int
+
+	for i, j := range trie.PrefixIter("te") {
+		keys = append(keys, i)
+		elements = append(elements, j)
+	}
+	if !reflect.DeepEqual(keys, []wrapper.String{"tea", "team", "ten"}) || !reflect.DeepEqual(elements, []int{1, 2, 3}) {
+		t.Log("keys are", keys, "elements are", elements)
+		t.Fail()
+	}
+	keys = nil
+	for i := range trie.PrefixIter("tea") {
+		keys = append(keys, i)
+		break
+	}
+	if !reflect.DeepEqual(keys, []wrapper.String{"tea"}) {
+		t.Log("keys are", keys)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(trie.KeysWithPrefix("t").ToSlice(), []wrapper.String{"tea", "team", "ten", "to"}) {
+		t.Log("keys are", trie.KeysWithPrefix("t"))
+		t.Fail()
+	}
+	if !trie.KeysWithPrefix("x").IsEmpty() || !trie.KeysWithPrefix("tex").IsEmpty() {
+		t.Log("keys are not empty")
+		t.Fail()
+	}
+}
+func TestLongestPrefixMatchTrie(t *testing.T) {
+
+	var trie *Trie[string, string] = NewTrieFromSlice([]string{"192.168", "192.168.1", "10"}, []string{"a", "b", "c"})
+
+	if key, e, ok := trie.LongestPrefixMatch("192.168.1.20"); !ok || key != "192.168.1" || e != "b" {
+		t.Log("key is", key, "element is", e)
+		t.Fail()
+	}
+	if key, e, ok := trie.LongestPrefixMatch("192.168.2.1"); !ok || key != "192.168" || e != "a" {
+		t.Log("key is", key, "element is", e)
+		t.Fail()
+	}
+	if key, e, ok := trie.LongestPrefixMatch("192.1"); ok || key != "" || e != "" {
+		t.Log("key is", key, "element is", e)
+		t.Fail()
+	}
+	trie.Put("", "d")
+	if key, e, ok := trie.LongestPrefixMatch("172"); !ok || key != "" || e != "d" {
+		t.Log("key is", key, "element is", e)
+		t.Fail()
+	}
+}
+func TestIterTrie(t *testing.T) {
+
+	var trie *Trie[wrapper.String, int] = NewTrieFromSlice([]wrapper.String{"a", "ab", "abc", "b"}, []int{1, 2, 3, 4})
+	var keys []wrapper.String
+
+	for i := trie.Iter(); !i.End(); i = i.Next() {
+		keys = append(keys, i.Key())
+		if i.Element()%2 == 0 {
+			i = i.Remove()
+			if i.End() {
+				break
+			}
+			keys = append(keys, i.Key())
+		}
+	}
+	if !reflect.DeepEqual(keys, []wrapper.String{"a", "ab", "abc", "b"}) {
+		t.Log("keys are", keys)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(trie.Keys().ToSlice(), []wrapper.String{"a", "abc"}) {
+		t.Log("keys are", trie.Keys())
+		t.Fail()
+	}
+	if !NewTrie[wrapper.String, int]().Iter().End() {
+		t.Log("iterator is not ended")
+		t.Fail()
+	}
+}
+func TestEqualTrie(t *testing.T) {
+
+	var trie *Trie[wrapper.String, int] = NewTrieFromSlice([]wrapper.String{"tea", "ten", "to"}, []int{1, 2, 3})
+	var other table.Table[wrapper.String, int] = table.NewTreeTableFromSlice([]wrapper.String{"to", "tea", "ten"}, []int{3, 1, 2})
+
+	if !trie.Equal(other) {
+		t.Log("tables are not equal")
+		t.Fail()
+	}
+	if !trie.Equal(NewRadixTreeFromSlice([]wrapper.String{"tea", "ten", "to"}, []int{1, 2, 3})) {
+		t.Log("tables are not equal")
+		t.Fail()
+	}
+	if !trie.Equal(trie.Copy()) {
+		t.Log("copy is not equal")
+		t.Fail()
+	}
+	other.Put("to", 4)
+	if trie.Equal(other) {
+		t.Log("tables are equal")
+		t.Fail()
+	}
+	if trie.Equal(NewTrie[string, int]()) {
+		t.Log("tables are equal")
+		t.Fail()
+	}
+	if trie.Hash() != trie.Copy().Hash() {
+		t.Log("hashes are different")
+		t.Fail()
+	}
+}
+func TestCompareTrie(t *testing.T) {
+
+	var trie *Trie[wrapper.String, int] = NewTrieFromSlice([]wrapper.String{"tea", "ten"}, []int{1, 2})
+
+	if trie.Compare(NewTrieFromSlice([]wrapper.String{"a", "b"}, []int{1, 2})) != 0 {
+		t.Log("compare is not 0")
+		t.Fail()
+	}
+	if trie.Compare(table.NewHashTableFromSlice([]wrapper.String{"a"}, []int{1})) != 1 {
+		t.Log("compare is not 1")
+		t.Fail()
+	}
+	if trie.Compare(NewTrieFromSlice([]wrapper.String{"a", "b", "c"}, []int{1, 2, 3})) != -1 {
+		t.Log("compare is not -1")
+		t.Fail()
+	}
+	if trie.Compare(NewTrie[string, int]()) != -2 {
+		t.Log("compare is not -2")
+		t.Fail()
+	}
+}
+func TestStreamTrie(t *testing.T) {
+
+	var trie *Trie[string, int] = NewTrieFromSlice([]string{"tea", "ten", "to"}, []int{1, 2, 3})
+
+	result := table.CollectTo(trie.Stream().Filter(func(key string, element int) bool {
+		return key[1] == 'e'
+	}).Map(func(key string, element int) int {
+		return element * 10
+	}), EntriesToTrie[string, int]())
+	if !result.Equal(NewTrieFromSlice([]string{"tea", "ten"}, []int{10, 20})) {
+		t.Log("result is", result)
+		t.Fail()
+	}
+	var collected table.Table[string, int] = trie.Stream().Collect()
+	if _, ok := collected.(*Trie[string, int]); !ok || collected.Len() != 3 {
+		t.Log("result is", collected)
+		t.Fail()
+	}
+}
+func TestStringTrie(t *testing.T) {
+
+	var trie *Trie[wrapper.String, int] = NewTrieFromSlice([]wrapper.String{"b", "a"}, []int{2, 1})
+
+	if trie.String() != "Trie[wrapper.String, int][a: 1, b: 2]" {
+		t.Log("string is", trie)
+		t.Fail()
+	}
+}
+func TestJSONTrie(t *testing.T) {
+
+	var trie *Trie[wrapper.String, int] = NewTrieFromSlice([]wrapper.String{"to", "tea", "ten"}, []int{3, 1, 2})
+
+	data, err := json.Marshal(trie)
+	if err != nil || string(data) != `{"tea":1,"ten":2,"to":3}` {
+		t.Log("data is", string(data), "err is", err)
+		t.Fail()
+	}
+	var result Trie[wrapper.String, int]
+	if err := json.Unmarshal(data, &result); err != nil || !result.Equal(trie) {
+		t.Log("result is", &result, "err is", err)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`[1]`), &result); err == nil {
+		t.Log("err is nil")
+		t.Fail()
+	}
+}
+func TestBinary(t *testing.T) {
+
+	var keys []wrapper.String = []wrapper.String{"to", "tea", "ten"}
+	var elements []int = []int{3, 1, 2}
+	var tests []binaryTest = []binaryTest{
+		{"Trie", NewTrieFromSlice(keys, elements), NewTrieFromSlice([]wrapper.String{"x"}, []int{4}), &Trie[wrapper.String, int]{}},
+		{"RadixTree", NewRadixTreeFromSlice(keys, elements), NewRadixTreeFromSlice([]wrapper.String{"x"}, []int{4}), &RadixTree[wrapper.String, int]{}},
+	}
+
+	for _, i := range tests {
+		data, err := i.structure.MarshalBinary()
+		if err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := i.result.UnmarshalBinary(data); err != nil || !i.result.Equal(i.structure) {
+			t.Log(i.name, "result is", i.result, "err is", err)
+			t.Fail()
+		}
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(i.structure); err != nil {
+			t.Log(i.name, "err is", err)
+			t.Fail()
+		}
+		if err := gob.NewDecoder(&buffer).Decode(i.zero); err != nil || !i.zero.Equal(i.structure) {
+			t.Log(i.name, "result is", i.zero, "err is", err)
+			t.Fail()
+		}
+	}
+}
+
+type binaryTest struct {
+	name      string
+	structure binaryStructure
+	result    binaryStructure
+	zero      binaryStructure
+}
+
+type binaryStructure interface {
+	util.Equaler
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
@@ -60,3 +60,10 @@ func Copy[T any](e T) T {
 	}
 	return e
 }
+
+// Compare returns the result of i.Compare(j).
+//
+// It can be used to obtain a comparison function for the types that implement [Comparer].
+func Compare[T Comparer](i T, j T) int {
+	return i.Compare(j)
+}